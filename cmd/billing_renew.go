@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	"github.com/cristiano-pacheco/goflix/internal/billing/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/job"
+	"github.com/cristiano-pacheco/goflix/internal/identity"
+	shared_modules "github.com/cristiano-pacheco/goflix/internal/shared/modules"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+)
+
+// billingRenewCmd represents the billing renew command.
+var billingRenewCmd = &cobra.Command{
+	Use:   "billing:renew",
+	Short: "Renew and expire subscriptions",
+	Long: `Charge the subscriptions reaching the end of their period, retry the past due ones
+and expire the ones that are not renewed. The scheduler runs the same job periodically,
+this command runs it once, e.g. from cron when the scheduler is disabled.`,
+	Run: func(_ *cobra.Command, _ []string) {
+		var output usecase.RenewSubscriptionsOutput
+		var errRenew error
+
+		// The app is built with the modules of the server but never started, so the HTTP server,
+		// the scheduler and the consumers do not run
+		app := fx.New(
+			shared_modules.Module,
+			identity.Module,
+			billing.Module,
+			fx.NopLogger,
+			fx.Invoke(func(renewSubscriptionsUseCase *usecase.RenewSubscriptionsUseCase, cfg config.Config) {
+				input := job.NewRenewSubscriptionsInput(cfg)
+				output, errRenew = renewSubscriptionsUseCase.Execute(context.Background(), input)
+			}),
+		)
+		if err := app.Err(); err != nil {
+			//nolint:sloglint // this is a command
+			slog.Error("Failed to build the application", "error", err)
+			os.Exit(1)
+		}
+
+		if errRenew != nil {
+			//nolint:sloglint // this is a command
+			slog.Error("Failed to renew subscriptions", "error", errRenew)
+			os.Exit(1)
+		}
+
+		//nolint:sloglint // this is a command
+		slog.Info(
+			"Subscriptions renewed successfully",
+			"renewed", output.Renewed,
+			"pastDue", output.PastDue,
+			"expired", output.Expired,
+			"failed", output.Failed,
+		)
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(billingRenewCmd)
+}
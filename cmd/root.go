@@ -6,6 +6,8 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	"github.com/cristiano-pacheco/goflix/internal/catalog"
 	"github.com/cristiano-pacheco/goflix/internal/identity"
 	shared_modules "github.com/cristiano-pacheco/goflix/internal/shared/modules"
 )
@@ -18,6 +20,8 @@ var rootCmd = &cobra.Command{
 		app := fx.New(
 			shared_modules.Module,
 			identity.Module,
+			billing.Module,
+			catalog.Module,
 		)
 		app.Run()
 	},
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/persistence/gorm/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+)
+
+// userRoleCmd represents the user role command.
+var userRoleCmd = &cobra.Command{
+	Use:   "user:role <email> <role>",
+	Short: "Change the role of a user",
+	Long: `Change the role of a user, for example to create the first administrator.
+Access tokens already issued keep the previous role until they expire.`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // email and role
+	Run: func(_ *cobra.Command, args []string) {
+		config.Init()
+		cfg := config.GetConfig()
+
+		db := database.New(cfg)
+		userRepository := repository.NewUserRepository(db, mapper.NewUserMapper())
+
+		ctx := context.Background()
+		email, role := args[0], args[1]
+
+		user, err := userRepository.FindByEmail(ctx, email)
+		if err != nil {
+			//nolint:sloglint // this is a command
+			slog.Error("Failed to find user", "email", email, "error", err)
+			os.Exit(1)
+		}
+
+		err = user.ChangeRole(role)
+		if err != nil {
+			//nolint:sloglint // this is a command
+			slog.Error("Failed to change role", "error", err)
+			os.Exit(1)
+		}
+
+		err = userRepository.Update(ctx, user)
+		if err != nil {
+			//nolint:sloglint // this is a command
+			slog.Error("Failed to update user", "error", err)
+			os.Exit(1)
+		}
+
+		//nolint:sloglint // this is a command
+		slog.Info("User role updated successfully", "email", email, "role", role)
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(userRoleCmd)
+}
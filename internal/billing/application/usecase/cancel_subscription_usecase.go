@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CancelSubscriptionUseCase struct {
+	subscriptionRepository repository.SubscriptionRepository
+	invoiceRepository      repository.InvoiceRepository
+	txManager              database.TxManager
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewCancelSubscriptionUseCase(
+	subscriptionRepository repository.SubscriptionRepository,
+	invoiceRepository repository.InvoiceRepository,
+	txManager database.TxManager,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CancelSubscriptionUseCase {
+	return &CancelSubscriptionUseCase{subscriptionRepository, invoiceRepository, txManager, validate, logger}
+}
+
+type CancelSubscriptionInput struct {
+	UserID         uint64 `validate:"required,number"`
+	SubscriptionID uint64 `validate:"required,number"`
+	// Immediately ends the subscription now. Otherwise auto-renew is turned off and the
+	// subscription expires at the end of the period already paid.
+	Immediately bool
+}
+
+func (uc *CancelSubscriptionUseCase) Execute(
+	ctx context.Context,
+	input CancelSubscriptionInput,
+) (SubscriptionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CancelSubscriptionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	var output SubscriptionOutput
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		output, err = uc.cancel(ctx, input)
+		return err
+	})
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	return output, nil
+}
+
+// cancel runs in the transaction carried by ctx. The subscription stays locked until it commits,
+// so a renewal running meanwhile is waited for instead of being overwritten.
+func (uc *CancelSubscriptionUseCase) cancel(
+	ctx context.Context,
+	input CancelSubscriptionInput,
+) (SubscriptionOutput, error) {
+	lockCtx := database.WithRowLock(ctx, database.RowLock{Strength: database.LockForUpdate})
+	subscriptionModel, err := findUserSubscription(
+		lockCtx,
+		uc.subscriptionRepository,
+		uc.logger,
+		input.UserID,
+		input.SubscriptionID,
+	)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	if input.Immediately {
+		err = uc.cancelImmediately(ctx, &subscriptionModel)
+	} else {
+		err = subscriptionModel.CancelAtPeriodEnd()
+	}
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	err = uc.subscriptionRepository.Update(ctx, subscriptionModel)
+	if err != nil {
+		message := "error cancelling subscription"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+		return SubscriptionOutput{}, err
+	}
+
+	return newSubscriptionOutput(subscriptionModel), nil
+}
+
+// cancelImmediately also voids the unpaid invoice of a PastDue subscription, which is
+// never going to be retried.
+func (uc *CancelSubscriptionUseCase) cancelImmediately(
+	ctx context.Context,
+	subscriptionModel *model.SubscriptionModel,
+) error {
+	if err := subscriptionModel.Cancel(time.Now().UTC()); err != nil {
+		return err
+	}
+
+	invoice, err := uc.invoiceRepository.FindOpenBySubscriptionID(ctx, subscriptionModel.ID())
+	if err != nil {
+		if errors.Is(err, errs.ErrInvoiceNotFound) {
+			return nil
+		}
+		message := "error finding open invoice"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+		return err
+	}
+
+	if err = invoice.Void(); err != nil {
+		return err
+	}
+
+	if err = uc.invoiceRepository.Update(ctx, invoice); err != nil {
+		message := "error voiding open invoice"
+		uc.logger.Error(message, "error", err, "invoiceID", invoice.ID())
+		return err
+	}
+
+	return nil
+}
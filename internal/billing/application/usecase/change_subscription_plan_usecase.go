@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// ChangeSubscriptionPlanUseCase upgrades or downgrades a subscription to another plan with
+// the same interval and currency. The billing cycle is kept: the price difference for the
+// days left in the current period is charged on an upgrade and refunded on a downgrade.
+// Trials switch plan without any charge.
+type ChangeSubscriptionPlanUseCase struct {
+	subscriptionRepository repository.SubscriptionRepository
+	planRepository         repository.PlanRepository
+	prorationService       service.ProrationService
+	chargeService          service.SubscriptionChargeService
+	txManager              database.TxManager
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewChangeSubscriptionPlanUseCase(
+	subscriptionRepository repository.SubscriptionRepository,
+	planRepository repository.PlanRepository,
+	prorationService service.ProrationService,
+	chargeService service.SubscriptionChargeService,
+	txManager database.TxManager,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ChangeSubscriptionPlanUseCase {
+	return &ChangeSubscriptionPlanUseCase{
+		subscriptionRepository,
+		planRepository,
+		prorationService,
+		chargeService,
+		txManager,
+		validate,
+		logger,
+	}
+}
+
+type ChangeSubscriptionPlanInput struct {
+	UserID         uint64 `validate:"required,number"`
+	SubscriptionID uint64 `validate:"required,number"`
+	PlanID         uint64 `validate:"required,number"`
+}
+
+type ChangeSubscriptionPlanOutput struct {
+	Subscription  SubscriptionOutput
+	ChargedCents  uint
+	RefundedCents uint
+}
+
+func (uc *ChangeSubscriptionPlanUseCase) Execute(
+	ctx context.Context,
+	input ChangeSubscriptionPlanInput,
+) (ChangeSubscriptionPlanOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ChangeSubscriptionPlanUseCase.Execute")
+	defer span.End()
+
+	output := ChangeSubscriptionPlanOutput{}
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	// The transaction is not retried since the proration goes through the payment gateway
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		output, err = uc.changePlan(ctx, input)
+		return err
+	}, database.WithAttempts(1))
+	if err != nil {
+		return ChangeSubscriptionPlanOutput{}, err
+	}
+
+	return output, nil
+}
+
+// changePlan runs in the transaction carried by ctx. The subscription stays locked until it
+// commits, so a renewal running meanwhile is waited for instead of being overwritten.
+func (uc *ChangeSubscriptionPlanUseCase) changePlan(
+	ctx context.Context,
+	input ChangeSubscriptionPlanInput,
+) (ChangeSubscriptionPlanOutput, error) {
+	output := ChangeSubscriptionPlanOutput{}
+
+	lockCtx := database.WithRowLock(ctx, database.RowLock{Strength: database.LockForUpdate})
+	subscriptionModel, err := findUserSubscription(
+		lockCtx,
+		uc.subscriptionRepository,
+		uc.logger,
+		input.UserID,
+		input.SubscriptionID,
+	)
+	if err != nil {
+		return output, err
+	}
+
+	currentPlan, err := uc.planRepository.FindByID(ctx, subscriptionModel.PlanID())
+	if err != nil {
+		message := "error finding current plan"
+		uc.logger.Error(message, "error", err, "planID", subscriptionModel.PlanID())
+		return output, err
+	}
+
+	newPlan, err := uc.planRepository.FindByID(ctx, input.PlanID)
+	if err != nil {
+		return output, err
+	}
+
+	// Nothing is persisted until the proration is settled, so a declined upgrade keeps the current plan
+	err = subscriptionModel.ChangePlan(newPlan.ID())
+	if err != nil {
+		return output, err
+	}
+
+	if !subscriptionModel.IsTrialing() {
+		output, err = uc.settleProration(ctx, subscriptionModel, currentPlan, newPlan)
+		if err != nil {
+			return output, err
+		}
+	}
+
+	err = uc.subscriptionRepository.Update(ctx, subscriptionModel)
+	if err != nil {
+		message := "error changing subscription plan"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+		return output, err
+	}
+
+	output.Subscription = newSubscriptionOutput(subscriptionModel)
+	return output, nil
+}
+
+func (uc *ChangeSubscriptionPlanUseCase) settleProration(
+	ctx context.Context,
+	subscriptionModel model.SubscriptionModel,
+	currentPlan, newPlan model.PlanModel,
+) (ChangeSubscriptionPlanOutput, error) {
+	output := ChangeSubscriptionPlanOutput{}
+
+	if subscriptionModel.EndDate() == nil {
+		return output, errs.ErrPlanChangeRequiresEndDate
+	}
+
+	currentInterval := currentPlan.Interval()
+	newInterval := newPlan.Interval()
+	if currentInterval.String() != newInterval.String() {
+		return output, errs.ErrPlanIntervalMismatch
+	}
+
+	currentCurrency := currentPlan.Currency()
+	newCurrency := newPlan.Currency()
+	if currentCurrency.Code() != newCurrency.Code() {
+		return output, errs.ErrPlanCurrencyMismatch
+	}
+
+	now := time.Now().UTC()
+	proration, err := uc.prorationService.Calculate(service.ProrationInput{
+		CurrentAmount: currentPlan.Amount(),
+		NewAmount:     newPlan.Amount(),
+		PeriodStart:   subscriptionModel.CurrentPeriodStart(),
+		PeriodEnd:     *subscriptionModel.EndDate(),
+		ChangeDate:    now,
+	})
+	if err != nil {
+		return output, err
+	}
+
+	if proration.ChargeCents > 0 {
+		_, err = uc.chargeService.ChargeProration(ctx, subscriptionModel, newPlan, now, proration)
+		if err != nil {
+			message := "error charging plan upgrade"
+			uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+			return output, err
+		}
+		output.ChargedCents = proration.ChargeCents
+	}
+
+	if proration.RefundCents > 0 {
+		output.RefundedCents, err = uc.chargeService.RefundProration(ctx, subscriptionModel, proration.RefundCents)
+		if err != nil {
+			message := "error refunding plan downgrade"
+			uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+			return output, err
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CreatePlanUseCase struct {
+	planRepository repository.PlanRepository
+	validate       validator.Validate
+	logger         logger.Logger
+}
+
+func NewCreatePlanUseCase(
+	planRepository repository.PlanRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreatePlanUseCase {
+	return &CreatePlanUseCase{planRepository, validate, logger}
+}
+
+type CreatePlanInput struct {
+	Name                 string `validate:"required"`
+	Description          string
+	AmountCents          uint   `validate:"required"`
+	Currency             string `validate:"required"`
+	Interval             string `validate:"required"`
+	TrialPeriodDays      *uint
+	MaxConcurrentStreams uint   `validate:"required"`
+	MaxResolution        string `validate:"required"`
+	MaxDownloads         uint
+	MaxProfiles          uint `validate:"required"`
+}
+
+func (uc *CreatePlanUseCase) Execute(ctx context.Context, input CreatePlanInput) (PlanOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreatePlanUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	entitlements, err := model.CreateEntitlementsModel(
+		input.MaxConcurrentStreams,
+		input.MaxResolution,
+		input.MaxDownloads,
+		input.MaxProfiles,
+	)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	planModel, err := model.CreatePlanModel(
+		input.Name,
+		input.Description,
+		input.Currency,
+		input.Interval,
+		input.AmountCents,
+		input.TrialPeriodDays,
+		entitlements,
+	)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	createdPlan, err := uc.planRepository.Create(ctx, planModel)
+	if err != nil {
+		message := "error creating plan"
+		uc.logger.Error(message, "error", err)
+		return PlanOutput{}, err
+	}
+
+	return newPlanOutput(createdPlan), nil
+}
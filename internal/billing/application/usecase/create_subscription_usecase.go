@@ -5,11 +5,12 @@ import (
 	"errors"
 	"time"
 
-	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/mapper"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
 	sharedErrs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
@@ -20,6 +21,9 @@ type CreateSubscriptionUseCase struct {
 	subscriptionRepository repository.SubscriptionRepository
 	planRepository         repository.PlanRepository
 	endDateMapper          mapper.EndDateMapper
+	paymentGateway         service.PaymentGateway
+	chargeService          service.SubscriptionChargeService
+	txManager              database.TxManager
 	validate               validator.Validate
 	logger                 logger.Logger
 }
@@ -28,6 +32,9 @@ func NewCreateSubscriptionUseCase(
 	subscriptionRepository repository.SubscriptionRepository,
 	planRepository repository.PlanRepository,
 	endDateMapper mapper.EndDateMapper,
+	paymentGateway service.PaymentGateway,
+	chargeService service.SubscriptionChargeService,
+	txManager database.TxManager,
 	validate validator.Validate,
 	logger logger.Logger,
 ) *CreateSubscriptionUseCase {
@@ -35,6 +42,9 @@ func NewCreateSubscriptionUseCase(
 		subscriptionRepository,
 		planRepository,
 		endDateMapper,
+		paymentGateway,
+		chargeService,
+		txManager,
 		validate,
 		logger,
 	}
@@ -43,6 +53,9 @@ func NewCreateSubscriptionUseCase(
 type CreateSubscriptionInput struct {
 	PlanID uint64 `validate:"required,number"`
 	UserID uint64 `validate:"required,number"`
+	// PaymentMethodToken is the tokenized card from the payment provider. It is only
+	// optional for free plans.
+	PaymentMethodToken string
 }
 
 type CreateSubscriptionOutput struct {
@@ -52,6 +65,7 @@ type CreateSubscriptionOutput struct {
 	Status         string
 	StartDate      time.Time
 	EndDate        *time.Time
+	TrialEndDate   *time.Time
 	AutoRenew      bool
 }
 
@@ -82,47 +96,42 @@ func (uc *CreateSubscriptionUseCase) Execute(
 		return output, err
 	}
 
-	// Check if user already has an active subscription
-	existingSubscriptions, err := uc.subscriptionRepository.FindByUserID(ctx, input.UserID)
-	if err != nil && !errors.Is(err, sharedErrs.ErrNotFound) {
-		message := "error finding existing subscriptions for user %d"
-		uc.logger.Error(message, "error", err, "userID", input.UserID)
+	// Fail fast, before the payment method is attached. The check is repeated in the transaction
+	_, err = uc.findExistingSubscriptions(ctx, input.UserID)
+	if err != nil {
 		return output, err
 	}
 
-	// Check for active subscriptions
-	for _, subscription := range existingSubscriptions {
-		status := subscription.Status()
-		if status.String() == enum.EnumSubscriptionStatusActive {
-			return output, errs.ErrUserAlreadyHasActiveSubscription
+	amount := planModel.Amount()
+	if amount.Cents() > 0 && input.PaymentMethodToken == "" {
+		return output, errs.ErrPaymentMethodRequired
+	}
+
+	// Trials need the payment method as well, to charge the first paid period. The gateway is
+	// called outside the transaction, which may run more than once
+	var paymentMethod *attachedPaymentMethod
+	if input.PaymentMethodToken != "" {
+		paymentMethod, err = uc.attachPaymentMethod(ctx, input.UserID, input.PaymentMethodToken)
+		if err != nil {
+			return output, err
 		}
 	}
 
-	// Calculate subscription dates based on plan interval
-	startDate := time.Now().UTC()
-	endDate := uc.endDateMapper.Map(startDate, planModel.Interval())
-
-	// Create subscription model
-	subscriptionModel, err := model.CreateSubscriptionModel(
-		input.UserID,
-		input.PlanID,
-		startDate,
-		endDate,
-	)
+	var createdSubscription model.SubscriptionModel
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		createdSubscription, err = uc.createSubscription(ctx, input, planModel, paymentMethod)
+		return err
+	})
 	if err != nil {
-		message := "error creating subscription model"
-		uc.logger.Error(message, "error", err)
 		return output, err
 	}
 
-	// TODO: charge user
-
-	// Save subscription
-	createdSubscription, err := uc.subscriptionRepository.Create(ctx, subscriptionModel)
-	if err != nil {
-		message := "error creating subscription"
-		uc.logger.Error(message, "error", err)
-		return output, err
+	// The subscription is only activated once the first period is paid
+	if !createdSubscription.IsTrialing() {
+		err = uc.charge(ctx, &createdSubscription, planModel)
+		if err != nil {
+			return output, err
+		}
 	}
 
 	// TODO: send email to user
@@ -135,8 +144,237 @@ func (uc *CreateSubscriptionUseCase) Execute(
 		Status:         createdStatus.String(),
 		StartDate:      createdSubscription.StartDate(),
 		EndDate:        createdSubscription.EndDate(),
+		TrialEndDate:   createdSubscription.TrialEndDate(),
 		AutoRenew:      createdSubscription.AutoRenew(),
 	}
 
 	return output, nil
 }
+
+// createSubscription checks the subscriptions of the user again and saves the new one, in the
+// transaction carried by ctx. The subscriptions of the user stay locked until it commits, so a
+// subscription activated by a concurrent request is seen once that request is done, and the
+// past due ones are expired once.
+func (uc *CreateSubscriptionUseCase) createSubscription(
+	ctx context.Context,
+	input CreateSubscriptionInput,
+	planModel model.PlanModel,
+	paymentMethod *attachedPaymentMethod,
+) (model.SubscriptionModel, error) {
+	lockCtx := database.WithRowLock(ctx, database.RowLock{Strength: database.LockForUpdate})
+	existingSubscriptions, err := uc.findExistingSubscriptions(lockCtx, input.UserID)
+	if err != nil {
+		return model.SubscriptionModel{}, err
+	}
+
+	// The trial is granted once per user, so cancelling and re-subscribing does not restart it
+	hasUsedTrial := false
+	var pastDueSubscriptions []model.SubscriptionModel
+	for _, subscription := range existingSubscriptions {
+		if subscription.HasTrial() {
+			hasUsedTrial = true
+		}
+		if subscription.IsPastDue() {
+			pastDueSubscriptions = append(pastDueSubscriptions, subscription)
+		}
+	}
+
+	startDate := time.Now().UTC()
+
+	var subscriptionModel model.SubscriptionModel
+	trialPeriod := planModel.TrialPeriod()
+	if trialPeriod != nil && !hasUsedTrial {
+		// The first period is the trial; it is converted to a paid period when it ends
+		trialEndDate := startDate.AddDate(0, 0, int(trialPeriod.Days()))
+		subscriptionModel, err = model.CreateTrialSubscriptionModel(
+			input.UserID,
+			input.PlanID,
+			startDate,
+			trialEndDate,
+		)
+	} else {
+		// Calculate subscription dates based on plan interval
+		endDate := uc.endDateMapper.Map(startDate, planModel.Interval())
+		subscriptionModel, err = model.CreateSubscriptionModel(
+			input.UserID,
+			input.PlanID,
+			startDate,
+			endDate,
+		)
+	}
+	if err != nil {
+		message := "error creating subscription model"
+		uc.logger.Error(message, "error", err)
+		return model.SubscriptionModel{}, err
+	}
+
+	if paymentMethod != nil {
+		err = subscriptionModel.AttachPaymentMethod(paymentMethod.customerID, paymentMethod.paymentMethodID)
+		if err != nil {
+			return model.SubscriptionModel{}, err
+		}
+	}
+
+	// A new subscription supersedes the unpaid ones, so the scheduler stops retrying them
+	err = uc.expireSubscriptions(ctx, pastDueSubscriptions)
+	if err != nil {
+		return model.SubscriptionModel{}, err
+	}
+
+	createdSubscription, err := uc.subscriptionRepository.Create(ctx, subscriptionModel)
+	if err != nil {
+		message := "error creating subscription"
+		uc.logger.Error(message, "error", err)
+		return model.SubscriptionModel{}, err
+	}
+
+	return createdSubscription, nil
+}
+
+// findExistingSubscriptions returns the subscriptions of the user, or an error when one of them
+// is active or paused: a paused subscription is resumed rather than replaced.
+func (uc *CreateSubscriptionUseCase) findExistingSubscriptions(
+	ctx context.Context,
+	userID uint64,
+) ([]model.SubscriptionModel, error) {
+	existingSubscriptions, err := uc.subscriptionRepository.FindByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, sharedErrs.ErrNotFound) {
+		message := "error finding existing subscriptions for user %d"
+		uc.logger.Error(message, "error", err, "userID", userID)
+		return nil, err
+	}
+
+	for _, subscription := range existingSubscriptions {
+		if subscription.IsActive() {
+			return nil, errs.ErrUserAlreadyHasActiveSubscription
+		}
+		if subscription.IsPaused() {
+			return nil, errs.ErrUserHasPausedSubscription
+		}
+	}
+
+	return existingSubscriptions, nil
+}
+
+type attachedPaymentMethod struct {
+	customerID      string
+	paymentMethodID string
+}
+
+func (uc *CreateSubscriptionUseCase) attachPaymentMethod(
+	ctx context.Context,
+	userID uint64,
+	paymentMethodToken string,
+) (*attachedPaymentMethod, error) {
+	customerID, err := uc.paymentGateway.CreateCustomer(ctx, userID)
+	if err != nil {
+		message := "error creating payment customer"
+		uc.logger.Error(message, "error", err, "userID", userID)
+		return nil, err
+	}
+
+	paymentMethodID, err := uc.paymentGateway.AttachPaymentMethod(ctx, customerID, paymentMethodToken)
+	if err != nil {
+		message := "error attaching payment method"
+		uc.logger.Error(message, "error", err, "userID", userID)
+		return nil, err
+	}
+
+	return &attachedPaymentMethod{customerID, paymentMethodID}, nil
+}
+
+func (uc *CreateSubscriptionUseCase) expireSubscriptions(
+	ctx context.Context,
+	subscriptions []model.SubscriptionModel,
+) error {
+	for _, subscription := range subscriptions {
+		if err := subscription.Expire(); err != nil {
+			return err
+		}
+		if err := uc.subscriptionRepository.Update(ctx, subscription); err != nil {
+			message := "error expiring past due subscription"
+			uc.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+			return err
+		}
+	}
+	return nil
+}
+
+// charge pays the first period and activates the subscription. A failed charge leaves
+// the subscription PastDue without a retry: the user is expected to subscribe again with
+// another payment method, and the error is returned to the caller.
+func (uc *CreateSubscriptionUseCase) charge(
+	ctx context.Context,
+	subscription *model.SubscriptionModel,
+	plan model.PlanModel,
+) error {
+	_, errCharge := uc.chargeService.Charge(
+		ctx,
+		*subscription,
+		plan,
+		subscription.StartDate(),
+		subscription.EndDate(),
+	)
+	if errCharge != nil {
+		message := "error charging subscription"
+		uc.logger.Error(message, "error", errCharge, "subscriptionID", subscription.ID())
+
+		if err := subscription.MarkPastDue(nil); err != nil {
+			return err
+		}
+		if err := uc.subscriptionRepository.Update(ctx, *subscription); err != nil {
+			message = "error marking subscription as past due"
+			uc.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+			return err
+		}
+		return errCharge
+	}
+
+	pending := *subscription
+	if err := subscription.Activate(); err != nil {
+		return err
+	}
+
+	if err := uc.subscriptionRepository.Update(ctx, *subscription); err != nil {
+		if errors.Is(err, errs.ErrUserAlreadyHasActiveSubscription) {
+			*subscription = pending
+			return uc.cancelDuplicate(ctx, subscription, plan)
+		}
+		message := "error activating subscription"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return err
+	}
+
+	return nil
+}
+
+// cancelDuplicate undoes a subscription that a concurrent request of the same user beat to
+// activation: the first period is refunded and the subscription cancelled.
+func (uc *CreateSubscriptionUseCase) cancelDuplicate(
+	ctx context.Context,
+	subscription *model.SubscriptionModel,
+	plan model.PlanModel,
+) error {
+	uc.logger.Warn("user subscribed twice at the same time", "subscriptionID", subscription.ID())
+
+	amount := plan.Amount()
+	if amount.Cents() > 0 {
+		_, err := uc.chargeService.RefundProration(ctx, *subscription, amount.Cents())
+		if err != nil {
+			message := "error refunding duplicate subscription"
+			uc.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+			return err
+		}
+	}
+
+	if err := subscription.Cancel(time.Now().UTC()); err != nil {
+		return err
+	}
+	if err := uc.subscriptionRepository.Update(ctx, *subscription); err != nil {
+		message := "error cancelling duplicate subscription"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return err
+	}
+
+	return errs.ErrUserAlreadyHasActiveSubscription
+}
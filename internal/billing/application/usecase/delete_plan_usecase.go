@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type DeletePlanUseCase struct {
+	planRepository         repository.PlanRepository
+	subscriptionRepository repository.SubscriptionRepository
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewDeletePlanUseCase(
+	planRepository repository.PlanRepository,
+	subscriptionRepository repository.SubscriptionRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *DeletePlanUseCase {
+	return &DeletePlanUseCase{planRepository, subscriptionRepository, validate, logger}
+}
+
+type DeletePlanInput struct {
+	PlanID uint64 `validate:"required,number"`
+}
+
+func (uc *DeletePlanUseCase) Execute(ctx context.Context, input DeletePlanInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "DeletePlanUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	// Subscriptions reference their plan, so a plan in use cannot be removed
+	hasSubscriptions, err := uc.subscriptionRepository.ExistsByPlanID(ctx, input.PlanID)
+	if err != nil {
+		message := "error checking plan subscriptions"
+		uc.logger.Error(message, "error", err, "planID", input.PlanID)
+		return err
+	}
+
+	if hasSubscriptions {
+		return errs.ErrPlanHasSubscriptions
+	}
+
+	err = uc.planRepository.Delete(ctx, input.PlanID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlanNotFound) {
+			message := "error deleting plan"
+			uc.logger.Error(message, "error", err, "planID", input.PlanID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type FindInvoiceUseCase struct {
+	invoiceRepository repository.InvoiceRepository
+	paymentRepository repository.PaymentRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewFindInvoiceUseCase(
+	invoiceRepository repository.InvoiceRepository,
+	paymentRepository repository.PaymentRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindInvoiceUseCase {
+	return &FindInvoiceUseCase{invoiceRepository, paymentRepository, validate, logger}
+}
+
+type FindInvoiceInput struct {
+	UserID    uint64 `validate:"required,number"`
+	InvoiceID uint64 `validate:"required,number"`
+}
+
+type FindInvoiceOutput struct {
+	Invoice  InvoiceOutput
+	Payments []PaymentOutput
+}
+
+func (uc *FindInvoiceUseCase) Execute(ctx context.Context, input FindInvoiceInput) (FindInvoiceOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindInvoiceUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return FindInvoiceOutput{}, err
+	}
+
+	invoiceModel, err := uc.invoiceRepository.FindByID(ctx, input.InvoiceID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrInvoiceNotFound) {
+			message := "error finding invoice by id"
+			uc.logger.Error(message, "error", err, "invoiceID", input.InvoiceID)
+		}
+		return FindInvoiceOutput{}, err
+	}
+
+	// Another user's invoice is reported as missing so its existence is not disclosed
+	if invoiceModel.UserID() != input.UserID {
+		return FindInvoiceOutput{}, errs.ErrInvoiceNotFound
+	}
+
+	paymentModels, err := uc.paymentRepository.FindByInvoiceID(ctx, invoiceModel.ID())
+	if err != nil {
+		message := "error finding invoice payments"
+		uc.logger.Error(message, "error", err, "invoiceID", invoiceModel.ID())
+		return FindInvoiceOutput{}, err
+	}
+
+	payments := make([]PaymentOutput, 0, len(paymentModels))
+	for _, paymentModel := range paymentModels {
+		payments = append(payments, newPaymentOutput(paymentModel))
+	}
+
+	return FindInvoiceOutput{
+		Invoice:  newInvoiceOutput(invoiceModel),
+		Payments: payments,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type FindPlanUseCase struct {
+	planRepository repository.PlanRepository
+	validate       validator.Validate
+	logger         logger.Logger
+}
+
+func NewFindPlanUseCase(
+	planRepository repository.PlanRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindPlanUseCase {
+	return &FindPlanUseCase{planRepository, validate, logger}
+}
+
+type FindPlanInput struct {
+	PlanID uint64 `validate:"required,number"`
+}
+
+func (uc *FindPlanUseCase) Execute(ctx context.Context, input FindPlanInput) (PlanOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindPlanUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	planModel, err := uc.planRepository.FindByID(ctx, input.PlanID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlanNotFound) {
+			message := "error finding plan by id"
+			uc.logger.Error(message, "error", err, "planID", input.PlanID)
+		}
+		return PlanOutput{}, err
+	}
+
+	return newPlanOutput(planModel), nil
+}
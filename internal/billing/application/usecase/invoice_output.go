@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+type InvoiceOutput struct {
+	InvoiceID      uint64
+	SubscriptionID uint64
+	Status         string
+	CurrencyCode   string
+	CurrencyName   string
+	CurrencyNumber string
+	MinorUnits     uint
+	TotalCents     uint
+	Total          string
+	PeriodStart    time.Time
+	PeriodEnd      *time.Time
+	PaidAt         *time.Time
+	Lines          []InvoiceLineOutput
+	CreatedAt      time.Time
+}
+
+type InvoiceLineOutput struct {
+	Description     string
+	Quantity        uint
+	UnitAmountCents uint
+	UnitAmount      string
+	AmountCents     uint
+	Amount          string
+}
+
+type PaymentOutput struct {
+	PaymentID         uint64
+	Status            string
+	AmountCents       uint
+	Amount            string
+	CurrencyCode      string
+	ProviderPaymentID string
+	FailureReason     string
+	CreatedAt         time.Time
+}
+
+func newInvoiceOutput(invoiceModel model.InvoiceModel) InvoiceOutput {
+	statusEnum := invoiceModel.Status()
+	currencyModel := invoiceModel.Currency()
+	totalModel := invoiceModel.Total()
+
+	lines := make([]InvoiceLineOutput, 0, len(invoiceModel.Lines()))
+	for _, line := range invoiceModel.Lines() {
+		unitAmountModel := line.UnitAmount()
+		amountModel := line.Amount()
+		lines = append(lines, InvoiceLineOutput{
+			Description:     line.Description(),
+			Quantity:        line.Quantity(),
+			UnitAmountCents: unitAmountModel.Cents(),
+			UnitAmount:      currencyModel.FormatAmount(unitAmountModel.Cents()),
+			AmountCents:     amountModel.Cents(),
+			Amount:          currencyModel.FormatAmount(amountModel.Cents()),
+		})
+	}
+
+	return InvoiceOutput{
+		InvoiceID:      invoiceModel.ID(),
+		SubscriptionID: invoiceModel.SubscriptionID(),
+		Status:         statusEnum.String(),
+		CurrencyCode:   currencyModel.Code(),
+		CurrencyName:   currencyModel.Currency(),
+		CurrencyNumber: currencyModel.Number(),
+		MinorUnits:     currencyModel.MinorUnits(),
+		TotalCents:     totalModel.Cents(),
+		Total:          currencyModel.FormatAmount(totalModel.Cents()),
+		PeriodStart:    invoiceModel.PeriodStart(),
+		PeriodEnd:      invoiceModel.PeriodEnd(),
+		PaidAt:         invoiceModel.PaidAt(),
+		Lines:          lines,
+		CreatedAt:      invoiceModel.CreatedAt(),
+	}
+}
+
+func newPaymentOutput(paymentModel model.PaymentModel) PaymentOutput {
+	statusEnum := paymentModel.Status()
+	amountModel := paymentModel.Amount()
+	currencyModel := paymentModel.Currency()
+
+	return PaymentOutput{
+		PaymentID:         paymentModel.ID(),
+		Status:            statusEnum.String(),
+		AmountCents:       amountModel.Cents(),
+		Amount:            currencyModel.FormatAmount(amountModel.Cents()),
+		CurrencyCode:      currencyModel.Code(),
+		ProviderPaymentID: paymentModel.ProviderPaymentID(),
+		FailureReason:     paymentModel.FailureReason(),
+		CreatedAt:         paymentModel.CreatedAt(),
+	}
+}
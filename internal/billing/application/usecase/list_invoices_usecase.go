@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type ListInvoicesUseCase struct {
+	invoiceRepository repository.InvoiceRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewListInvoicesUseCase(
+	invoiceRepository repository.InvoiceRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ListInvoicesUseCase {
+	return &ListInvoicesUseCase{invoiceRepository, validate, logger}
+}
+
+type ListInvoicesInput struct {
+	UserID uint64 `validate:"required,number"`
+}
+
+func (uc *ListInvoicesUseCase) Execute(ctx context.Context, input ListInvoicesInput) ([]InvoiceOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ListInvoicesUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceModels, err := uc.invoiceRepository.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		message := "error listing invoices"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return nil, err
+	}
+
+	output := make([]InvoiceOutput, 0, len(invoiceModels))
+	for _, invoiceModel := range invoiceModels {
+		output = append(output, newInvoiceOutput(invoiceModel))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type ListPlansUseCase struct {
+	planRepository repository.PlanRepository
+	logger         logger.Logger
+}
+
+func NewListPlansUseCase(
+	planRepository repository.PlanRepository,
+	logger logger.Logger,
+) *ListPlansUseCase {
+	return &ListPlansUseCase{planRepository, logger}
+}
+
+func (uc *ListPlansUseCase) Execute(ctx context.Context) ([]PlanOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ListPlansUseCase.Execute")
+	defer span.End()
+
+	planModels, err := uc.planRepository.FindAll(ctx)
+	if err != nil {
+		message := "error listing plans"
+		uc.logger.Error(message, "error", err)
+		return nil, err
+	}
+
+	output := make([]PlanOutput, len(planModels))
+	for i, planModel := range planModels {
+		output[i] = newPlanOutput(planModel)
+	}
+
+	return output, nil
+}
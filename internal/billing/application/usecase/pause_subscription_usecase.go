@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// PauseSubscriptionUseCase suspends access and billing until the user resumes the subscription.
+type PauseSubscriptionUseCase struct {
+	subscriptionRepository repository.SubscriptionRepository
+	txManager              database.TxManager
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewPauseSubscriptionUseCase(
+	subscriptionRepository repository.SubscriptionRepository,
+	txManager database.TxManager,
+	validate validator.Validate,
+	logger logger.Logger,
+) *PauseSubscriptionUseCase {
+	return &PauseSubscriptionUseCase{subscriptionRepository, txManager, validate, logger}
+}
+
+type PauseSubscriptionInput struct {
+	UserID         uint64 `validate:"required,number"`
+	SubscriptionID uint64 `validate:"required,number"`
+}
+
+func (uc *PauseSubscriptionUseCase) Execute(
+	ctx context.Context,
+	input PauseSubscriptionInput,
+) (SubscriptionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "PauseSubscriptionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	var output SubscriptionOutput
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		output, err = uc.pause(ctx, input)
+		return err
+	})
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	return output, nil
+}
+
+// pause runs in the transaction carried by ctx. The subscription stays locked until it commits,
+// so a renewal running meanwhile is waited for instead of being overwritten.
+func (uc *PauseSubscriptionUseCase) pause(
+	ctx context.Context,
+	input PauseSubscriptionInput,
+) (SubscriptionOutput, error) {
+	lockCtx := database.WithRowLock(ctx, database.RowLock{Strength: database.LockForUpdate})
+	subscriptionModel, err := findUserSubscription(
+		lockCtx,
+		uc.subscriptionRepository,
+		uc.logger,
+		input.UserID,
+		input.SubscriptionID,
+	)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	err = subscriptionModel.Pause(time.Now().UTC())
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	err = uc.subscriptionRepository.Update(ctx, subscriptionModel)
+	if err != nil {
+		message := "error pausing subscription"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+		return SubscriptionOutput{}, err
+	}
+
+	return newSubscriptionOutput(subscriptionModel), nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+type PlanOutput struct {
+	PlanID               uint64
+	Name                 string
+	Description          string
+	AmountCents          uint
+	Amount               string
+	CurrencyCode         string
+	CurrencyName         string
+	CurrencyNumber       string
+	MinorUnits           uint
+	Interval             string
+	TrialPeriodDays      *uint
+	MaxConcurrentStreams uint
+	MaxResolution        string
+	MaxDownloads         uint
+	MaxProfiles          uint
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func newPlanOutput(planModel model.PlanModel) PlanOutput {
+	nameModel := planModel.Name()
+	amountModel := planModel.Amount()
+	currencyModel := planModel.Currency()
+	intervalEnum := planModel.Interval()
+	entitlements := planModel.Entitlements()
+	maxResolution := entitlements.MaxResolution()
+
+	var description string
+	if planModel.Description() != nil {
+		description = planModel.Description().String()
+	}
+
+	var trialPeriodDays *uint
+	if planModel.TrialPeriod() != nil {
+		days := planModel.TrialPeriod().Days()
+		trialPeriodDays = &days
+	}
+
+	return PlanOutput{
+		PlanID:               planModel.ID(),
+		Name:                 nameModel.String(),
+		Description:          description,
+		AmountCents:          amountModel.Cents(),
+		Amount:               currencyModel.FormatAmount(amountModel.Cents()),
+		CurrencyCode:         currencyModel.Code(),
+		CurrencyName:         currencyModel.Currency(),
+		CurrencyNumber:       currencyModel.Number(),
+		MinorUnits:           currencyModel.MinorUnits(),
+		Interval:             intervalEnum.String(),
+		TrialPeriodDays:      trialPeriodDays,
+		MaxConcurrentStreams: entitlements.MaxConcurrentStreams(),
+		MaxResolution:        maxResolution.String(),
+		MaxDownloads:         entitlements.MaxDownloads(),
+		MaxProfiles:          entitlements.MaxProfiles(),
+		CreatedAt:            planModel.CreatedAt(),
+		UpdatedAt:            planModel.UpdatedAt(),
+	}
+}
@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+// RenewSubscriptionsUseCase charges the next period of every subscription that reaches
+// the end of its current one, trials included, and expires the ones that are not renewed.
+// A failed charge leaves the subscription PastDue and it is retried until the grace period
+// of the unpaid invoice runs out.
+//
+// Several runs may overlap, e.g. the scheduler of every instance and a billing:renew command.
+// Each subscription is claimed with a row lock before it is charged, so it is charged once
+// and a change made by the user meanwhile is not overwritten.
+type RenewSubscriptionsUseCase struct {
+	subscriptionRepository repository.SubscriptionRepository
+	planRepository         repository.PlanRepository
+	invoiceRepository      repository.InvoiceRepository
+	endDateMapper          mapper.EndDateMapper
+	chargeService          service.SubscriptionChargeService
+	txManager              database.TxManager
+	logger                 logger.Logger
+}
+
+func NewRenewSubscriptionsUseCase(
+	subscriptionRepository repository.SubscriptionRepository,
+	planRepository repository.PlanRepository,
+	invoiceRepository repository.InvoiceRepository,
+	endDateMapper mapper.EndDateMapper,
+	chargeService service.SubscriptionChargeService,
+	txManager database.TxManager,
+	logger logger.Logger,
+) *RenewSubscriptionsUseCase {
+	return &RenewSubscriptionsUseCase{
+		subscriptionRepository,
+		planRepository,
+		invoiceRepository,
+		endDateMapper,
+		chargeService,
+		txManager,
+		logger,
+	}
+}
+
+type RenewSubscriptionsInput struct {
+	Now time.Time
+	// LeadTime renews subscriptions that far ahead of the end of their period.
+	LeadTime time.Duration
+	// RetryInterval is the delay between two attempts to charge a PastDue subscription.
+	RetryInterval time.Duration
+	// GracePeriod is how long after the start of the unpaid period a PastDue
+	// subscription is retried before it expires.
+	GracePeriod time.Duration
+}
+
+type RenewSubscriptionsOutput struct {
+	Renewed int
+	PastDue int
+	Expired int
+	Failed  int
+}
+
+func (uc *RenewSubscriptionsUseCase) Execute(
+	ctx context.Context,
+	input RenewSubscriptionsInput,
+) (RenewSubscriptionsOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "RenewSubscriptionsUseCase.Execute")
+	defer span.End()
+
+	output := RenewSubscriptionsOutput{}
+
+	if input.Now.IsZero() {
+		input.Now = time.Now().UTC()
+	}
+
+	dueSubscriptions, err := uc.subscriptionRepository.FindDueForRenewal(ctx, input.Now.Add(input.LeadTime))
+	if err != nil {
+		message := "error finding subscriptions due for renewal"
+		uc.logger.Error(message, "error", err)
+		return output, err
+	}
+
+	retrySubscriptions, err := uc.subscriptionRepository.FindDueForRetry(ctx, input.Now)
+	if err != nil {
+		message := "error finding subscriptions due for retry"
+		uc.logger.Error(message, "error", err)
+		return output, err
+	}
+
+	// A failing subscription must not block the others, it is picked up again on the next run
+	for _, subscription := range dueSubscriptions {
+		status, errRenew := uc.claim(ctx, subscription.ID(), input, uc.renew)
+		output.count(status, errRenew)
+		if errRenew != nil {
+			message := "error renewing subscription"
+			uc.logger.Error(message, "error", errRenew, "subscriptionID", subscription.ID())
+		}
+	}
+
+	for _, subscription := range retrySubscriptions {
+		status, errRetry := uc.claim(ctx, subscription.ID(), input, uc.retry)
+		output.count(status, errRetry)
+		if errRetry != nil {
+			message := "error retrying subscription charge"
+			uc.logger.Error(message, "error", errRetry, "subscriptionID", subscription.ID())
+		}
+	}
+
+	return output, nil
+}
+
+// claim runs fn on the subscription in a transaction that holds its row lock, the subscription
+// read under the lock replacing the listed one. A subscription locked by another run is skipped,
+// that run handles it. The transaction is not retried since fn calls the payment gateway.
+func (uc *RenewSubscriptionsUseCase) claim(
+	ctx context.Context,
+	subscriptionID uint64,
+	input RenewSubscriptionsInput,
+	fn func(ctx context.Context, subscription model.SubscriptionModel, input RenewSubscriptionsInput) (string, error),
+) (string, error) {
+	var status string
+	err := uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		lockCtx := database.WithRowLock(ctx, database.RowLock{
+			Strength: database.LockForUpdate,
+			Wait:     database.LockSkipLocked,
+		})
+		subscription, err := uc.subscriptionRepository.FindByID(lockCtx, subscriptionID)
+		if errors.Is(err, errs.ErrSubscriptionNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		status, err = fn(ctx, subscription, input)
+		return err
+	}, database.WithAttempts(1))
+	if err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// renew charges the period that follows the current one and returns the status the
+// subscription was moved to, or an empty status when it was left untouched.
+func (uc *RenewSubscriptionsUseCase) renew(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	input RenewSubscriptionsInput,
+) (string, error) {
+	// The subscription may have been renewed, cancelled or paused since it was listed
+	if !subscription.IsActive() && !subscription.IsTrialing() {
+		return "", nil
+	}
+	if subscription.EndDate() == nil || subscription.EndDate().After(input.Now.Add(input.LeadTime)) {
+		return "", nil
+	}
+	periodStart := *subscription.EndDate()
+
+	if !subscription.AutoRenew() {
+		// The lead time only applies to charges, access lasts until the end of the period
+		if periodStart.After(input.Now) {
+			return "", nil
+		}
+		if err := subscription.Expire(); err != nil {
+			return "", err
+		}
+		return enum.EnumSubscriptionStatusExpired, uc.subscriptionRepository.Update(ctx, subscription)
+	}
+
+	planModel, err := uc.planRepository.FindByID(ctx, subscription.PlanID())
+	if err != nil {
+		return "", err
+	}
+
+	periodEnd := uc.endDateMapper.Map(periodStart, planModel.Interval())
+
+	_, errCharge := uc.chargeService.Charge(ctx, subscription, planModel, periodStart, periodEnd)
+	if errCharge != nil {
+		message := "error charging subscription renewal"
+		uc.logger.Error(message, "error", errCharge, "subscriptionID", subscription.ID())
+
+		nextRetryAt := uc.nextRetryAt(periodStart, input)
+		if err = subscription.MarkPastDue(&nextRetryAt); err != nil {
+			return "", err
+		}
+		return enum.EnumSubscriptionStatusPastDue, uc.subscriptionRepository.Update(ctx, subscription)
+	}
+
+	if err = subscription.Renew(periodEnd); err != nil {
+		return "", err
+	}
+
+	return enum.EnumSubscriptionStatusActive, uc.subscriptionRepository.Update(ctx, subscription)
+}
+
+// retry charges the unpaid invoice of a PastDue subscription again, or expires the
+// subscription and voids the invoice once the grace period is over.
+func (uc *RenewSubscriptionsUseCase) retry(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	input RenewSubscriptionsInput,
+) (string, error) {
+	// The subscription may have been paid, cancelled or retried since it was listed
+	if !subscription.IsPastDue() {
+		return "", nil
+	}
+	if subscription.NextRetryAt() == nil || subscription.NextRetryAt().After(input.Now) {
+		return "", nil
+	}
+
+	invoice, err := uc.invoiceRepository.FindOpenBySubscriptionID(ctx, subscription.ID())
+	if err != nil {
+		return "", err
+	}
+
+	gracePeriodEnd := invoice.PeriodStart().Add(input.GracePeriod)
+	if !subscription.AutoRenew() || !input.Now.Before(gracePeriodEnd) {
+		return uc.expire(ctx, subscription, invoice)
+	}
+
+	planModel, err := uc.planRepository.FindByID(ctx, subscription.PlanID())
+	if err != nil {
+		return "", err
+	}
+
+	_, errCharge := uc.chargeService.Charge(ctx, subscription, planModel, invoice.PeriodStart(), invoice.PeriodEnd())
+	if errCharge != nil {
+		message := "error retrying subscription charge"
+		uc.logger.Error(message, "error", errCharge, "subscriptionID", subscription.ID())
+
+		nextRetryAt := uc.nextRetryAt(invoice.PeriodStart(), input)
+		if err = subscription.MarkPastDue(&nextRetryAt); err != nil {
+			return "", err
+		}
+		return enum.EnumSubscriptionStatusPastDue, uc.subscriptionRepository.Update(ctx, subscription)
+	}
+
+	if err = subscription.Renew(invoice.PeriodEnd()); err != nil {
+		return "", err
+	}
+
+	return enum.EnumSubscriptionStatusActive, uc.subscriptionRepository.Update(ctx, subscription)
+}
+
+func (uc *RenewSubscriptionsUseCase) expire(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	invoice model.InvoiceModel,
+) (string, error) {
+	if err := invoice.Void(); err != nil {
+		return "", err
+	}
+
+	if err := uc.invoiceRepository.Update(ctx, invoice); err != nil {
+		return "", err
+	}
+
+	if err := subscription.Expire(); err != nil {
+		return "", err
+	}
+
+	return enum.EnumSubscriptionStatusExpired, uc.subscriptionRepository.Update(ctx, subscription)
+}
+
+// nextRetryAt never schedules past the end of the grace period, so the subscription
+// expires on time when every retry fails.
+func (uc *RenewSubscriptionsUseCase) nextRetryAt(periodStart time.Time, input RenewSubscriptionsInput) time.Time {
+	nextRetryAt := input.Now.Add(input.RetryInterval)
+	gracePeriodEnd := periodStart.Add(input.GracePeriod)
+	if nextRetryAt.After(gracePeriodEnd) {
+		return gracePeriodEnd
+	}
+	return nextRetryAt
+}
+
+func (o *RenewSubscriptionsOutput) count(status string, err error) {
+	if err != nil {
+		o.Failed++
+		return
+	}
+
+	switch status {
+	case enum.EnumSubscriptionStatusActive:
+		o.Renewed++
+	case enum.EnumSubscriptionStatusPastDue:
+		o.PastDue++
+	case enum.EnumSubscriptionStatusExpired:
+		o.Expired++
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// ResumeSubscriptionUseCase reactivates a paused subscription with the time it had left.
+type ResumeSubscriptionUseCase struct {
+	subscriptionRepository repository.SubscriptionRepository
+	txManager              database.TxManager
+	validate               validator.Validate
+	logger                 logger.Logger
+}
+
+func NewResumeSubscriptionUseCase(
+	subscriptionRepository repository.SubscriptionRepository,
+	txManager database.TxManager,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ResumeSubscriptionUseCase {
+	return &ResumeSubscriptionUseCase{subscriptionRepository, txManager, validate, logger}
+}
+
+type ResumeSubscriptionInput struct {
+	UserID         uint64 `validate:"required,number"`
+	SubscriptionID uint64 `validate:"required,number"`
+}
+
+func (uc *ResumeSubscriptionUseCase) Execute(
+	ctx context.Context,
+	input ResumeSubscriptionInput,
+) (SubscriptionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ResumeSubscriptionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	var output SubscriptionOutput
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		output, err = uc.resume(ctx, input)
+		return err
+	})
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	return output, nil
+}
+
+// resume runs in the transaction carried by ctx. The subscription stays locked until it commits,
+// so a renewal running meanwhile is waited for instead of being overwritten.
+func (uc *ResumeSubscriptionUseCase) resume(
+	ctx context.Context,
+	input ResumeSubscriptionInput,
+) (SubscriptionOutput, error) {
+	lockCtx := database.WithRowLock(ctx, database.RowLock{Strength: database.LockForUpdate})
+	subscriptionModel, err := findUserSubscription(
+		lockCtx,
+		uc.subscriptionRepository,
+		uc.logger,
+		input.UserID,
+		input.SubscriptionID,
+	)
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	err = subscriptionModel.Resume(time.Now().UTC())
+	if err != nil {
+		return SubscriptionOutput{}, err
+	}
+
+	err = uc.subscriptionRepository.Update(ctx, subscriptionModel)
+	if err != nil {
+		message := "error resuming subscription"
+		uc.logger.Error(message, "error", err, "subscriptionID", subscriptionModel.ID())
+		return SubscriptionOutput{}, err
+	}
+
+	return newSubscriptionOutput(subscriptionModel), nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+)
+
+type SubscriptionOutput struct {
+	SubscriptionID uint64
+	UserID         uint64
+	PlanID         uint64
+	Status         string
+	StartDate      time.Time
+	EndDate        *time.Time
+	TrialEndDate   *time.Time
+	PausedAt       *time.Time
+	AutoRenew      bool
+}
+
+func newSubscriptionOutput(subscriptionModel model.SubscriptionModel) SubscriptionOutput {
+	statusEnum := subscriptionModel.Status()
+
+	return SubscriptionOutput{
+		SubscriptionID: subscriptionModel.ID(),
+		UserID:         subscriptionModel.UserID(),
+		PlanID:         subscriptionModel.PlanID(),
+		Status:         statusEnum.String(),
+		StartDate:      subscriptionModel.StartDate(),
+		EndDate:        subscriptionModel.EndDate(),
+		TrialEndDate:   subscriptionModel.TrialEndDate(),
+		PausedAt:       subscriptionModel.PausedAt(),
+		AutoRenew:      subscriptionModel.AutoRenew(),
+	}
+}
+
+// findUserSubscription loads a subscription of the user. Another user's subscription is
+// reported as missing so its existence is not disclosed.
+func findUserSubscription(
+	ctx context.Context,
+	subscriptionRepository repository.SubscriptionRepository,
+	logger logger.Logger,
+	userID, subscriptionID uint64,
+) (model.SubscriptionModel, error) {
+	subscriptionModel, err := subscriptionRepository.FindByID(ctx, subscriptionID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrSubscriptionNotFound) {
+			message := "error finding subscription by id"
+			logger.Error(message, "error", err, "subscriptionID", subscriptionID)
+		}
+		return model.SubscriptionModel{}, err
+	}
+
+	if subscriptionModel.UserID() != userID {
+		return model.SubscriptionModel{}, errs.ErrSubscriptionNotFound
+	}
+
+	return subscriptionModel, nil
+}
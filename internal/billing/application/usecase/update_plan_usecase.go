@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UpdatePlanUseCase struct {
+	planRepository repository.PlanRepository
+	validate       validator.Validate
+	logger         logger.Logger
+}
+
+func NewUpdatePlanUseCase(
+	planRepository repository.PlanRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UpdatePlanUseCase {
+	return &UpdatePlanUseCase{planRepository, validate, logger}
+}
+
+type UpdatePlanInput struct {
+	PlanID               uint64 `validate:"required,number"`
+	Name                 string `validate:"required"`
+	Description          string
+	AmountCents          uint   `validate:"required"`
+	Currency             string `validate:"required"`
+	Interval             string `validate:"required"`
+	TrialPeriodDays      *uint
+	MaxConcurrentStreams uint   `validate:"required"`
+	MaxResolution        string `validate:"required"`
+	MaxDownloads         uint
+	MaxProfiles          uint `validate:"required"`
+}
+
+func (uc *UpdatePlanUseCase) Execute(ctx context.Context, input UpdatePlanInput) (PlanOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UpdatePlanUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	entitlements, err := model.CreateEntitlementsModel(
+		input.MaxConcurrentStreams,
+		input.MaxResolution,
+		input.MaxDownloads,
+		input.MaxProfiles,
+	)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	planModel, err := uc.planRepository.FindByID(ctx, input.PlanID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlanNotFound) {
+			message := "error finding plan by id"
+			uc.logger.Error(message, "error", err, "planID", input.PlanID)
+		}
+		return PlanOutput{}, err
+	}
+
+	err = planModel.Update(
+		input.Name,
+		input.Description,
+		input.Currency,
+		input.Interval,
+		input.AmountCents,
+		input.TrialPeriodDays,
+		entitlements,
+	)
+	if err != nil {
+		return PlanOutput{}, err
+	}
+
+	err = uc.planRepository.Update(ctx, planModel)
+	if err != nil {
+		message := "error updating plan"
+		uc.logger.Error(message, "error", err, "planID", input.PlanID)
+		return PlanOutput{}, err
+	}
+
+	return newPlanOutput(planModel), nil
+}
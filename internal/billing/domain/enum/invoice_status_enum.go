@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+const (
+	EnumInvoiceStatusOpen string = "Open"
+	EnumInvoiceStatusPaid string = "Paid"
+	EnumInvoiceStatusVoid string = "Void"
+)
+
+type InvoiceStatusEnum struct {
+	value string
+}
+
+func NewInvoiceStatusEnum(value string) (InvoiceStatusEnum, error) {
+	if err := validateInvoiceStatusEnum(value); err != nil {
+		return InvoiceStatusEnum{}, err
+	}
+
+	return InvoiceStatusEnum{value: value}, nil
+}
+
+func (s *InvoiceStatusEnum) String() string {
+	return s.value
+}
+
+func validateInvoiceStatusEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumInvoiceStatusOpen: {},
+		EnumInvoiceStatusPaid: {},
+		EnumInvoiceStatusVoid: {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidInvoiceStatus, value)
+	}
+
+	return nil
+}
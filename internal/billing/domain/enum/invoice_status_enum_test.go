@@ -0,0 +1,83 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInvoiceStatusEnum(t *testing.T) {
+	t.Run("valid open status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumInvoiceStatusOpen
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid paid status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumInvoiceStatusPaid
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid void status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumInvoiceStatusVoid
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("invalid status returns error", func(t *testing.T) {
+		// Arrange
+		value := "InvalidStatus"
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidInvoiceStatus)
+		require.Equal(t, "", result.String())
+	})
+
+	t.Run("empty string returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidInvoiceStatus)
+		require.Equal(t, "", result.String())
+	})
+
+	t.Run("case sensitive validation returns error for lowercase", func(t *testing.T) {
+		// Arrange
+		value := "paid"
+
+		// Act
+		result, err := enum.NewInvoiceStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidInvoiceStatus)
+		require.Equal(t, "", result.String())
+	})
+}
@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+const (
+	EnumPaymentStatusSucceeded string = "Succeeded"
+	EnumPaymentStatusFailed    string = "Failed"
+	EnumPaymentStatusRefunded  string = "Refunded"
+)
+
+type PaymentStatusEnum struct {
+	value string
+}
+
+func NewPaymentStatusEnum(value string) (PaymentStatusEnum, error) {
+	if err := validatePaymentStatusEnum(value); err != nil {
+		return PaymentStatusEnum{}, err
+	}
+
+	return PaymentStatusEnum{value: value}, nil
+}
+
+func (s *PaymentStatusEnum) String() string {
+	return s.value
+}
+
+func validatePaymentStatusEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumPaymentStatusSucceeded: {},
+		EnumPaymentStatusFailed:    {},
+		EnumPaymentStatusRefunded:  {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidPaymentStatus, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPaymentStatusEnum(t *testing.T) {
+	t.Run("valid succeeded status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumPaymentStatusSucceeded
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid failed status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumPaymentStatusFailed
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid refunded status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumPaymentStatusRefunded
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("invalid status returns error", func(t *testing.T) {
+		// Arrange
+		value := "InvalidStatus"
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidPaymentStatus)
+		require.Equal(t, "", result.String())
+	})
+
+	t.Run("empty string returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidPaymentStatus)
+		require.Equal(t, "", result.String())
+	})
+
+	t.Run("case sensitive validation returns error for lowercase", func(t *testing.T) {
+		// Arrange
+		value := "succeeded"
+
+		// Act
+		result, err := enum.NewPaymentStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidPaymentStatus)
+		require.Equal(t, "", result.String())
+	})
+}
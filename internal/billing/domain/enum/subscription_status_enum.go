@@ -12,8 +12,46 @@ const (
 	EnumSubscriptionStatusCancelled string = "Cancelled"
 	EnumSubscriptionStatusExpired   string = "Expired"
 	EnumSubscriptionStatusPastDue   string = "PastDue"
+	EnumSubscriptionStatusTrialing  string = "Trialing"
+	EnumSubscriptionStatusPaused    string = "Paused"
 )
 
+// subscriptionStatusTransitions is the subscription state machine: the statuses each
+// status can move to. Cancelled and Expired are final, the user subscribes again instead.
+var subscriptionStatusTransitions = map[string][]string{
+	EnumSubscriptionStatusInactive: {
+		EnumSubscriptionStatusActive,
+		EnumSubscriptionStatusPastDue,
+		EnumSubscriptionStatusCancelled,
+	},
+	EnumSubscriptionStatusTrialing: {
+		EnumSubscriptionStatusActive,
+		EnumSubscriptionStatusPastDue,
+		EnumSubscriptionStatusCancelled,
+		EnumSubscriptionStatusExpired,
+	},
+	EnumSubscriptionStatusActive: {
+		EnumSubscriptionStatusActive,
+		EnumSubscriptionStatusPastDue,
+		EnumSubscriptionStatusPaused,
+		EnumSubscriptionStatusCancelled,
+		EnumSubscriptionStatusExpired,
+	},
+	EnumSubscriptionStatusPastDue: {
+		EnumSubscriptionStatusActive,
+		EnumSubscriptionStatusPastDue,
+		EnumSubscriptionStatusCancelled,
+		EnumSubscriptionStatusExpired,
+	},
+	EnumSubscriptionStatusPaused: {
+		EnumSubscriptionStatusActive,
+		EnumSubscriptionStatusCancelled,
+		EnumSubscriptionStatusExpired,
+	},
+	EnumSubscriptionStatusCancelled: {},
+	EnumSubscriptionStatusExpired:   {},
+}
+
 type SubscriptionStatusEnum struct {
 	value string
 }
@@ -30,6 +68,16 @@ func (s *SubscriptionStatusEnum) String() string {
 	return s.value
 }
 
+// CanTransitionTo reports whether a subscription in this status may move to next.
+func (s *SubscriptionStatusEnum) CanTransitionTo(next SubscriptionStatusEnum) bool {
+	for _, allowed := range subscriptionStatusTransitions[s.value] {
+		if allowed == next.value {
+			return true
+		}
+	}
+	return false
+}
+
 func validateSubscriptionStatusEnum(value string) error {
 	allowedValues := map[string]struct{}{
 		EnumSubscriptionStatusActive:    {},
@@ -37,6 +85,8 @@ func validateSubscriptionStatusEnum(value string) error {
 		EnumSubscriptionStatusCancelled: {},
 		EnumSubscriptionStatusExpired:   {},
 		EnumSubscriptionStatusPastDue:   {},
+		EnumSubscriptionStatusTrialing:  {},
+		EnumSubscriptionStatusPaused:    {},
 	}
 
 	if _, ok := allowedValues[value]; !ok {
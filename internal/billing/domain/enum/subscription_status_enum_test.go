@@ -69,6 +69,30 @@ func TestNewSubscriptionStatusEnum(t *testing.T) {
 		require.Equal(t, value, result.String())
 	})
 
+	t.Run("valid trialing status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumSubscriptionStatusTrialing
+
+		// Act
+		result, err := enum.NewSubscriptionStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid paused status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumSubscriptionStatusPaused
+
+		// Act
+		result, err := enum.NewSubscriptionStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
 	t.Run("invalid status returns error", func(t *testing.T) {
 		// Arrange
 		value := "InvalidStatus"
@@ -130,4 +154,104 @@ func TestSubscriptionStatusEnum_String(t *testing.T) {
 		// Assert
 		require.Equal(t, enum.EnumSubscriptionStatusCancelled, result)
 	})
-} 
\ No newline at end of file
+} 
+
+func TestSubscriptionStatusEnum_CanTransitionTo(t *testing.T) {
+	t.Run("active can be paused", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPaused)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.True(t, result)
+	})
+
+	t.Run("paused can be resumed", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPaused)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.True(t, result)
+	})
+
+	t.Run("past due can be settled", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPastDue)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.True(t, result)
+	})
+
+	t.Run("trialing cannot be paused", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusTrialing)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPaused)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.False(t, result)
+	})
+
+	t.Run("paused cannot become past due", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPaused)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusPastDue)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.False(t, result)
+	})
+
+	t.Run("expired cannot be reactivated", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusExpired)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.False(t, result)
+	})
+
+	t.Run("cancelled cannot be reactivated", func(t *testing.T) {
+		// Arrange
+		from, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusCancelled)
+		require.NoError(t, err)
+		to, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+		require.NoError(t, err)
+
+		// Act
+		result := from.CanTransitionTo(to)
+
+		// Assert
+		require.False(t, result)
+	})
+}
@@ -0,0 +1,61 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+const (
+	EnumVideoResolutionSD  string = "480p"
+	EnumVideoResolutionHD  string = "720p"
+	EnumVideoResolutionFHD string = "1080p"
+	EnumVideoResolutionUHD string = "2160p"
+)
+
+type VideoResolutionEnum struct {
+	value string
+}
+
+func NewVideoResolutionEnum(value string) (VideoResolutionEnum, error) {
+	if err := validateVideoResolutionEnum(value); err != nil {
+		return VideoResolutionEnum{}, err
+	}
+
+	return VideoResolutionEnum{value: value}, nil
+}
+
+func (e *VideoResolutionEnum) String() string {
+	return e.value
+}
+
+// Height is the vertical resolution in pixels, e.g. 1080 for 1080p.
+func (e *VideoResolutionEnum) Height() uint {
+	switch e.value {
+	case EnumVideoResolutionSD:
+		return 480
+	case EnumVideoResolutionHD:
+		return 720
+	case EnumVideoResolutionFHD:
+		return 1080
+	case EnumVideoResolutionUHD:
+		return 2160
+	default:
+		return 0
+	}
+}
+
+func validateVideoResolutionEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumVideoResolutionSD:  {},
+		EnumVideoResolutionHD:  {},
+		EnumVideoResolutionFHD: {},
+		EnumVideoResolutionUHD: {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidVideoResolution, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+func TestNewVideoResolutionEnum(t *testing.T) {
+	t.Run("valid resolution returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumVideoResolutionFHD
+
+		// Act
+		result, err := enum.NewVideoResolutionEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("invalid resolution returns error", func(t *testing.T) {
+		// Arrange
+		value := "8K"
+
+		// Act
+		result, err := enum.NewVideoResolutionEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoResolution)
+		require.Equal(t, "", result.String())
+	})
+
+	t.Run("empty string returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		result, err := enum.NewVideoResolutionEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoResolution)
+		require.Equal(t, "", result.String())
+	})
+}
+
+func TestVideoResolutionEnum_Height(t *testing.T) {
+	t.Run("returns the height of sd", func(t *testing.T) {
+		// Arrange
+		resolution, err := enum.NewVideoResolutionEnum(enum.EnumVideoResolutionSD)
+		require.NoError(t, err)
+
+		// Act
+		result := resolution.Height()
+
+		// Assert
+		require.Equal(t, uint(480), result)
+	})
+
+	t.Run("returns the height of uhd", func(t *testing.T) {
+		// Arrange
+		resolution, err := enum.NewVideoResolutionEnum(enum.EnumVideoResolutionUHD)
+		require.NoError(t, err)
+
+		// Act
+		result := resolution.Height()
+
+		// Assert
+		require.Equal(t, uint(2160), result)
+	})
+}
@@ -3,7 +3,8 @@ package errs
 import "errors"
 
 var (
-	ErrPlanNotFound = errors.New("plan not found")
+	ErrPlanNotFound         = errors.New("plan not found")
+	ErrPlanHasSubscriptions = errors.New("plan has subscriptions and cannot be deleted")
 
 	ErrAmountExceedsMaximum = errors.New("amount exceeds maximum allowed value")
 
@@ -38,14 +39,55 @@ var (
 	ErrTrialPeriodTooShort = errors.New("trial period must be at least 1 day")
 	ErrTrialPeriodTooLong  = errors.New("trial period cannot exceed 365 days")
 
+	ErrMaxConcurrentStreamsOutOfRange = errors.New("max concurrent streams must be between 1 and 10")
+	ErrMaxProfilesOutOfRange          = errors.New("max profiles must be between 1 and 10")
+	ErrMaxDownloadsTooHigh            = errors.New("max downloads cannot exceed 100")
+
 	ErrSubscriptionNotFound = errors.New("subscription not found")
 
 	ErrUserIDRequired         = errors.New("user ID is required")
 	ErrPlanIDRequired         = errors.New("plan ID is required")
+	ErrSubscriptionIDRequired = errors.New("subscription ID is required")
+	ErrInvoiceIDRequired      = errors.New("invoice ID is required")
 	ErrStartDateRequired      = errors.New("start date is required")
 	ErrEndDateBeforeStartDate = errors.New("end date cannot be before start date")
+	ErrTrialEndDateRequired   = errors.New("trial end date must be after the start date")
+
+	ErrSubscriptionNotTrialing  = errors.New("subscription is not in a trial period")
+	ErrSubscriptionNotRenewable = errors.New("subscription cannot be renewed in its current status")
+	ErrSubscriptionNotActive    = errors.New("subscription is not active")
+	ErrSubscriptionNotPaused    = errors.New("subscription is not paused")
+
+	ErrInvalidSubscriptionStatusTransition = errors.New("invalid subscription status transition")
+
+	ErrSubscriptionAlreadyOnPlan = errors.New("subscription is already on this plan")
+	ErrPlanIntervalMismatch      = errors.New("plan change requires a plan with the same billing interval")
+	ErrPlanCurrencyMismatch      = errors.New("plan change requires a plan with the same currency")
+	ErrPlanChangeRequiresEndDate = errors.New("plan change is not available for subscriptions without an end date")
+
+	ErrPaymentMethodRequired      = errors.New("payment method is required")
+	ErrPaymentDeclined            = errors.New("payment was declined")
+	ErrPaymentMethodNotFound      = errors.New("payment method not found")
+	ErrPaymentCustomerNotFound    = errors.New("payment customer not found")
+	ErrPaymentNotFound            = errors.New("payment not found")
+	ErrAuthorizationNotFound      = errors.New("payment authorization not found")
+	ErrAuthorizationAlreadyUsed   = errors.New("payment authorization has already been captured")
+	ErrRefundExceedsPaymentAmount = errors.New("refund amount exceeds the captured amount")
+
+	ErrInvoiceNotFound              = errors.New("invoice not found")
+	ErrInvoiceLinesRequired         = errors.New("invoice must have at least one line")
+	ErrInvoiceLineDescriptionEmpty  = errors.New("invoice line description is required")
+	ErrInvoiceLineDescriptionLength = errors.New("invoice line description cannot exceed 255 characters")
+	ErrInvoiceLineQuantityRequired  = errors.New("invoice line quantity must be at least 1")
+	ErrInvoiceNotOpen               = errors.New("invoice is not open")
+	ErrPaymentProviderIDRequired    = errors.New("payment provider id is required")
 
 	ErrInvalidSubscriptionStatus        = errors.New("invalid subscription status")
 	ErrInvalidPlanInterval              = errors.New("invalid plan interval")
+	ErrInvalidVideoResolution           = errors.New("invalid video resolution")
+	ErrInvalidInvoiceStatus             = errors.New("invalid invoice status")
+	ErrInvalidPaymentStatus             = errors.New("invalid payment status")
 	ErrUserAlreadyHasActiveSubscription = errors.New("user already has an active subscription")
+	ErrUserHasPausedSubscription        = errors.New("user has a paused subscription, resume it instead")
+	ErrTrialAlreadyUsed                 = errors.New("user has already used the trial period")
 )
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
@@ -9,6 +10,8 @@ import (
 
 const (
 	currencyCodeLength = 3
+	defaultMinorUnits  = 2
+	decimalBase        = 10
 )
 
 // CurrencyModel represents an ISO 4217 currency with complete information
@@ -45,6 +48,40 @@ func (c *CurrencyModel) Number() string {
 	return c.number
 }
 
+// MinorUnits returns the number of decimal places used by the currency (ISO 4217 minor unit)
+func (c *CurrencyModel) MinorUnits() uint {
+	if units, ok := currencyMinorUnits[c.code]; ok {
+		return units
+	}
+	return defaultMinorUnits
+}
+
+// FormatAmount formats an amount expressed in the smallest currency unit using the currency decimal places,
+// e.g. 1999 cents in USD is "19.99" and 1999 in JPY is "1999".
+func (c *CurrencyModel) FormatAmount(cents uint) string {
+	minorUnits := c.MinorUnits()
+	if minorUnits == 0 {
+		return strconv.FormatUint(uint64(cents), decimalBase)
+	}
+
+	divisor := uint64(1)
+	for range minorUnits {
+		divisor *= decimalBase
+	}
+
+	value := uint64(cents)
+	return fmt.Sprintf("%d.%0*d", value/divisor, int(minorUnits), value%divisor)
+}
+
+// currencyMinorUnits lists the currencies that do not use two decimal places
+var currencyMinorUnits = map[string]uint{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"XDR": 0, "XSU": 0, "XUA": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4,
+}
+
 type currencyInfo struct {
 	country  string
 	currency string
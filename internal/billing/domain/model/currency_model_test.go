@@ -209,3 +209,56 @@ func TestCreateCurrencyModel_AllSupportedCurrencies(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrencyModel_FormatAmount(t *testing.T) {
+	t.Run("two decimal currency formats cents", func(t *testing.T) {
+		// Arrange
+		currency, err := model.CreateCurrencyModel("USD")
+		require.NoError(t, err)
+
+		// Act
+		result := currency.FormatAmount(1999)
+
+		// Assert
+		assert.Equal(t, uint(2), currency.MinorUnits())
+		assert.Equal(t, "19.99", result)
+	})
+
+	t.Run("two decimal currency pads small amounts", func(t *testing.T) {
+		// Arrange
+		currency, err := model.CreateCurrencyModel("EUR")
+		require.NoError(t, err)
+
+		// Act
+		result := currency.FormatAmount(5)
+
+		// Assert
+		assert.Equal(t, "0.05", result)
+	})
+
+	t.Run("zero decimal currency formats whole units", func(t *testing.T) {
+		// Arrange
+		currency, err := model.CreateCurrencyModel("JPY")
+		require.NoError(t, err)
+
+		// Act
+		result := currency.FormatAmount(1999)
+
+		// Assert
+		assert.Equal(t, uint(0), currency.MinorUnits())
+		assert.Equal(t, "1999", result)
+	})
+
+	t.Run("three decimal currency formats thousandths", func(t *testing.T) {
+		// Arrange
+		currency, err := model.CreateCurrencyModel("KWD")
+		require.NoError(t, err)
+
+		// Act
+		result := currency.FormatAmount(1999)
+
+		// Assert
+		assert.Equal(t, uint(3), currency.MinorUnits())
+		assert.Equal(t, "1.999", result)
+	})
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+const (
+	minConcurrentStreams = 1
+	maxConcurrentStreams = 10
+	minProfiles          = 1
+	maxProfiles          = 10
+	maxDownloads         = 100
+)
+
+// EntitlementsModel holds the features a plan gives access to. Other modules enforce them,
+// billing only stores them.
+type EntitlementsModel struct {
+	maxConcurrentStreams uint
+	maxResolution        enum.VideoResolutionEnum
+	maxDownloads         uint
+	maxProfiles          uint
+}
+
+func CreateEntitlementsModel(
+	maxConcurrentStreams uint,
+	maxResolution string,
+	maxDownloads uint,
+	maxProfiles uint,
+) (EntitlementsModel, error) {
+	if err := validateEntitlements(maxConcurrentStreams, maxDownloads, maxProfiles); err != nil {
+		return EntitlementsModel{}, err
+	}
+
+	resolution, err := enum.NewVideoResolutionEnum(maxResolution)
+	if err != nil {
+		return EntitlementsModel{}, err
+	}
+
+	return EntitlementsModel{
+		maxConcurrentStreams: maxConcurrentStreams,
+		maxResolution:        resolution,
+		maxDownloads:         maxDownloads,
+		maxProfiles:          maxProfiles,
+	}, nil
+}
+
+func (e *EntitlementsModel) MaxConcurrentStreams() uint {
+	return e.maxConcurrentStreams
+}
+
+func (e *EntitlementsModel) MaxResolution() enum.VideoResolutionEnum {
+	return e.maxResolution
+}
+
+// MaxDownloads is the number of titles that can be kept offline at once, zero disables downloads.
+func (e *EntitlementsModel) MaxDownloads() uint {
+	return e.maxDownloads
+}
+
+func (e *EntitlementsModel) MaxProfiles() uint {
+	return e.maxProfiles
+}
+
+func (e *EntitlementsModel) DownloadsAllowed() bool {
+	return e.maxDownloads > 0
+}
+
+func validateEntitlements(concurrentStreams, downloads, profiles uint) error {
+	if concurrentStreams < minConcurrentStreams || concurrentStreams > maxConcurrentStreams {
+		return errs.ErrMaxConcurrentStreamsOutOfRange
+	}
+
+	if profiles < minProfiles || profiles > maxProfiles {
+		return errs.ErrMaxProfilesOutOfRange
+	}
+
+	if downloads > maxDownloads {
+		return errs.ErrMaxDownloadsTooHigh
+	}
+
+	return nil
+}
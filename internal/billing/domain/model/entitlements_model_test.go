@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+func TestCreateEntitlementsModel(t *testing.T) {
+	t.Run("valid entitlements return model", func(t *testing.T) {
+		// Arrange
+		streams := uint(4)
+		resolution := "2160p"
+		downloads := uint(25)
+		profiles := uint(5)
+
+		// Act
+		result, err := model.CreateEntitlementsModel(streams, resolution, downloads, profiles)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, streams, result.MaxConcurrentStreams())
+		maxResolution := result.MaxResolution()
+		require.Equal(t, resolution, maxResolution.String())
+		require.Equal(t, downloads, result.MaxDownloads())
+		require.Equal(t, profiles, result.MaxProfiles())
+		require.True(t, result.DownloadsAllowed())
+	})
+
+	t.Run("zero downloads disables downloads", func(t *testing.T) {
+		// Act
+		result, err := model.CreateEntitlementsModel(1, "720p", 0, 1)
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, result.DownloadsAllowed())
+	})
+
+	t.Run("zero concurrent streams returns error", func(t *testing.T) {
+		// Act
+		result, err := model.CreateEntitlementsModel(0, "720p", 0, 1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrMaxConcurrentStreamsOutOfRange)
+		require.Equal(t, model.EntitlementsModel{}, result)
+	})
+
+	t.Run("too many concurrent streams returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEntitlementsModel(11, "720p", 0, 1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrMaxConcurrentStreamsOutOfRange)
+	})
+
+	t.Run("zero profiles returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEntitlementsModel(1, "720p", 0, 0)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrMaxProfilesOutOfRange)
+	})
+
+	t.Run("too many profiles returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEntitlementsModel(1, "720p", 0, 11)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrMaxProfilesOutOfRange)
+	})
+
+	t.Run("too many downloads returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEntitlementsModel(1, "720p", 101, 1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrMaxDownloadsTooHigh)
+	})
+
+	t.Run("invalid resolution returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEntitlementsModel(1, "4K", 0, 1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoResolution)
+	})
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+const maxInvoiceLineDescriptionLength = 255
+
+type InvoiceLineModel struct {
+	id          uint64
+	invoiceID   uint64
+	description string
+	quantity    uint
+	unitAmount  AmountModel
+	amount      AmountModel
+	createdAt   time.Time
+}
+
+func CreateInvoiceLineModel(description string, quantity uint, unitAmountCents uint) (InvoiceLineModel, error) {
+	description = strings.TrimSpace(description)
+
+	line, err := buildInvoiceLineModel(description, quantity, unitAmountCents)
+	if err != nil {
+		return InvoiceLineModel{}, err
+	}
+
+	line.createdAt = time.Now().UTC()
+	return line, nil
+}
+
+func RestoreInvoiceLineModel(
+	id, invoiceID uint64,
+	description string,
+	quantity uint,
+	unitAmountCents uint,
+	createdAt time.Time,
+) (InvoiceLineModel, error) {
+	line, err := buildInvoiceLineModel(description, quantity, unitAmountCents)
+	if err != nil {
+		return InvoiceLineModel{}, err
+	}
+
+	line.id = id
+	line.invoiceID = invoiceID
+	line.createdAt = createdAt
+	return line, nil
+}
+
+func (l *InvoiceLineModel) ID() uint64 {
+	return l.id
+}
+
+func (l *InvoiceLineModel) InvoiceID() uint64 {
+	return l.invoiceID
+}
+
+func (l *InvoiceLineModel) Description() string {
+	return l.description
+}
+
+func (l *InvoiceLineModel) Quantity() uint {
+	return l.quantity
+}
+
+func (l *InvoiceLineModel) UnitAmount() AmountModel {
+	return l.unitAmount
+}
+
+// Amount is the line total, quantity times the unit amount.
+func (l *InvoiceLineModel) Amount() AmountModel {
+	return l.amount
+}
+
+func (l *InvoiceLineModel) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+func buildInvoiceLineModel(description string, quantity uint, unitAmountCents uint) (InvoiceLineModel, error) {
+	if description == "" {
+		return InvoiceLineModel{}, errs.ErrInvoiceLineDescriptionEmpty
+	}
+
+	if utf8.RuneCountInString(description) > maxInvoiceLineDescriptionLength {
+		return InvoiceLineModel{}, errs.ErrInvoiceLineDescriptionLength
+	}
+
+	if quantity == 0 {
+		return InvoiceLineModel{}, errs.ErrInvoiceLineQuantityRequired
+	}
+
+	unitAmount, err := CreateAmountModel(unitAmountCents)
+	if err != nil {
+		return InvoiceLineModel{}, err
+	}
+
+	// Compared by division so a large quantity cannot overflow the multiplication
+	if unitAmountCents > 0 && quantity > maxAmountCents/unitAmountCents {
+		return InvoiceLineModel{}, errs.ErrAmountExceedsMaximum
+	}
+
+	amount, err := CreateAmountModel(unitAmountCents * quantity)
+	if err != nil {
+		return InvoiceLineModel{}, err
+	}
+
+	return InvoiceLineModel{
+		description: description,
+		quantity:    quantity,
+		unitAmount:  unitAmount,
+		amount:      amount,
+	}, nil
+}
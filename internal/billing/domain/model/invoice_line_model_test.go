@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+func TestCreateInvoiceLineModel(t *testing.T) {
+	t.Run("valid line computes the amount from quantity and unit amount", func(t *testing.T) {
+		// Arrange
+		description := "Premium Plan (Month)"
+
+		// Act
+		result, err := model.CreateInvoiceLineModel(description, 3, 1999)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, description, result.Description())
+		require.Equal(t, uint(3), result.Quantity())
+		unitAmount := result.UnitAmount()
+		require.Equal(t, uint(1999), unitAmount.Cents())
+		amount := result.Amount()
+		require.Equal(t, uint(5997), amount.Cents())
+		require.NotZero(t, result.CreatedAt())
+	})
+
+	t.Run("description is trimmed", func(t *testing.T) {
+		// Act
+		result, err := model.CreateInvoiceLineModel("  Basic Plan  ", 1, 999)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "Basic Plan", result.Description())
+	})
+
+	t.Run("empty description returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceLineModel("   ", 1, 999)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceLineDescriptionEmpty)
+	})
+
+	t.Run("description too long returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceLineModel(strings.Repeat("a", 256), 1, 999)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceLineDescriptionLength)
+	})
+
+	t.Run("zero quantity returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceLineModel("Basic Plan", 0, 999)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceLineQuantityRequired)
+	})
+
+	t.Run("line amount above the maximum returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceLineModel("Basic Plan", 2, 999999999)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrAmountExceedsMaximum)
+	})
+}
+
+func TestRestoreInvoiceLineModel(t *testing.T) {
+	t.Run("valid input restores the line", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now().UTC().Add(-time.Hour)
+
+		// Act
+		result, err := model.RestoreInvoiceLineModel(5, 10, "Basic Plan", 1, 999, createdAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), result.ID())
+		require.Equal(t, uint64(10), result.InvoiceID())
+		amount := result.Amount()
+		require.Equal(t, uint(999), amount.Cents())
+		require.Equal(t, createdAt, result.CreatedAt())
+	})
+}
@@ -0,0 +1,214 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+// InvoiceModel bills one period of a subscription. The total is the sum of its lines
+// and is always expressed in the invoice currency.
+type InvoiceModel struct {
+	id             uint64
+	userID         uint64
+	subscriptionID uint64
+	status         enum.InvoiceStatusEnum
+	currency       CurrencyModel
+	total          AmountModel
+	periodStart    time.Time
+	periodEnd      *time.Time
+	lines          []InvoiceLineModel
+	paidAt         *time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func CreateInvoiceModel(
+	userID, subscriptionID uint64,
+	currency string,
+	periodStart time.Time,
+	periodEnd *time.Time,
+	lines []InvoiceLineModel,
+) (InvoiceModel, error) {
+	statusEnum, err := enum.NewInvoiceStatusEnum(enum.EnumInvoiceStatusOpen)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	invoice, err := buildInvoiceModel(userID, subscriptionID, currency, periodStart, periodEnd, lines)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	invoice.status = statusEnum
+	invoice.createdAt = time.Now().UTC()
+	invoice.updatedAt = time.Now().UTC()
+	return invoice, nil
+}
+
+func RestoreInvoiceModel(
+	id, userID, subscriptionID uint64,
+	status string,
+	currency string,
+	periodStart time.Time,
+	periodEnd *time.Time,
+	lines []InvoiceLineModel,
+	paidAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (InvoiceModel, error) {
+	statusEnum, err := enum.NewInvoiceStatusEnum(status)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	invoice, err := buildInvoiceModel(userID, subscriptionID, currency, periodStart, periodEnd, lines)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	invoice.id = id
+	invoice.status = statusEnum
+	invoice.paidAt = paidAt
+	invoice.createdAt = createdAt
+	invoice.updatedAt = updatedAt
+	return invoice, nil
+}
+
+func (i *InvoiceModel) ID() uint64 {
+	return i.id
+}
+
+func (i *InvoiceModel) UserID() uint64 {
+	return i.userID
+}
+
+func (i *InvoiceModel) SubscriptionID() uint64 {
+	return i.subscriptionID
+}
+
+func (i *InvoiceModel) Status() enum.InvoiceStatusEnum {
+	return i.status
+}
+
+func (i *InvoiceModel) Currency() CurrencyModel {
+	return i.currency
+}
+
+func (i *InvoiceModel) Total() AmountModel {
+	return i.total
+}
+
+func (i *InvoiceModel) PeriodStart() time.Time {
+	return i.periodStart
+}
+
+func (i *InvoiceModel) PeriodEnd() *time.Time {
+	return i.periodEnd
+}
+
+func (i *InvoiceModel) Lines() []InvoiceLineModel {
+	return i.lines
+}
+
+func (i *InvoiceModel) PaidAt() *time.Time {
+	return i.paidAt
+}
+
+func (i *InvoiceModel) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *InvoiceModel) UpdatedAt() time.Time {
+	return i.updatedAt
+}
+
+func (i *InvoiceModel) IsPaid() bool {
+	return i.status.String() == enum.EnumInvoiceStatusPaid
+}
+
+func (i *InvoiceModel) MarkPaid(paidAt time.Time) error {
+	if i.status.String() != enum.EnumInvoiceStatusOpen {
+		return errs.ErrInvoiceNotOpen
+	}
+
+	statusEnum, err := enum.NewInvoiceStatusEnum(enum.EnumInvoiceStatusPaid)
+	if err != nil {
+		return err
+	}
+
+	i.status = statusEnum
+	i.paidAt = &paidAt
+	i.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Void cancels an invoice that will never be paid, e.g. when the subscription is cancelled.
+func (i *InvoiceModel) Void() error {
+	if i.status.String() != enum.EnumInvoiceStatusOpen {
+		return errs.ErrInvoiceNotOpen
+	}
+
+	statusEnum, err := enum.NewInvoiceStatusEnum(enum.EnumInvoiceStatusVoid)
+	if err != nil {
+		return err
+	}
+
+	i.status = statusEnum
+	i.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func buildInvoiceModel(
+	userID, subscriptionID uint64,
+	currency string,
+	periodStart time.Time,
+	periodEnd *time.Time,
+	lines []InvoiceLineModel,
+) (InvoiceModel, error) {
+	if userID == 0 {
+		return InvoiceModel{}, errs.ErrUserIDRequired
+	}
+
+	if subscriptionID == 0 {
+		return InvoiceModel{}, errs.ErrSubscriptionIDRequired
+	}
+
+	if periodStart.IsZero() {
+		return InvoiceModel{}, errs.ErrStartDateRequired
+	}
+
+	if periodEnd != nil && periodEnd.Before(periodStart) {
+		return InvoiceModel{}, errs.ErrEndDateBeforeStartDate
+	}
+
+	if len(lines) == 0 {
+		return InvoiceModel{}, errs.ErrInvoiceLinesRequired
+	}
+
+	currencyModel, err := CreateCurrencyModel(currency)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	var totalCents uint
+	for _, line := range lines {
+		amount := line.Amount()
+		totalCents += amount.Cents()
+	}
+
+	total, err := CreateAmountModel(totalCents)
+	if err != nil {
+		return InvoiceModel{}, err
+	}
+
+	return InvoiceModel{
+		userID:         userID,
+		subscriptionID: subscriptionID,
+		currency:       currencyModel,
+		total:          total,
+		periodStart:    periodStart,
+		periodEnd:      periodEnd,
+		lines:          lines,
+	}, nil
+}
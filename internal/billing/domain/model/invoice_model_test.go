@@ -0,0 +1,147 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+func newInvoiceLines(t *testing.T, unitAmountsCents ...uint) []model.InvoiceLineModel {
+	t.Helper()
+
+	lines := make([]model.InvoiceLineModel, 0, len(unitAmountsCents))
+	for _, unitAmountCents := range unitAmountsCents {
+		line, err := model.CreateInvoiceLineModel("Premium Plan (Month)", 1, unitAmountCents)
+		require.NoError(t, err)
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestCreateInvoiceModel(t *testing.T) {
+	t.Run("valid invoice is open and totals its lines", func(t *testing.T) {
+		// Arrange
+		periodStart := time.Now().UTC()
+		periodEnd := periodStart.AddDate(0, 1, 0)
+		lines := newInvoiceLines(t, 1999, 500)
+
+		// Act
+		result, err := model.CreateInvoiceModel(1, 2, "USD", periodStart, &periodEnd, lines)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), result.UserID())
+		require.Equal(t, uint64(2), result.SubscriptionID())
+		status := result.Status()
+		require.Equal(t, enum.EnumInvoiceStatusOpen, status.String())
+		currency := result.Currency()
+		require.Equal(t, "USD", currency.Code())
+		total := result.Total()
+		require.Equal(t, uint(2499), total.Cents())
+		require.Equal(t, periodStart, result.PeriodStart())
+		require.Equal(t, &periodEnd, result.PeriodEnd())
+		require.Len(t, result.Lines(), 2)
+		require.Nil(t, result.PaidAt())
+		require.False(t, result.IsPaid())
+	})
+
+	t.Run("invoice without lines returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceModel(1, 2, "USD", time.Now().UTC(), nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceLinesRequired)
+	})
+
+	t.Run("zero subscription id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceModel(1, 0, "USD", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionIDRequired)
+	})
+
+	t.Run("period end before period start returns error", func(t *testing.T) {
+		// Arrange
+		periodStart := time.Now().UTC()
+		periodEnd := periodStart.Add(-time.Hour)
+
+		// Act
+		_, err := model.CreateInvoiceModel(1, 2, "USD", periodStart, &periodEnd, newInvoiceLines(t, 999))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrEndDateBeforeStartDate)
+	})
+
+	t.Run("invalid currency returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateInvoiceModel(1, 2, "XXX", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrCurrencyCodeInvalid)
+	})
+}
+
+func TestInvoiceModel_MarkPaid(t *testing.T) {
+	t.Run("open invoice becomes paid", func(t *testing.T) {
+		// Arrange
+		invoice, err := model.CreateInvoiceModel(1, 2, "USD", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+		require.NoError(t, err)
+		paidAt := time.Now().UTC()
+
+		// Act
+		err = invoice.MarkPaid(paidAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, invoice.IsPaid())
+		require.Equal(t, &paidAt, invoice.PaidAt())
+	})
+
+	t.Run("paid invoice cannot be paid again", func(t *testing.T) {
+		// Arrange
+		invoice, err := model.CreateInvoiceModel(1, 2, "USD", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+		require.NoError(t, err)
+		require.NoError(t, invoice.MarkPaid(time.Now().UTC()))
+
+		// Act
+		err = invoice.MarkPaid(time.Now().UTC())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceNotOpen)
+	})
+}
+
+func TestInvoiceModel_Void(t *testing.T) {
+	t.Run("open invoice becomes void", func(t *testing.T) {
+		// Arrange
+		invoice, err := model.CreateInvoiceModel(1, 2, "USD", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+		require.NoError(t, err)
+
+		// Act
+		err = invoice.Void()
+
+		// Assert
+		require.NoError(t, err)
+		status := invoice.Status()
+		require.Equal(t, enum.EnumInvoiceStatusVoid, status.String())
+	})
+
+	t.Run("void invoice cannot be paid", func(t *testing.T) {
+		// Arrange
+		invoice, err := model.CreateInvoiceModel(1, 2, "USD", time.Now().UTC(), nil, newInvoiceLines(t, 999))
+		require.NoError(t, err)
+		require.NoError(t, invoice.Void())
+
+		// Act
+		err = invoice.MarkPaid(time.Now().UTC())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceNotOpen)
+	})
+}
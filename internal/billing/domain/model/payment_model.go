@@ -0,0 +1,166 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+)
+
+// PaymentModel records one attempt to pay an invoice through the payment gateway,
+// successful or not.
+type PaymentModel struct {
+	id                uint64
+	invoiceID         uint64
+	status            enum.PaymentStatusEnum
+	amount            AmountModel
+	currency          CurrencyModel
+	providerPaymentID string
+	failureReason     string
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+func CreateSucceededPaymentModel(
+	invoiceID uint64,
+	amountCents uint,
+	currency string,
+	providerPaymentID string,
+) (PaymentModel, error) {
+	if providerPaymentID == "" {
+		return PaymentModel{}, errs.ErrPaymentProviderIDRequired
+	}
+
+	return createPaymentModel(invoiceID, enum.EnumPaymentStatusSucceeded, amountCents, currency, providerPaymentID, "")
+}
+
+func CreateFailedPaymentModel(
+	invoiceID uint64,
+	amountCents uint,
+	currency string,
+	failureReason string,
+) (PaymentModel, error) {
+	return createPaymentModel(invoiceID, enum.EnumPaymentStatusFailed, amountCents, currency, "", failureReason)
+}
+
+// CreateRefundedPaymentModel records money given back on an invoice, e.g. the prorated
+// difference of a downgrade. The provider id is the refund id on the gateway side.
+func CreateRefundedPaymentModel(
+	invoiceID uint64,
+	amountCents uint,
+	currency string,
+	providerRefundID string,
+) (PaymentModel, error) {
+	if providerRefundID == "" {
+		return PaymentModel{}, errs.ErrPaymentProviderIDRequired
+	}
+
+	return createPaymentModel(invoiceID, enum.EnumPaymentStatusRefunded, amountCents, currency, providerRefundID, "")
+}
+
+func RestorePaymentModel(
+	id, invoiceID uint64,
+	status string,
+	amountCents uint,
+	currency string,
+	providerPaymentID string,
+	failureReason string,
+	createdAt, updatedAt time.Time,
+) (PaymentModel, error) {
+	payment, err := buildPaymentModel(invoiceID, status, amountCents, currency)
+	if err != nil {
+		return PaymentModel{}, err
+	}
+
+	payment.id = id
+	payment.providerPaymentID = providerPaymentID
+	payment.failureReason = failureReason
+	payment.createdAt = createdAt
+	payment.updatedAt = updatedAt
+	return payment, nil
+}
+
+func (p *PaymentModel) ID() uint64 {
+	return p.id
+}
+
+func (p *PaymentModel) InvoiceID() uint64 {
+	return p.invoiceID
+}
+
+func (p *PaymentModel) Status() enum.PaymentStatusEnum {
+	return p.status
+}
+
+func (p *PaymentModel) Amount() AmountModel {
+	return p.amount
+}
+
+func (p *PaymentModel) Currency() CurrencyModel {
+	return p.currency
+}
+
+// ProviderPaymentID is the payment id on the gateway side, used for refunds and reconciliation.
+func (p *PaymentModel) ProviderPaymentID() string {
+	return p.providerPaymentID
+}
+
+func (p *PaymentModel) FailureReason() string {
+	return p.failureReason
+}
+
+func (p *PaymentModel) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *PaymentModel) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func createPaymentModel(
+	invoiceID uint64,
+	status string,
+	amountCents uint,
+	currency string,
+	providerPaymentID string,
+	failureReason string,
+) (PaymentModel, error) {
+	payment, err := buildPaymentModel(invoiceID, status, amountCents, currency)
+	if err != nil {
+		return PaymentModel{}, err
+	}
+
+	payment.providerPaymentID = providerPaymentID
+	payment.failureReason = failureReason
+	payment.createdAt = time.Now().UTC()
+	payment.updatedAt = time.Now().UTC()
+	return payment, nil
+}
+
+func buildPaymentModel(invoiceID uint64, status string, amountCents uint, currency string) (PaymentModel, error) {
+	if invoiceID == 0 {
+		return PaymentModel{}, errs.ErrInvoiceIDRequired
+	}
+
+	statusEnum, err := enum.NewPaymentStatusEnum(status)
+	if err != nil {
+		return PaymentModel{}, err
+	}
+
+	amount, err := CreateAmountModel(amountCents)
+	if err != nil {
+		return PaymentModel{}, err
+	}
+
+	currencyModel, err := CreateCurrencyModel(currency)
+	if err != nil {
+		return PaymentModel{}, err
+	}
+
+	return PaymentModel{
+		invoiceID: invoiceID,
+		status:    statusEnum,
+		amount:    amount,
+		currency:  currencyModel,
+	}, nil
+}
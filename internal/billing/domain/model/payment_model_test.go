@@ -0,0 +1,115 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+func TestCreateSucceededPaymentModel(t *testing.T) {
+	t.Run("valid input returns succeeded payment", func(t *testing.T) {
+		// Act
+		result, err := model.CreateSucceededPaymentModel(1, 1999, "USD", "pay_123")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), result.InvoiceID())
+		status := result.Status()
+		require.Equal(t, enum.EnumPaymentStatusSucceeded, status.String())
+		amount := result.Amount()
+		require.Equal(t, uint(1999), amount.Cents())
+		currency := result.Currency()
+		require.Equal(t, "USD", currency.Code())
+		require.Equal(t, "pay_123", result.ProviderPaymentID())
+		require.Empty(t, result.FailureReason())
+	})
+
+	t.Run("missing provider payment id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateSucceededPaymentModel(1, 1999, "USD", "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrPaymentProviderIDRequired)
+	})
+
+	t.Run("zero invoice id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateSucceededPaymentModel(0, 1999, "USD", "pay_123")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvoiceIDRequired)
+	})
+}
+
+func TestCreateFailedPaymentModel(t *testing.T) {
+	t.Run("valid input returns failed payment with reason", func(t *testing.T) {
+		// Act
+		result, err := model.CreateFailedPaymentModel(1, 1999, "USD", "payment was declined")
+
+		// Assert
+		require.NoError(t, err)
+		status := result.Status()
+		require.Equal(t, enum.EnumPaymentStatusFailed, status.String())
+		require.Empty(t, result.ProviderPaymentID())
+		require.Equal(t, "payment was declined", result.FailureReason())
+	})
+}
+
+func TestCreateRefundedPaymentModel(t *testing.T) {
+	t.Run("valid input returns refunded payment", func(t *testing.T) {
+		// Act
+		result, err := model.CreateRefundedPaymentModel(1, 500, "USD", "re_123")
+
+		// Assert
+		require.NoError(t, err)
+		status := result.Status()
+		require.Equal(t, enum.EnumPaymentStatusRefunded, status.String())
+		amount := result.Amount()
+		require.Equal(t, uint(500), amount.Cents())
+		require.Equal(t, "re_123", result.ProviderPaymentID())
+	})
+
+	t.Run("missing provider refund id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateRefundedPaymentModel(1, 500, "USD", "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrPaymentProviderIDRequired)
+	})
+}
+
+func TestRestorePaymentModel(t *testing.T) {
+	t.Run("valid input restores the payment", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now().UTC().Add(-time.Hour)
+		updatedAt := time.Now().UTC()
+
+		// Act
+		result, err := model.RestorePaymentModel(
+			7, 1, enum.EnumPaymentStatusRefunded, 1999, "EUR", "pay_123", "", createdAt, updatedAt,
+		)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), result.ID())
+		status := result.Status()
+		require.Equal(t, enum.EnumPaymentStatusRefunded, status.String())
+		require.Equal(t, createdAt, result.CreatedAt())
+		require.Equal(t, updatedAt, result.UpdatedAt())
+	})
+
+	t.Run("invalid status returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestorePaymentModel(
+			7, 1, "Pending", 1999, "EUR", "", "", time.Now().UTC(), time.Now().UTC(),
+		)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidPaymentStatus)
+	})
+}
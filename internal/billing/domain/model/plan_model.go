@@ -8,21 +8,23 @@ import (
 )
 
 type PlanModel struct {
-	id          uint64
-	name        NameModel
-	description *DescriptionModel
-	amount      AmountModel
-	currency    CurrencyModel
-	interval    enum.PlanIntervalEnum
-	trialPeriod *TrialPeriodModel
-	createdAt   time.Time
-	updatedAt   time.Time
+	id           uint64
+	name         NameModel
+	description  *DescriptionModel
+	amount       AmountModel
+	currency     CurrencyModel
+	interval     enum.PlanIntervalEnum
+	trialPeriod  *TrialPeriodModel
+	entitlements EntitlementsModel
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
 func CreatePlanModel(
 	name, description, currency, interval string,
 	amountCents uint,
 	trialPeriod *uint,
+	entitlements EntitlementsModel,
 ) (PlanModel, error) {
 	name = strings.TrimSpace(name)
 	description = strings.TrimSpace(description)
@@ -67,14 +69,15 @@ func CreatePlanModel(
 	}
 
 	return PlanModel{
-		name:        nameModel,
-		description: descriptionModel,
-		amount:      amountModel,
-		currency:    currencyModel,
-		interval:    planInterval,
-		trialPeriod: trialPeriodModel,
-		createdAt:   time.Now().UTC(),
-		updatedAt:   time.Now().UTC(),
+		name:         nameModel,
+		description:  descriptionModel,
+		amount:       amountModel,
+		currency:     currencyModel,
+		interval:     planInterval,
+		trialPeriod:  trialPeriodModel,
+		entitlements: entitlements,
+		createdAt:    time.Now().UTC(),
+		updatedAt:    time.Now().UTC(),
 	}, nil
 }
 
@@ -83,6 +86,7 @@ func RestorePlanModel(
 	name, description, currency, interval string,
 	amountCents uint,
 	trialPeriod *uint,
+	entitlements EntitlementsModel,
 	createdAt, updatedAt time.Time,
 ) (PlanModel, error) {
 	nameModel, err := CreateNameModel(name)
@@ -124,15 +128,16 @@ func RestorePlanModel(
 	}
 
 	return PlanModel{
-		id:          id,
-		name:        nameModel,
-		description: descriptionModel,
-		amount:      amountModel,
-		currency:    currencyModel,
-		interval:    planInterval,
-		trialPeriod: trialPeriodModel,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
+		id:           id,
+		name:         nameModel,
+		description:  descriptionModel,
+		amount:       amountModel,
+		currency:     currencyModel,
+		interval:     planInterval,
+		trialPeriod:  trialPeriodModel,
+		entitlements: entitlements,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
 	}, nil
 }
 
@@ -164,6 +169,10 @@ func (p *PlanModel) TrialPeriod() *TrialPeriodModel {
 	return p.trialPeriod
 }
 
+func (p *PlanModel) Entitlements() EntitlementsModel {
+	return p.entitlements
+}
+
 func (p *PlanModel) CreatedAt() time.Time {
 	return p.createdAt
 }
@@ -171,3 +180,21 @@ func (p *PlanModel) CreatedAt() time.Time {
 func (p *PlanModel) UpdatedAt() time.Time {
 	return p.updatedAt
 }
+
+// Update replaces the plan details, applying the same validation as CreatePlanModel.
+func (p *PlanModel) Update(
+	name, description, currency, interval string,
+	amountCents uint,
+	trialPeriod *uint,
+	entitlements EntitlementsModel,
+) error {
+	updated, err := CreatePlanModel(name, description, currency, interval, amountCents, trialPeriod, entitlements)
+	if err != nil {
+		return err
+	}
+
+	updated.id = p.id
+	updated.createdAt = p.createdAt
+	*p = updated
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 )
 
@@ -20,7 +21,7 @@ func TestCreatePlanModel(t *testing.T) {
 		trialPeriod := uint(7)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod, newEntitlements(t))
 
 		// Assert
 		require.NoError(t, err)
@@ -51,7 +52,7 @@ func TestCreatePlanModel(t *testing.T) {
 		trialPeriod := uint(14)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod, newEntitlements(t))
 
 		// Assert
 		require.NoError(t, err)
@@ -77,7 +78,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(99999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.NoError(t, err)
@@ -103,7 +104,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(1999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.NoError(t, err)
@@ -123,7 +124,7 @@ func TestCreatePlanModel(t *testing.T) {
 
 		for _, interval := range validIntervals {
 			// Act
-			result, err := model.CreatePlanModel(name, "", currency, interval, amountCents, nil)
+			result, err := model.CreatePlanModel(name, "", currency, interval, amountCents, nil, newEntitlements(t))
 
 			// Assert
 			require.NoError(t, err)
@@ -141,7 +142,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -158,7 +159,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -179,7 +180,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(1000000000)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -196,7 +197,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -213,7 +214,7 @@ func TestCreatePlanModel(t *testing.T) {
 		amountCents := uint(999)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, nil, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -231,7 +232,7 @@ func TestCreatePlanModel(t *testing.T) {
 		trialPeriod := uint(0)
 
 		// Act
-		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod)
+		result, err := model.CreatePlanModel(name, description, currency, interval, amountCents, &trialPeriod, newEntitlements(t))
 
 		// Assert
 		require.Error(t, err)
@@ -262,6 +263,7 @@ func TestRestorePlanModel(t *testing.T) {
 			interval,
 			amountCents,
 			&trialPeriod,
+			newEntitlements(t),
 			createdAt,
 			updatedAt,
 		)
@@ -305,6 +307,7 @@ func TestRestorePlanModel(t *testing.T) {
 			interval,
 			amountCents,
 			nil,
+			newEntitlements(t),
 			createdAt,
 			updatedAt,
 		)
@@ -346,6 +349,7 @@ func TestRestorePlanModel(t *testing.T) {
 			interval,
 			amountCents,
 			nil,
+			newEntitlements(t),
 			createdAt,
 			updatedAt,
 		)
@@ -378,6 +382,7 @@ func TestPlanModel_Getters(t *testing.T) {
 			interval,
 			amountCents,
 			&trialPeriod,
+			newEntitlements(t),
 			createdAt,
 			updatedAt,
 		)
@@ -408,7 +413,7 @@ func TestPlanModel_Getters(t *testing.T) {
 		interval := "Year"
 		amountCents := uint(9999)
 
-		plan, err := model.CreatePlanModel(name, "", currency, interval, amountCents, nil)
+		plan, err := model.CreatePlanModel(name, "", currency, interval, amountCents, nil, newEntitlements(t))
 		require.NoError(t, err)
 
 		// Act & Assert
@@ -423,7 +428,61 @@ func TestPlanModel_Getters(t *testing.T) {
 		intervalModel := plan.Interval()
 		require.Equal(t, interval, intervalModel.String())
 		require.Nil(t, plan.TrialPeriod())
+		entitlements := plan.Entitlements()
+		require.Equal(t, uint(2), entitlements.MaxConcurrentStreams())
+		require.Equal(t, uint(3), entitlements.MaxProfiles())
 		require.True(t, plan.CreatedAt().After(time.Time{}))
 		require.True(t, plan.UpdatedAt().After(time.Time{}))
 	})
 }
+
+func TestPlanModel_Update(t *testing.T) {
+	t.Run("valid update replaces details and keeps identity", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now().UTC().Add(-time.Hour)
+		plan, err := model.RestorePlanModel(10, "Basic", "Basic plan", "USD", "Month", 999, nil, newEntitlements(t), createdAt, createdAt)
+		require.NoError(t, err)
+		trialPeriod := uint(7)
+
+		// Act
+		err = plan.Update("Premium", "Premium plan", "EUR", "Year", 9999, &trialPeriod, newEntitlements(t))
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), plan.ID())
+		nameModel := plan.Name()
+		require.Equal(t, "Premium", (&nameModel).String())
+		require.Equal(t, "Premium plan", plan.Description().String())
+		amountModel := plan.Amount()
+		require.Equal(t, uint(9999), (&amountModel).Cents())
+		currencyModel := plan.Currency()
+		require.Equal(t, "EUR", currencyModel.Code())
+		intervalModel := plan.Interval()
+		require.Equal(t, "Year", intervalModel.String())
+		require.Equal(t, trialPeriod, plan.TrialPeriod().Days())
+		require.Equal(t, createdAt, plan.CreatedAt())
+		require.True(t, plan.UpdatedAt().After(createdAt))
+	})
+
+	t.Run("invalid update returns error and keeps previous details", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Now().UTC().Add(-time.Hour)
+		plan, err := model.RestorePlanModel(10, "Basic", "Basic plan", "USD", "Month", 999, nil, newEntitlements(t), createdAt, createdAt)
+		require.NoError(t, err)
+
+		// Act
+		err = plan.Update("Premium", "", "XXX", "Year", 9999, nil, newEntitlements(t))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrCurrencyCodeInvalid)
+		currencyModel := plan.Currency()
+		require.Equal(t, "USD", currencyModel.Code())
+		require.Equal(t, createdAt, plan.UpdatedAt())
+	})
+}
+
+func newEntitlements(t *testing.T) model.EntitlementsModel {
+	entitlements, err := model.CreateEntitlementsModel(2, "1080p", 0, 3)
+	require.NoError(t, err)
+	return entitlements
+}
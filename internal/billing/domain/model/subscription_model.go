@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
@@ -8,17 +9,29 @@ import (
 )
 
 type SubscriptionModel struct {
-	id        uint64
-	userID    uint64
-	planID    uint64
-	status    enum.SubscriptionStatusEnum
-	startDate time.Time
-	endDate   *time.Time
-	autoRenew bool
-	createdAt time.Time
-	updatedAt time.Time
+	id           uint64
+	userID       uint64
+	planID       uint64
+	status       enum.SubscriptionStatusEnum
+	startDate    time.Time
+	endDate      *time.Time
+	trialEndDate *time.Time
+	autoRenew    bool
+	createdAt    time.Time
+	updatedAt    time.Time
+
+	paymentCustomerID string
+	paymentMethodID   string
+
+	retryCount  uint
+	nextRetryAt *time.Time
+
+	currentPeriodStart time.Time
+	pausedAt           *time.Time
 }
 
+// CreateSubscriptionModel creates an Inactive subscription. It becomes Active once the
+// first charge succeeds, or PastDue when it fails.
 func CreateSubscriptionModel(
 	userID, planID uint64,
 	startDate time.Time,
@@ -28,7 +41,7 @@ func CreateSubscriptionModel(
 		return SubscriptionModel{}, err
 	}
 
-	statusEnum, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusActive)
+	statusEnum, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusInactive)
 	if err != nil {
 		return SubscriptionModel{}, err
 	}
@@ -42,6 +55,43 @@ func CreateSubscriptionModel(
 		autoRenew: true, // auto-renew enabled by default
 		createdAt: time.Now().UTC(),
 		updatedAt: time.Now().UTC(),
+
+		currentPeriodStart: startDate,
+	}, nil
+}
+
+// CreateTrialSubscriptionModel creates a subscription in the Trialing status. The
+// first period ends together with the trial, when it is converted to a paid period.
+func CreateTrialSubscriptionModel(
+	userID, planID uint64,
+	startDate time.Time,
+	trialEndDate time.Time,
+) (SubscriptionModel, error) {
+	if err := validateSubscription(userID, planID, startDate, &trialEndDate); err != nil {
+		return SubscriptionModel{}, err
+	}
+
+	if !trialEndDate.After(startDate) {
+		return SubscriptionModel{}, errs.ErrTrialEndDateRequired
+	}
+
+	statusEnum, err := enum.NewSubscriptionStatusEnum(enum.EnumSubscriptionStatusTrialing)
+	if err != nil {
+		return SubscriptionModel{}, err
+	}
+
+	return SubscriptionModel{
+		userID:       userID,
+		planID:       planID,
+		status:       statusEnum,
+		startDate:    startDate,
+		endDate:      &trialEndDate,
+		trialEndDate: &trialEndDate,
+		autoRenew:    true, // auto-renew enabled by default
+		createdAt:    time.Now().UTC(),
+		updatedAt:    time.Now().UTC(),
+
+		currentPeriodStart: startDate,
 	}, nil
 }
 
@@ -50,8 +100,14 @@ func RestoreSubscriptionModel(
 	status string,
 	startDate time.Time,
 	endDate *time.Time,
+	trialEndDate *time.Time,
 	autoRenew bool,
 	createdAt, updatedAt time.Time,
+	paymentCustomerID, paymentMethodID string,
+	retryCount uint,
+	nextRetryAt *time.Time,
+	currentPeriodStart time.Time,
+	pausedAt *time.Time,
 ) (SubscriptionModel, error) {
 	if err := validateSubscription(userID, planID, startDate, endDate); err != nil {
 		return SubscriptionModel{}, err
@@ -63,15 +119,25 @@ func RestoreSubscriptionModel(
 	}
 
 	return SubscriptionModel{
-		id:        id,
-		userID:    userID,
-		planID:    planID,
-		status:    statusEnum,
-		startDate: startDate,
-		endDate:   endDate,
-		autoRenew: autoRenew,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:           id,
+		userID:       userID,
+		planID:       planID,
+		status:       statusEnum,
+		startDate:    startDate,
+		endDate:      endDate,
+		trialEndDate: trialEndDate,
+		autoRenew:    autoRenew,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+
+		paymentCustomerID: paymentCustomerID,
+		paymentMethodID:   paymentMethodID,
+
+		retryCount:  retryCount,
+		nextRetryAt: nextRetryAt,
+
+		currentPeriodStart: currentPeriodStart,
+		pausedAt:           pausedAt,
 	}, nil
 }
 
@@ -99,6 +165,10 @@ func (s *SubscriptionModel) EndDate() *time.Time {
 	return s.endDate
 }
 
+func (s *SubscriptionModel) TrialEndDate() *time.Time {
+	return s.trialEndDate
+}
+
 func (s *SubscriptionModel) AutoRenew() bool {
 	return s.autoRenew
 }
@@ -111,12 +181,110 @@ func (s *SubscriptionModel) UpdatedAt() time.Time {
 	return s.updatedAt
 }
 
+func (s *SubscriptionModel) PaymentCustomerID() string {
+	return s.paymentCustomerID
+}
+
+func (s *SubscriptionModel) PaymentMethodID() string {
+	return s.paymentMethodID
+}
+
+// AttachPaymentMethod records the payment provider references used to charge the subscription.
+func (s *SubscriptionModel) AttachPaymentMethod(paymentCustomerID, paymentMethodID string) error {
+	if paymentCustomerID == "" || paymentMethodID == "" {
+		return errs.ErrPaymentMethodRequired
+	}
+
+	s.paymentCustomerID = paymentCustomerID
+	s.paymentMethodID = paymentMethodID
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (s *SubscriptionModel) HasPaymentMethod() bool {
+	return s.paymentCustomerID != "" && s.paymentMethodID != ""
+}
+
+// Activate marks the subscription as paid for its current period.
+func (s *SubscriptionModel) Activate() error {
+	return s.UpdateStatus(enum.EnumSubscriptionStatusActive)
+}
+
+// RetryCount is the number of failed charges since the subscription was last paid.
+func (s *SubscriptionModel) RetryCount() uint {
+	return s.retryCount
+}
+
+// NextRetryAt is when the unpaid period of a PastDue subscription is charged again.
+// It is nil when no retry is scheduled.
+func (s *SubscriptionModel) NextRetryAt() *time.Time {
+	return s.nextRetryAt
+}
+
+// MarkPastDue records that charging the current period failed and schedules the next
+// attempt, or no attempt when nextRetryAt is nil.
+func (s *SubscriptionModel) MarkPastDue(nextRetryAt *time.Time) error {
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusPastDue); err != nil {
+		return err
+	}
+
+	s.retryCount++
+	s.nextRetryAt = nextRetryAt
+	return nil
+}
+
+// Renew starts the next paid period, which ends at endDate. It converts a trial to its
+// first paid period and settles a PastDue subscription.
+func (s *SubscriptionModel) Renew(endDate *time.Time) error {
+	switch s.status.String() {
+	case enum.EnumSubscriptionStatusTrialing:
+		if err := s.ConvertTrialToPaid(endDate); err != nil {
+			return err
+		}
+	case enum.EnumSubscriptionStatusActive, enum.EnumSubscriptionStatusPastDue:
+		if endDate != nil && endDate.Before(s.startDate) {
+			return errs.ErrEndDateBeforeStartDate
+		}
+
+		if err := s.UpdateStatus(enum.EnumSubscriptionStatusActive); err != nil {
+			return err
+		}
+		if s.endDate != nil {
+			s.currentPeriodStart = *s.endDate
+		}
+		s.endDate = endDate
+	default:
+		return errs.ErrSubscriptionNotRenewable
+	}
+
+	s.retryCount = 0
+	s.nextRetryAt = nil
+	return nil
+}
+
+// Expire ends the subscription, either at the end of a period that is not renewed or
+// when the grace period of a PastDue subscription runs out.
+func (s *SubscriptionModel) Expire() error {
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusExpired); err != nil {
+		return err
+	}
+
+	s.nextRetryAt = nil
+	return nil
+}
+
+// UpdateStatus moves the subscription to another status, as long as the subscription
+// state machine allows it.
 func (s *SubscriptionModel) UpdateStatus(statusValue string) error {
 	status, err := enum.NewSubscriptionStatusEnum(statusValue)
 	if err != nil {
 		return err
 	}
 
+	if !s.status.CanTransitionTo(status) {
+		return fmt.Errorf("%w: %s to %s", errs.ErrInvalidSubscriptionStatusTransition, s.status.String(), statusValue)
+	}
+
 	s.status = status
 	s.updatedAt = time.Now().UTC()
 	return nil
@@ -132,6 +300,136 @@ func (s *SubscriptionModel) UpdateEndDate(endDate *time.Time) error {
 	return nil
 }
 
+// HasTrial reports whether the subscription was started with a trial period.
+func (s *SubscriptionModel) HasTrial() bool {
+	return s.trialEndDate != nil
+}
+
+func (s *SubscriptionModel) IsTrialing() bool {
+	return s.status.String() == enum.EnumSubscriptionStatusTrialing
+}
+
+// IsActive reports whether the subscription grants access, which includes a running trial.
+func (s *SubscriptionModel) IsActive() bool {
+	return s.status.String() == enum.EnumSubscriptionStatusActive || s.IsTrialing()
+}
+
+func (s *SubscriptionModel) IsPastDue() bool {
+	return s.status.String() == enum.EnumSubscriptionStatusPastDue
+}
+
+// ConvertTrialToPaid moves a trialing subscription to its first paid period, which
+// starts when the trial ends and lasts until endDate.
+func (s *SubscriptionModel) ConvertTrialToPaid(endDate *time.Time) error {
+	if !s.IsTrialing() {
+		return errs.ErrSubscriptionNotTrialing
+	}
+
+	if endDate != nil && endDate.Before(*s.trialEndDate) {
+		return errs.ErrEndDateBeforeStartDate
+	}
+
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusActive); err != nil {
+		return err
+	}
+
+	s.currentPeriodStart = *s.trialEndDate
+	s.endDate = endDate
+	return nil
+}
+
+// CurrentPeriodStart is when the period ending at EndDate started, i.e. the start of
+// the period that was last paid, or of the trial.
+func (s *SubscriptionModel) CurrentPeriodStart() time.Time {
+	return s.currentPeriodStart
+}
+
+// PausedAt is when the subscription was paused. It is nil unless the subscription is Paused.
+func (s *SubscriptionModel) PausedAt() *time.Time {
+	return s.pausedAt
+}
+
+func (s *SubscriptionModel) IsPaused() bool {
+	return s.status.String() == enum.EnumSubscriptionStatusPaused
+}
+
+// CancelAtPeriodEnd turns auto-renew off, so the subscription keeps its access until
+// the end of the current period and then expires.
+func (s *SubscriptionModel) CancelAtPeriodEnd() error {
+	if !s.IsActive() {
+		return errs.ErrSubscriptionNotActive
+	}
+
+	s.SetAutoRenew(false)
+	return nil
+}
+
+// Cancel ends the subscription right away. The current period is cut short at now and
+// pending retries are dropped.
+func (s *SubscriptionModel) Cancel(now time.Time) error {
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusCancelled); err != nil {
+		return err
+	}
+
+	if s.endDate == nil || s.endDate.After(now) {
+		s.endDate = &now
+	}
+	s.autoRenew = false
+	s.nextRetryAt = nil
+	s.pausedAt = nil
+	return nil
+}
+
+// Pause suspends access and billing. The time left in the current period is kept and
+// given back on Resume.
+func (s *SubscriptionModel) Pause(now time.Time) error {
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusPaused); err != nil {
+		return err
+	}
+
+	s.pausedAt = &now
+	return nil
+}
+
+// Resume reactivates a paused subscription and pushes the end of the current period
+// back by the time it spent paused.
+func (s *SubscriptionModel) Resume(now time.Time) error {
+	if !s.IsPaused() {
+		return errs.ErrSubscriptionNotPaused
+	}
+
+	if err := s.UpdateStatus(enum.EnumSubscriptionStatusActive); err != nil {
+		return err
+	}
+
+	if s.endDate != nil && s.pausedAt != nil && now.After(*s.pausedAt) {
+		endDate := s.endDate.Add(now.Sub(*s.pausedAt))
+		s.endDate = &endDate
+	}
+	s.pausedAt = nil
+	return nil
+}
+
+// ChangePlan moves the subscription to another plan for the rest of the current period.
+// Prorating the price difference is up to the caller.
+func (s *SubscriptionModel) ChangePlan(planID uint64) error {
+	if planID == 0 {
+		return errs.ErrPlanIDRequired
+	}
+
+	if !s.IsActive() {
+		return errs.ErrSubscriptionNotActive
+	}
+
+	if planID == s.planID {
+		return errs.ErrSubscriptionAlreadyOnPlan
+	}
+
+	s.planID = planID
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
 func (s *SubscriptionModel) SetAutoRenew(autoRenew bool) {
 	s.autoRenew = autoRenew
 	s.updatedAt = time.Now().UTC()
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 )
 
@@ -28,7 +29,7 @@ func TestCreateSubscriptionModel(t *testing.T) {
 		assert.Equal(t, userID, subscription.UserID())
 		assert.Equal(t, planID, subscription.PlanID())
 		statusEnum := subscription.Status()
-		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.Equal(t, enum.EnumSubscriptionStatusInactive, (&statusEnum).String())
 		assert.Equal(t, startDate, subscription.StartDate())
 		assert.Equal(t, endDate, subscription.EndDate())
 		assert.True(t, subscription.AutoRenew()) // auto-renew is true by default
@@ -51,7 +52,7 @@ func TestCreateSubscriptionModel(t *testing.T) {
 		assert.Equal(t, userID, subscription.UserID())
 		assert.Equal(t, planID, subscription.PlanID())
 		statusEnum := subscription.Status()
-		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.Equal(t, enum.EnumSubscriptionStatusInactive, (&statusEnum).String())
 		assert.Equal(t, startDate, subscription.StartDate())
 		assert.Nil(t, subscription.EndDate())
 		assert.True(t, subscription.AutoRenew()) // auto-renew is true by default
@@ -136,7 +137,7 @@ func TestRestoreSubscriptionModel(t *testing.T) {
 
 		// Act
 		subscription, err := model.RestoreSubscriptionModel(
-			id, userID, planID, status, startDate, endDate, autoRenew, createdAt, updatedAt,
+			id, userID, planID, status, startDate, endDate, nil, autoRenew, createdAt, updatedAt, "", "", 0, nil, startDate, nil,
 		)
 
 		// Assert
@@ -166,7 +167,7 @@ func TestRestoreSubscriptionModel(t *testing.T) {
 
 		// Act
 		subscription, err := model.RestoreSubscriptionModel(
-			id, userID, planID, status, startDate, nil, autoRenew, createdAt, updatedAt,
+			id, userID, planID, status, startDate, nil, nil, autoRenew, createdAt, updatedAt, "", "", 0, nil, startDate, nil,
 		)
 
 		// Assert
@@ -188,7 +189,7 @@ func TestRestoreSubscriptionModel(t *testing.T) {
 
 		// Act
 		subscription, err := model.RestoreSubscriptionModel(
-			id, userID, planID, status, startDate, nil, autoRenew, createdAt, updatedAt,
+			id, userID, planID, status, startDate, nil, nil, autoRenew, createdAt, updatedAt, "", "", 0, nil, startDate, nil,
 		)
 
 		// Assert
@@ -337,3 +338,473 @@ func TestSubscriptionModel_SetAutoRenew(t *testing.T) {
 		assert.True(t, subscription.UpdatedAt().After(originalUpdatedAt))
 	})
 }
+
+func TestCreateTrialSubscriptionModel(t *testing.T) {
+	t.Run("valid input returns trialing subscription", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		trialEndDate := startDate.AddDate(0, 0, 7)
+
+		// Act
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, trialEndDate)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusTrialing, (&statusEnum).String())
+		assert.Equal(t, trialEndDate, *subscription.EndDate())
+		assert.Equal(t, trialEndDate, *subscription.TrialEndDate())
+		assert.True(t, subscription.HasTrial())
+		assert.True(t, subscription.IsTrialing())
+		assert.True(t, subscription.IsActive())
+		assert.True(t, subscription.AutoRenew())
+	})
+
+	t.Run("trial end date equal to start date returns error", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+
+		// Act
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, startDate)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTrialEndDateRequired)
+		assert.Zero(t, subscription)
+	})
+
+	t.Run("trial end date before start date returns error", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+
+		// Act
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, startDate.Add(-time.Hour))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrEndDateBeforeStartDate)
+		assert.Zero(t, subscription)
+	})
+}
+
+func TestSubscriptionModel_ConvertTrialToPaid(t *testing.T) {
+	t.Run("trialing subscription becomes active with the paid end date", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		trialEndDate := startDate.AddDate(0, 0, 7)
+		paidEndDate := trialEndDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, trialEndDate)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.ConvertTrialToPaid(&paidEndDate)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.Equal(t, paidEndDate, *subscription.EndDate())
+		assert.Equal(t, trialEndDate, *subscription.TrialEndDate())
+		assert.True(t, subscription.HasTrial())
+		assert.False(t, subscription.IsTrialing())
+		assert.True(t, subscription.IsActive())
+	})
+
+	t.Run("paid end date before trial end returns error", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		trialEndDate := startDate.AddDate(0, 0, 7)
+		paidEndDate := trialEndDate.Add(-time.Hour)
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, trialEndDate)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.ConvertTrialToPaid(&paidEndDate)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrEndDateBeforeStartDate)
+		assert.True(t, subscription.IsTrialing())
+	})
+
+	t.Run("non trialing subscription returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.ConvertTrialToPaid(nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionNotTrialing)
+		assert.False(t, subscription.HasTrial())
+	})
+}
+
+func TestSubscriptionModel_AttachPaymentMethod(t *testing.T) {
+	t.Run("valid references are attached", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.AttachPaymentMethod("cus_1", "pm_1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "cus_1", subscription.PaymentCustomerID())
+		assert.Equal(t, "pm_1", subscription.PaymentMethodID())
+		assert.True(t, subscription.HasPaymentMethod())
+	})
+
+	t.Run("empty payment method returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.AttachPaymentMethod("cus_1", "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrPaymentMethodRequired)
+		assert.False(t, subscription.HasPaymentMethod())
+	})
+}
+
+func TestSubscriptionModel_Activate(t *testing.T) {
+	t.Run("inactive subscription becomes active", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.Activate()
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.True(t, subscription.IsActive())
+	})
+}
+
+func TestSubscriptionModel_MarkPastDue(t *testing.T) {
+	t.Run("inactive subscription becomes past due", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.MarkPastDue(nil)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusPastDue, (&statusEnum).String())
+		assert.False(t, subscription.IsActive())
+		assert.Equal(t, uint(1), subscription.RetryCount())
+		assert.Nil(t, subscription.NextRetryAt())
+	})
+
+	t.Run("each failed retry is counted and rescheduled", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		firstRetryAt := time.Now().UTC().Add(24 * time.Hour)
+		secondRetryAt := firstRetryAt.Add(24 * time.Hour)
+		require.NoError(t, subscription.MarkPastDue(&firstRetryAt))
+
+		// Act
+		err = subscription.MarkPastDue(&secondRetryAt)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), subscription.RetryCount())
+		assert.Equal(t, &secondRetryAt, subscription.NextRetryAt())
+	})
+}
+
+func TestSubscriptionModel_Renew(t *testing.T) {
+	t.Run("active subscription is extended", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		endDate := startDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateSubscriptionModel(1, 2, startDate, &endDate)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		nextEndDate := endDate.AddDate(0, 1, 0)
+
+		// Act
+		err = subscription.Renew(&nextEndDate)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &nextEndDate, subscription.EndDate())
+		assert.Equal(t, endDate, subscription.CurrentPeriodStart())
+		assert.True(t, subscription.IsActive())
+	})
+
+	t.Run("past due subscription is settled and its retries cleared", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		endDate := startDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateSubscriptionModel(1, 2, startDate, &endDate)
+		require.NoError(t, err)
+		retryAt := endDate.Add(24 * time.Hour)
+		require.NoError(t, subscription.MarkPastDue(&retryAt))
+		nextEndDate := endDate.AddDate(0, 1, 0)
+
+		// Act
+		err = subscription.Renew(&nextEndDate)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.Equal(t, uint(0), subscription.RetryCount())
+		assert.Nil(t, subscription.NextRetryAt())
+	})
+
+	t.Run("trialing subscription is converted to paid", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		trialEndDate := startDate.AddDate(0, 0, 7)
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, trialEndDate)
+		require.NoError(t, err)
+		paidEndDate := trialEndDate.AddDate(0, 1, 0)
+
+		// Act
+		err = subscription.Renew(&paidEndDate)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusActive, (&statusEnum).String())
+		assert.Equal(t, &paidEndDate, subscription.EndDate())
+	})
+
+	t.Run("expired subscription returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		require.NoError(t, subscription.Expire())
+
+		// Act
+		err = subscription.Renew(nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionNotRenewable)
+	})
+}
+
+func TestSubscriptionModel_Expire(t *testing.T) {
+	t.Run("past due subscription expires and its retry is cancelled", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		retryAt := time.Now().UTC().Add(24 * time.Hour)
+		require.NoError(t, subscription.MarkPastDue(&retryAt))
+
+		// Act
+		err = subscription.Expire()
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusExpired, (&statusEnum).String())
+		assert.Nil(t, subscription.NextRetryAt())
+		assert.False(t, subscription.IsActive())
+	})
+}
+
+func TestSubscriptionModel_UpdateStatus_StateMachine(t *testing.T) {
+	t.Run("expired subscription cannot be reactivated", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		require.NoError(t, subscription.Expire())
+
+		// Act
+		err = subscription.Activate()
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidSubscriptionStatusTransition)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusExpired, (&statusEnum).String())
+	})
+}
+
+func TestSubscriptionModel_CancelAtPeriodEnd(t *testing.T) {
+	t.Run("active subscription keeps its access and stops renewing", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		endDate := startDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateSubscriptionModel(1, 2, startDate, &endDate)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+
+		// Act
+		err = subscription.CancelAtPeriodEnd()
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, subscription.AutoRenew())
+		assert.True(t, subscription.IsActive())
+		assert.Equal(t, &endDate, subscription.EndDate())
+	})
+
+	t.Run("past due subscription returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.MarkPastDue(nil))
+
+		// Act
+		err = subscription.CancelAtPeriodEnd()
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionNotActive)
+	})
+}
+
+func TestSubscriptionModel_Cancel(t *testing.T) {
+	t.Run("active subscription ends now", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC().AddDate(0, 0, -10)
+		endDate := startDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateSubscriptionModel(1, 2, startDate, &endDate)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		now := time.Now().UTC()
+
+		// Act
+		err = subscription.Cancel(now)
+
+		// Assert
+		require.NoError(t, err)
+		statusEnum := subscription.Status()
+		assert.Equal(t, enum.EnumSubscriptionStatusCancelled, (&statusEnum).String())
+		assert.Equal(t, &now, subscription.EndDate())
+		assert.False(t, subscription.AutoRenew())
+	})
+
+	t.Run("cancelled subscription returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Cancel(time.Now().UTC()))
+
+		// Act
+		err = subscription.Cancel(time.Now().UTC())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidSubscriptionStatusTransition)
+	})
+}
+
+func TestSubscriptionModel_PauseAndResume(t *testing.T) {
+	t.Run("resume pushes the end date back by the paused time", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		endDate := startDate.AddDate(0, 1, 0)
+		subscription, err := model.CreateSubscriptionModel(1, 2, startDate, &endDate)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		pausedAt := startDate.AddDate(0, 0, 5)
+		resumedAt := pausedAt.AddDate(0, 0, 3)
+		require.NoError(t, subscription.Pause(pausedAt))
+
+		// Act
+		err = subscription.Resume(resumedAt)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, subscription.IsActive())
+		assert.Nil(t, subscription.PausedAt())
+		assert.Equal(t, endDate.AddDate(0, 0, 3), *subscription.EndDate())
+	})
+
+	t.Run("paused subscription does not grant access", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+
+		// Act
+		err = subscription.Pause(time.Now().UTC())
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, subscription.IsPaused())
+		assert.False(t, subscription.IsActive())
+		assert.NotNil(t, subscription.PausedAt())
+	})
+
+	t.Run("trialing subscription cannot be paused", func(t *testing.T) {
+		// Arrange
+		startDate := time.Now().UTC()
+		subscription, err := model.CreateTrialSubscriptionModel(1, 2, startDate, startDate.AddDate(0, 0, 7))
+		require.NoError(t, err)
+
+		// Act
+		err = subscription.Pause(time.Now().UTC())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidSubscriptionStatusTransition)
+	})
+
+	t.Run("active subscription cannot be resumed", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+
+		// Act
+		err = subscription.Resume(time.Now().UTC())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionNotPaused)
+	})
+}
+
+func TestSubscriptionModel_ChangePlan(t *testing.T) {
+	t.Run("active subscription moves to the new plan", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+
+		// Act
+		err = subscription.ChangePlan(3)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), subscription.PlanID())
+	})
+
+	t.Run("same plan returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+
+		// Act
+		err = subscription.ChangePlan(2)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionAlreadyOnPlan)
+	})
+
+	t.Run("paused subscription returns error", func(t *testing.T) {
+		// Arrange
+		subscription, err := model.CreateSubscriptionModel(1, 2, time.Now().UTC(), nil)
+		require.NoError(t, err)
+		require.NoError(t, subscription.Activate())
+		require.NoError(t, subscription.Pause(time.Now().UTC()))
+
+		// Act
+		err = subscription.ChangePlan(3)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSubscriptionNotActive)
+	})
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+type InvoiceRepository interface {
+	// Create stores the invoice together with its lines.
+	Create(ctx context.Context, invoice model.InvoiceModel) (model.InvoiceModel, error)
+	// Update stores the invoice status and payment date, lines are never changed once issued.
+	Update(ctx context.Context, invoice model.InvoiceModel) error
+	FindByID(ctx context.Context, id uint64) (model.InvoiceModel, error)
+	FindByUserID(ctx context.Context, userID uint64) ([]model.InvoiceModel, error)
+	FindBySubscriptionPeriod(
+		ctx context.Context,
+		subscriptionID uint64,
+		periodStart time.Time,
+	) (model.InvoiceModel, error)
+	// FindOpenBySubscriptionID returns the latest unpaid invoice of the subscription.
+	FindOpenBySubscriptionID(ctx context.Context, subscriptionID uint64) (model.InvoiceModel, error)
+}
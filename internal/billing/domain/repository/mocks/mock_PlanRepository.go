@@ -0,0 +1,303 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPlanRepository is an autogenerated mock type for the PlanRepository type
+type MockPlanRepository struct {
+	mock.Mock
+}
+
+type MockPlanRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPlanRepository) EXPECT() *MockPlanRepository_Expecter {
+	return &MockPlanRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, plan
+func (_m *MockPlanRepository) Create(ctx context.Context, plan model.PlanModel) (model.PlanModel, error) {
+	ret := _m.Called(ctx, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.PlanModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlanModel) (model.PlanModel, error)); ok {
+		return rf(ctx, plan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlanModel) model.PlanModel); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		r0 = ret.Get(0).(model.PlanModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PlanModel) error); ok {
+		r1 = rf(ctx, plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlanRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPlanRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - plan model.PlanModel
+func (_e *MockPlanRepository_Expecter) Create(ctx interface{}, plan interface{}) *MockPlanRepository_Create_Call {
+	return &MockPlanRepository_Create_Call{Call: _e.mock.On("Create", ctx, plan)}
+}
+
+func (_c *MockPlanRepository_Create_Call) Run(run func(ctx context.Context, plan model.PlanModel)) *MockPlanRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PlanModel))
+	})
+	return _c
+}
+
+func (_c *MockPlanRepository_Create_Call) Return(_a0 model.PlanModel, _a1 error) *MockPlanRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlanRepository_Create_Call) RunAndReturn(run func(context.Context, model.PlanModel) (model.PlanModel, error)) *MockPlanRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockPlanRepository) Delete(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlanRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPlanRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockPlanRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockPlanRepository_Delete_Call {
+	return &MockPlanRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockPlanRepository_Delete_Call) Run(run func(ctx context.Context, id uint64)) *MockPlanRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPlanRepository_Delete_Call) Return(_a0 error) *MockPlanRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlanRepository_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockPlanRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx
+func (_m *MockPlanRepository) FindAll(ctx context.Context) ([]model.PlanModel, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.PlanModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.PlanModel, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.PlanModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PlanModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlanRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockPlanRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPlanRepository_Expecter) FindAll(ctx interface{}) *MockPlanRepository_FindAll_Call {
+	return &MockPlanRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockPlanRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockPlanRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPlanRepository_FindAll_Call) Return(_a0 []model.PlanModel, _a1 error) *MockPlanRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlanRepository_FindAll_Call) RunAndReturn(run func(context.Context) ([]model.PlanModel, error)) *MockPlanRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockPlanRepository) FindByID(ctx context.Context, id uint64) (model.PlanModel, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.PlanModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.PlanModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.PlanModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.PlanModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlanRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockPlanRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockPlanRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockPlanRepository_FindByID_Call {
+	return &MockPlanRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockPlanRepository_FindByID_Call) Run(run func(ctx context.Context, id uint64)) *MockPlanRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPlanRepository_FindByID_Call) Return(_a0 model.PlanModel, _a1 error) *MockPlanRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlanRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.PlanModel, error)) *MockPlanRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, plan
+func (_m *MockPlanRepository) Update(ctx context.Context, plan model.PlanModel) error {
+	ret := _m.Called(ctx, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlanModel) error); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlanRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockPlanRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - plan model.PlanModel
+func (_e *MockPlanRepository_Expecter) Update(ctx interface{}, plan interface{}) *MockPlanRepository_Update_Call {
+	return &MockPlanRepository_Update_Call{Call: _e.mock.On("Update", ctx, plan)}
+}
+
+func (_c *MockPlanRepository_Update_Call) Run(run func(ctx context.Context, plan model.PlanModel)) *MockPlanRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PlanModel))
+	})
+	return _c
+}
+
+func (_c *MockPlanRepository_Update_Call) Return(_a0 error) *MockPlanRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlanRepository_Update_Call) RunAndReturn(run func(context.Context, model.PlanModel) error) *MockPlanRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPlanRepository creates a new instance of MockPlanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPlanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPlanRepository {
+	mock := &MockPlanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
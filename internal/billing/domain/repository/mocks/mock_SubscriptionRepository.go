@@ -0,0 +1,538 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockSubscriptionRepository is an autogenerated mock type for the SubscriptionRepository type
+type MockSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptionRepository) EXPECT() *MockSubscriptionRepository_Expecter {
+	return &MockSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, subscription
+func (_m *MockSubscriptionRepository) Create(ctx context.Context, subscription model.SubscriptionModel) (model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SubscriptionModel) (model.SubscriptionModel, error)); ok {
+		return rf(ctx, subscription)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SubscriptionModel) model.SubscriptionModel); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Get(0).(model.SubscriptionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SubscriptionModel) error); ok {
+		r1 = rf(ctx, subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSubscriptionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription model.SubscriptionModel
+func (_e *MockSubscriptionRepository_Expecter) Create(ctx interface{}, subscription interface{}) *MockSubscriptionRepository_Create_Call {
+	return &MockSubscriptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, subscription)}
+}
+
+func (_c *MockSubscriptionRepository_Create_Call) Run(run func(ctx context.Context, subscription model.SubscriptionModel)) *MockSubscriptionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SubscriptionModel))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Create_Call) Return(_a0 model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Create_Call) RunAndReturn(run func(context.Context, model.SubscriptionModel) (model.SubscriptionModel, error)) *MockSubscriptionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockSubscriptionRepository) Delete(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSubscriptionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSubscriptionRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockSubscriptionRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockSubscriptionRepository_Delete_Call {
+	return &MockSubscriptionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockSubscriptionRepository_Delete_Call) Run(run func(ctx context.Context, id uint64)) *MockSubscriptionRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Delete_Call) Return(_a0 error) *MockSubscriptionRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Delete_Call) RunAndReturn(run func(context.Context, uint64) error) *MockSubscriptionRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsByPlanID provides a mock function with given fields: ctx, planID
+func (_m *MockSubscriptionRepository) ExistsByPlanID(ctx context.Context, planID uint64) (bool, error) {
+	ret := _m.Called(ctx, planID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByPlanID")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, planID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, planID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, planID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_ExistsByPlanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByPlanID'
+type MockSubscriptionRepository_ExistsByPlanID_Call struct {
+	*mock.Call
+}
+
+// ExistsByPlanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - planID uint64
+func (_e *MockSubscriptionRepository_Expecter) ExistsByPlanID(ctx interface{}, planID interface{}) *MockSubscriptionRepository_ExistsByPlanID_Call {
+	return &MockSubscriptionRepository_ExistsByPlanID_Call{Call: _e.mock.On("ExistsByPlanID", ctx, planID)}
+}
+
+func (_c *MockSubscriptionRepository_ExistsByPlanID_Call) Run(run func(ctx context.Context, planID uint64)) *MockSubscriptionRepository_ExistsByPlanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_ExistsByPlanID_Call) Return(_a0 bool, _a1 error) *MockSubscriptionRepository_ExistsByPlanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_ExistsByPlanID_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockSubscriptionRepository_ExistsByPlanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindActiveSubscriptionByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockSubscriptionRepository) FindActiveSubscriptionByUserID(ctx context.Context, userID uint64) (model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveSubscriptionByUserID")
+	}
+
+	var r0 model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.SubscriptionModel, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.SubscriptionModel); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(model.SubscriptionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveSubscriptionByUserID'
+type MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call struct {
+	*mock.Call
+}
+
+// FindActiveSubscriptionByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSubscriptionRepository_Expecter) FindActiveSubscriptionByUserID(ctx interface{}, userID interface{}) *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call {
+	return &MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call{Call: _e.mock.On("FindActiveSubscriptionByUserID", ctx, userID)}
+}
+
+func (_c *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call) Return(_a0 model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call) RunAndReturn(run func(context.Context, uint64) (model.SubscriptionModel, error)) *MockSubscriptionRepository_FindActiveSubscriptionByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockSubscriptionRepository) FindByID(ctx context.Context, id uint64) (model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.SubscriptionModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.SubscriptionModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.SubscriptionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockSubscriptionRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockSubscriptionRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockSubscriptionRepository_FindByID_Call {
+	return &MockSubscriptionRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockSubscriptionRepository_FindByID_Call) Run(run func(ctx context.Context, id uint64)) *MockSubscriptionRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindByID_Call) Return(_a0 model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.SubscriptionModel, error)) *MockSubscriptionRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockSubscriptionRepository) FindByUserID(ctx context.Context, userID uint64) ([]model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.SubscriptionModel, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.SubscriptionModel); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SubscriptionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockSubscriptionRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSubscriptionRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockSubscriptionRepository_FindByUserID_Call {
+	return &MockSubscriptionRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockSubscriptionRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockSubscriptionRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindByUserID_Call) Return(_a0 []model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint64) ([]model.SubscriptionModel, error)) *MockSubscriptionRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueForRenewal provides a mock function with given fields: ctx, date
+func (_m *MockSubscriptionRepository) FindDueForRenewal(ctx context.Context, date time.Time) ([]model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueForRenewal")
+	}
+
+	var r0 []model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.SubscriptionModel, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.SubscriptionModel); ok {
+		r0 = rf(ctx, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SubscriptionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_FindDueForRenewal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueForRenewal'
+type MockSubscriptionRepository_FindDueForRenewal_Call struct {
+	*mock.Call
+}
+
+// FindDueForRenewal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date time.Time
+func (_e *MockSubscriptionRepository_Expecter) FindDueForRenewal(ctx interface{}, date interface{}) *MockSubscriptionRepository_FindDueForRenewal_Call {
+	return &MockSubscriptionRepository_FindDueForRenewal_Call{Call: _e.mock.On("FindDueForRenewal", ctx, date)}
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRenewal_Call) Run(run func(ctx context.Context, date time.Time)) *MockSubscriptionRepository_FindDueForRenewal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRenewal_Call) Return(_a0 []model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_FindDueForRenewal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRenewal_Call) RunAndReturn(run func(context.Context, time.Time) ([]model.SubscriptionModel, error)) *MockSubscriptionRepository_FindDueForRenewal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueForRetry provides a mock function with given fields: ctx, date
+func (_m *MockSubscriptionRepository) FindDueForRetry(ctx context.Context, date time.Time) ([]model.SubscriptionModel, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueForRetry")
+	}
+
+	var r0 []model.SubscriptionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.SubscriptionModel, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.SubscriptionModel); ok {
+		r0 = rf(ctx, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SubscriptionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSubscriptionRepository_FindDueForRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueForRetry'
+type MockSubscriptionRepository_FindDueForRetry_Call struct {
+	*mock.Call
+}
+
+// FindDueForRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date time.Time
+func (_e *MockSubscriptionRepository_Expecter) FindDueForRetry(ctx interface{}, date interface{}) *MockSubscriptionRepository_FindDueForRetry_Call {
+	return &MockSubscriptionRepository_FindDueForRetry_Call{Call: _e.mock.On("FindDueForRetry", ctx, date)}
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRetry_Call) Run(run func(ctx context.Context, date time.Time)) *MockSubscriptionRepository_FindDueForRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRetry_Call) Return(_a0 []model.SubscriptionModel, _a1 error) *MockSubscriptionRepository_FindDueForRetry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_FindDueForRetry_Call) RunAndReturn(run func(context.Context, time.Time) ([]model.SubscriptionModel, error)) *MockSubscriptionRepository_FindDueForRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, subscription
+func (_m *MockSubscriptionRepository) Update(ctx context.Context, subscription model.SubscriptionModel) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SubscriptionModel) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSubscriptionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSubscriptionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription model.SubscriptionModel
+func (_e *MockSubscriptionRepository_Expecter) Update(ctx interface{}, subscription interface{}) *MockSubscriptionRepository_Update_Call {
+	return &MockSubscriptionRepository_Update_Call{Call: _e.mock.On("Update", ctx, subscription)}
+}
+
+func (_c *MockSubscriptionRepository_Update_Call) Run(run func(ctx context.Context, subscription model.SubscriptionModel)) *MockSubscriptionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SubscriptionModel))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Update_Call) Return(_a0 error) *MockSubscriptionRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSubscriptionRepository_Update_Call) RunAndReturn(run func(context.Context, model.SubscriptionModel) error) *MockSubscriptionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubscriptionRepository creates a new instance of MockSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptionRepository {
+	mock := &MockSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment model.PaymentModel) (model.PaymentModel, error)
+	FindByInvoiceID(ctx context.Context, invoiceID uint64) ([]model.PaymentModel, error)
+}
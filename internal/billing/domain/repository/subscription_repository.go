@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 )
@@ -12,5 +13,10 @@ type SubscriptionRepository interface {
 	Delete(ctx context.Context, id uint64) error
 	FindByID(ctx context.Context, id uint64) (model.SubscriptionModel, error)
 	FindByUserID(ctx context.Context, userID uint64) ([]model.SubscriptionModel, error)
+	ExistsByPlanID(ctx context.Context, planID uint64) (bool, error)
 	FindActiveSubscriptionByUserID(ctx context.Context, userID uint64) (model.SubscriptionModel, error)
+	// FindDueForRenewal returns the active and trialing subscriptions whose period ends at or before the date.
+	FindDueForRenewal(ctx context.Context, date time.Time) ([]model.SubscriptionModel, error)
+	// FindDueForRetry returns the PastDue subscriptions whose next charge attempt is at or before the date.
+	FindDueForRetry(ctx context.Context, date time.Time) ([]model.SubscriptionModel, error)
 }
@@ -0,0 +1,195 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSubscriptionAccessCache is an autogenerated mock type for the SubscriptionAccessCache type
+type MockSubscriptionAccessCache struct {
+	mock.Mock
+}
+
+type MockSubscriptionAccessCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptionAccessCache) EXPECT() *MockSubscriptionAccessCache_Expecter {
+	return &MockSubscriptionAccessCache_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, userID
+func (_m *MockSubscriptionAccessCache) Get(ctx context.Context, userID uint64) (bool, bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) bool); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64) error); ok {
+		r2 = rf(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockSubscriptionAccessCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSubscriptionAccessCache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSubscriptionAccessCache_Expecter) Get(ctx interface{}, userID interface{}) *MockSubscriptionAccessCache_Get_Call {
+	return &MockSubscriptionAccessCache_Get_Call{Call: _e.mock.On("Get", ctx, userID)}
+}
+
+func (_c *MockSubscriptionAccessCache_Get_Call) Run(run func(ctx context.Context, userID uint64)) *MockSubscriptionAccessCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Get_Call) Return(active bool, found bool, err error) *MockSubscriptionAccessCache_Get_Call {
+	_c.Call.Return(active, found, err)
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Get_Call) RunAndReturn(run func(context.Context, uint64) (bool, bool, error)) *MockSubscriptionAccessCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, userID
+func (_m *MockSubscriptionAccessCache) Invalidate(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSubscriptionAccessCache_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockSubscriptionAccessCache_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSubscriptionAccessCache_Expecter) Invalidate(ctx interface{}, userID interface{}) *MockSubscriptionAccessCache_Invalidate_Call {
+	return &MockSubscriptionAccessCache_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, userID)}
+}
+
+func (_c *MockSubscriptionAccessCache_Invalidate_Call) Run(run func(ctx context.Context, userID uint64)) *MockSubscriptionAccessCache_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Invalidate_Call) Return(_a0 error) *MockSubscriptionAccessCache_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Invalidate_Call) RunAndReturn(run func(context.Context, uint64) error) *MockSubscriptionAccessCache_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, userID, active
+func (_m *MockSubscriptionAccessCache) Set(ctx context.Context, userID uint64, active bool) error {
+	ret := _m.Called(ctx, userID, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool) error); ok {
+		r0 = rf(ctx, userID, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSubscriptionAccessCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockSubscriptionAccessCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - active bool
+func (_e *MockSubscriptionAccessCache_Expecter) Set(ctx interface{}, userID interface{}, active interface{}) *MockSubscriptionAccessCache_Set_Call {
+	return &MockSubscriptionAccessCache_Set_Call{Call: _e.mock.On("Set", ctx, userID, active)}
+}
+
+func (_c *MockSubscriptionAccessCache_Set_Call) Run(run func(ctx context.Context, userID uint64, active bool)) *MockSubscriptionAccessCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Set_Call) Return(_a0 error) *MockSubscriptionAccessCache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSubscriptionAccessCache_Set_Call) RunAndReturn(run func(context.Context, uint64, bool) error) *MockSubscriptionAccessCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubscriptionAccessCache creates a new instance of MockSubscriptionAccessCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptionAccessCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptionAccessCache {
+	mock := &MockSubscriptionAccessCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
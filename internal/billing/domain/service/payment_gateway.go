@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+)
+
+// PaymentGateway is the port to the payment provider. Amounts are in the minor units
+// of the currency, matching AmountModel.
+type PaymentGateway interface {
+	// CreateCustomer registers the user with the provider and returns the provider's customer id.
+	CreateCustomer(ctx context.Context, userID uint64) (string, error)
+	// AttachPaymentMethod stores a tokenized payment method for the customer and returns its id.
+	AttachPaymentMethod(ctx context.Context, customerID string, paymentMethodToken string) (string, error)
+	// Authorize places a hold for the amount on the payment method and returns the authorization id.
+	Authorize(ctx context.Context, input AuthorizeInput) (string, error)
+	// Capture settles an authorization and returns the payment id.
+	Capture(ctx context.Context, authorizationID string) (string, error)
+	// Refund returns part or all of a captured payment and returns the refund id.
+	Refund(ctx context.Context, paymentID string, amountCents uint) (string, error)
+}
+
+type AuthorizeInput struct {
+	CustomerID      string
+	PaymentMethodID string
+	AmountCents     uint
+	CurrencyCode    string
+	// Reference identifies the charge on the provider side, e.g. "subscription:42".
+	Reference string
+	// IdempotencyKey makes the provider return the first authorization when the same key is
+	// sent again, instead of placing a second hold.
+	IdempotencyKey string
+}
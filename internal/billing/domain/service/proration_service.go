@@ -0,0 +1,78 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+const hoursInDay = 24
+
+type ProrationInput struct {
+	CurrentAmount model.AmountModel
+	NewAmount     model.AmountModel
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	ChangeDate    time.Time
+}
+
+// Proration is the price difference between two plans for the days left in the period.
+// At most one of ChargeCents and RefundCents is set: upgrades are charged, downgrades refunded.
+type Proration struct {
+	PeriodDays    uint
+	RemainingDays uint
+	ChargeCents   uint
+	RefundCents   uint
+}
+
+type ProrationService interface {
+	Calculate(input ProrationInput) (Proration, error)
+}
+
+type prorationService struct {
+}
+
+func NewProrationService() ProrationService {
+	return &prorationService{}
+}
+
+// Calculate prorates by whole days. A started day counts as remaining, so an upgrade charges
+// the difference for the whole day of the change, including the part already spent on the old
+// plan, and a downgrade refunds it.
+func (s *prorationService) Calculate(input ProrationInput) (Proration, error) {
+	if !input.PeriodEnd.After(input.PeriodStart) {
+		return Proration{}, errs.ErrEndDateBeforeStartDate
+	}
+
+	periodDays := days(input.PeriodEnd.Sub(input.PeriodStart))
+	remainingDays := uint(0)
+	if input.PeriodEnd.After(input.ChangeDate) {
+		remainingDays = min(days(input.PeriodEnd.Sub(input.ChangeDate)), periodDays)
+	}
+
+	proration := Proration{
+		PeriodDays:    periodDays,
+		RemainingDays: remainingDays,
+	}
+
+	currentCents := input.CurrentAmount.Cents()
+	newCents := input.NewAmount.Cents()
+	switch {
+	case newCents > currentCents:
+		proration.ChargeCents = prorate(newCents-currentCents, remainingDays, periodDays)
+	case newCents < currentCents:
+		proration.RefundCents = prorate(currentCents-newCents, remainingDays, periodDays)
+	}
+
+	return proration, nil
+}
+
+func days(d time.Duration) uint {
+	return uint(math.Ceil(d.Hours() / hoursInDay))
+}
+
+func prorate(amountCents, remainingDays, periodDays uint) uint {
+	return uint(math.Round(float64(amountCents) * float64(remainingDays) / float64(periodDays)))
+}
@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+)
+
+type ProrationServiceTestSuite struct {
+	suite.Suite
+	prorationService service.ProrationService
+	periodStart      time.Time
+	periodEnd        time.Time
+}
+
+func (suite *ProrationServiceTestSuite) SetupTest() {
+	suite.prorationService = service.NewProrationService()
+	suite.periodStart = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	suite.periodEnd = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestProrationServiceSuite(t *testing.T) {
+	suite.Run(t, new(ProrationServiceTestSuite))
+}
+
+func (suite *ProrationServiceTestSuite) amount(cents uint) model.AmountModel {
+	amount, err := model.CreateAmountModel(cents)
+	suite.Require().NoError(err)
+	return amount
+}
+
+func (suite *ProrationServiceTestSuite) TestCalculate_Upgrade_ChargesDifferenceForRemainingDays() {
+	// Arrange
+	input := service.ProrationInput{
+		CurrentAmount: suite.amount(1000),
+		NewAmount:     suite.amount(2500),
+		PeriodStart:   suite.periodStart,
+		PeriodEnd:     suite.periodEnd,
+		ChangeDate:    time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	proration, err := suite.prorationService.Calculate(input)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal(uint(30), proration.PeriodDays)
+	suite.Equal(uint(10), proration.RemainingDays)
+	suite.Equal(uint(500), proration.ChargeCents)
+	suite.Zero(proration.RefundCents)
+}
+
+func (suite *ProrationServiceTestSuite) TestCalculate_Downgrade_RefundsDifferenceForRemainingDays() {
+	// Arrange
+	input := service.ProrationInput{
+		CurrentAmount: suite.amount(2500),
+		NewAmount:     suite.amount(1000),
+		PeriodStart:   suite.periodStart,
+		PeriodEnd:     suite.periodEnd,
+		ChangeDate:    time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	proration, err := suite.prorationService.Calculate(input)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal(uint(15), proration.RemainingDays)
+	suite.Equal(uint(750), proration.RefundCents)
+	suite.Zero(proration.ChargeCents)
+}
+
+func (suite *ProrationServiceTestSuite) TestCalculate_StartedDay_CountsAsRemaining() {
+	// Arrange
+	input := service.ProrationInput{
+		CurrentAmount: suite.amount(0),
+		NewAmount:     suite.amount(3000),
+		PeriodStart:   suite.periodStart,
+		PeriodEnd:     suite.periodEnd,
+		ChangeDate:    time.Date(2025, 6, 30, 18, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	proration, err := suite.prorationService.Calculate(input)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal(uint(1), proration.RemainingDays)
+	suite.Equal(uint(100), proration.ChargeCents)
+}
+
+func (suite *ProrationServiceTestSuite) TestCalculate_ChangeAfterPeriodEnd_ReturnsZero() {
+	// Arrange
+	input := service.ProrationInput{
+		CurrentAmount: suite.amount(1000),
+		NewAmount:     suite.amount(2000),
+		PeriodStart:   suite.periodStart,
+		PeriodEnd:     suite.periodEnd,
+		ChangeDate:    suite.periodEnd.Add(time.Hour),
+	}
+
+	// Act
+	proration, err := suite.prorationService.Calculate(input)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Zero(proration.RemainingDays)
+	suite.Zero(proration.ChargeCents)
+}
+
+func (suite *ProrationServiceTestSuite) TestCalculate_EmptyPeriod_ReturnsError() {
+	// Arrange
+	input := service.ProrationInput{
+		CurrentAmount: suite.amount(1000),
+		NewAmount:     suite.amount(2000),
+		PeriodStart:   suite.periodEnd,
+		PeriodEnd:     suite.periodEnd,
+		ChangeDate:    suite.periodEnd,
+	}
+
+	// Act
+	_, err := suite.prorationService.Calculate(input)
+
+	// Assert
+	suite.Require().ErrorIs(err, errs.ErrEndDateBeforeStartDate)
+}
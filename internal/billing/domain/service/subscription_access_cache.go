@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+)
+
+// SubscriptionAccessCache remembers whether a user has an active subscription, so access
+// checks on hot paths like playback don't hit the database on every request.
+type SubscriptionAccessCache interface {
+	// Get returns the cached answer for the user, found is false on a cache miss.
+	Get(ctx context.Context, userID uint64) (active bool, found bool, err error)
+	Set(ctx context.Context, userID uint64, active bool) error
+	// Invalidate drops the cached answer, it must be called whenever a subscription of the user changes.
+	Invalidate(ctx context.Context, userID uint64) error
+}
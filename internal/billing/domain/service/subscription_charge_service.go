@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+)
+
+type SubscriptionChargeService interface {
+	// Charge bills one period of the subscription: it issues the period's invoice, or reuses
+	// it when one exists, charges it through the payment gateway and records the payment.
+	// An invoice that is already paid is returned without charging again.
+	Charge(
+		ctx context.Context,
+		subscription model.SubscriptionModel,
+		plan model.PlanModel,
+		periodStart time.Time,
+		periodEnd *time.Time,
+	) (model.InvoiceModel, error)
+	// ChargeProration bills the prorated difference of a plan upgrade on its own invoice,
+	// covering the rest of the current period from periodStart. The invoice is voided when
+	// the charge fails, so it is never retried.
+	ChargeProration(
+		ctx context.Context,
+		subscription model.SubscriptionModel,
+		plan model.PlanModel,
+		periodStart time.Time,
+		proration Proration,
+	) (model.InvoiceModel, error)
+	// RefundProration gives back part of the payment of the current period, e.g. the
+	// prorated difference of a downgrade, and records the refund in the ledger. It returns
+	// the amount refunded, which never exceeds what is left of the payment.
+	RefundProration(ctx context.Context, subscription model.SubscriptionModel, amountCents uint) (uint, error)
+}
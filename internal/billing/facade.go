@@ -2,29 +2,117 @@ package billing
 
 import (
 	"context"
+	"errors"
 
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
 )
 
 type FacadeInterface interface {
+	// IsUserSubscriptionActive reports whether the user has an active or trialing subscription.
+	// A user without any subscription is not an error, it returns false.
 	IsUserSubscriptionActive(ctx context.Context, userID uint64) (bool, error)
+	// GetEntitlements returns the features of the plan the user is subscribed to.
+	// It fails with errs.ErrSubscriptionRequired when the user has no active subscription.
+	GetEntitlements(ctx context.Context, userID uint64) (Entitlements, error)
+}
+
+// Entitlements are the plan features other modules enforce.
+type Entitlements struct {
+	PlanID               uint64
+	MaxConcurrentStreams uint
+	// MaxResolution is the resolution name, e.g. "1080p", and MaxResolutionHeight its height in pixels.
+	MaxResolution       string
+	MaxResolutionHeight uint
+	// MaxDownloads is the number of titles that can be kept offline at once, zero disables downloads.
+	MaxDownloads uint
+	MaxProfiles  uint
 }
 
 type facade struct {
 	subscriptionRepository repository.SubscriptionRepository
+	planRepository         repository.PlanRepository
+	accessCache            service.SubscriptionAccessCache
+	logger                 logger.Logger
 }
 
-func NewFacade(subscriptionRepository repository.SubscriptionRepository) FacadeInterface {
+func NewFacade(
+	subscriptionRepository repository.SubscriptionRepository,
+	planRepository repository.PlanRepository,
+	accessCache service.SubscriptionAccessCache,
+	logger logger.Logger,
+) FacadeInterface {
 	return &facade{
 		subscriptionRepository,
+		planRepository,
+		accessCache,
+		logger,
 	}
 }
 
+// IsUserSubscriptionActive answers from the cache when it can. The cache is an optimization,
+// when it is unavailable the answer comes from the database.
 func (f *facade) IsUserSubscriptionActive(ctx context.Context, userID uint64) (bool, error) {
-	_, err := f.subscriptionRepository.FindActiveSubscriptionByUserID(ctx, userID)
+	ctx, span := otel.Trace().StartSpan(ctx, "BillingFacade.IsUserSubscriptionActive")
+	defer span.End()
+
+	active, found, err := f.accessCache.Get(ctx, userID)
 	if err != nil {
+		message := "error reading cached subscription access"
+		f.logger.Error(message, "error", err, "userID", userID)
+	}
+
+	if found {
+		return active, nil
+	}
+
+	_, err = f.subscriptionRepository.FindActiveSubscriptionByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, errs.ErrSubscriptionNotFound) {
 		return false, err
 	}
 
-	return true, nil
+	active = err == nil
+	err = f.accessCache.Set(ctx, userID, active)
+	if err != nil {
+		message := "error caching subscription access"
+		f.logger.Error(message, "error", err, "userID", userID)
+	}
+
+	return active, nil
+}
+
+func (f *facade) GetEntitlements(ctx context.Context, userID uint64) (Entitlements, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "BillingFacade.GetEntitlements")
+	defer span.End()
+
+	subscriptionModel, err := f.subscriptionRepository.FindActiveSubscriptionByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrSubscriptionNotFound) {
+			return Entitlements{}, shared_errs.ErrSubscriptionRequired
+		}
+		return Entitlements{}, err
+	}
+
+	planModel, err := f.planRepository.FindByID(ctx, subscriptionModel.PlanID())
+	if err != nil {
+		message := "error finding subscription plan"
+		f.logger.Error(message, "error", err, "planID", subscriptionModel.PlanID())
+		return Entitlements{}, err
+	}
+
+	entitlements := planModel.Entitlements()
+	maxResolution := entitlements.MaxResolution()
+
+	return Entitlements{
+		PlanID:               planModel.ID(),
+		MaxConcurrentStreams: entitlements.MaxConcurrentStreams(),
+		MaxResolution:        maxResolution.String(),
+		MaxResolutionHeight:  maxResolution.Height(),
+		MaxDownloads:         entitlements.MaxDownloads(),
+		MaxProfiles:          entitlements.MaxProfiles(),
+	}, nil
 }
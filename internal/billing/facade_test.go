@@ -0,0 +1,137 @@
+package billing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/billing/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/billing/domain/service/mocks"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+)
+
+const testUserID = uint64(1)
+
+func TestFacade_IsUserSubscriptionActive(t *testing.T) {
+	t.Run("cached answer is returned without reading the database", func(t *testing.T) {
+		// Arrange
+		sut := newFacadeSUT(t)
+		sut.accessCache.EXPECT().Get(mock.Anything, testUserID).Return(true, true, nil).Once()
+
+		// Act
+		active, err := sut.facade.IsUserSubscriptionActive(context.Background(), testUserID)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, active)
+	})
+
+	t.Run("cache miss reads the database and caches the answer", func(t *testing.T) {
+		// Arrange
+		sut := newFacadeSUT(t)
+		sut.accessCache.EXPECT().Get(mock.Anything, testUserID).Return(false, false, nil).Once()
+		sut.subscriptionRepository.EXPECT().FindActiveSubscriptionByUserID(mock.Anything, testUserID).
+			Return(newSubscription(t), nil).Once()
+		sut.accessCache.EXPECT().Set(mock.Anything, testUserID, true).Return(nil).Once()
+
+		// Act
+		active, err := sut.facade.IsUserSubscriptionActive(context.Background(), testUserID)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, active)
+	})
+
+	t.Run("user without a subscription is not active", func(t *testing.T) {
+		// Arrange
+		sut := newFacadeSUT(t)
+		sut.accessCache.EXPECT().Get(mock.Anything, testUserID).Return(false, false, nil).Once()
+		sut.subscriptionRepository.EXPECT().FindActiveSubscriptionByUserID(mock.Anything, testUserID).
+			Return(model.SubscriptionModel{}, errs.ErrSubscriptionNotFound).Once()
+		sut.accessCache.EXPECT().Set(mock.Anything, testUserID, false).Return(nil).Once()
+
+		// Act
+		active, err := sut.facade.IsUserSubscriptionActive(context.Background(), testUserID)
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+
+	t.Run("unavailable cache falls back to the database", func(t *testing.T) {
+		// Arrange
+		errCache := errors.New("redis is down")
+		sut := newFacadeSUT(t)
+		sut.accessCache.EXPECT().Get(mock.Anything, testUserID).Return(false, false, errCache).Once()
+		sut.subscriptionRepository.EXPECT().FindActiveSubscriptionByUserID(mock.Anything, testUserID).
+			Return(newSubscription(t), nil).Once()
+		sut.accessCache.EXPECT().Set(mock.Anything, testUserID, true).Return(errCache).Once()
+		sut.logger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return().Twice()
+
+		// Act
+		active, err := sut.facade.IsUserSubscriptionActive(context.Background(), testUserID)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, active)
+	})
+
+	t.Run("failed database read returns error", func(t *testing.T) {
+		// Arrange
+		errDB := errors.New("database is down")
+		sut := newFacadeSUT(t)
+		sut.accessCache.EXPECT().Get(mock.Anything, testUserID).Return(false, false, nil).Once()
+		sut.subscriptionRepository.EXPECT().FindActiveSubscriptionByUserID(mock.Anything, testUserID).
+			Return(model.SubscriptionModel{}, errDB).Once()
+
+		// Act
+		_, err := sut.facade.IsUserSubscriptionActive(context.Background(), testUserID)
+
+		// Assert
+		require.ErrorIs(t, err, errDB)
+	})
+}
+
+type facadeSUT struct {
+	facade                 billing.FacadeInterface
+	subscriptionRepository *repository_mocks.MockSubscriptionRepository
+	accessCache            *service_mocks.MockSubscriptionAccessCache
+	logger                 *logger_mocks.MockLogger
+}
+
+func newFacadeSUT(t *testing.T) *facadeSUT {
+	t.Helper()
+
+	sut := &facadeSUT{
+		subscriptionRepository: repository_mocks.NewMockSubscriptionRepository(t),
+		accessCache:            service_mocks.NewMockSubscriptionAccessCache(t),
+		logger:                 logger_mocks.NewMockLogger(t),
+	}
+	sut.facade = billing.NewFacade(
+		sut.subscriptionRepository,
+		repository_mocks.NewMockPlanRepository(t),
+		sut.accessCache,
+		sut.logger,
+	)
+	return sut
+}
+
+func newSubscription(t *testing.T) model.SubscriptionModel {
+	t.Helper()
+
+	now := time.Now().UTC()
+	subscription, err := model.RestoreSubscriptionModel(
+		1, testUserID, 1, enum.EnumSubscriptionStatusActive, now, nil, nil, true, now, now, "", "", 0, nil, now, nil,
+	)
+	require.NoError(t, err)
+	return subscription
+}
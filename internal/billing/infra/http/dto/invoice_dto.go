@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+type InvoiceLineResponse struct {
+	Description     string `json:"description"`
+	Quantity        uint   `json:"quantity"`
+	UnitAmountCents uint   `json:"unit_amount_cents"`
+	UnitAmount      string `json:"unit_amount"`
+	AmountCents     uint   `json:"amount_cents"`
+	Amount          string `json:"amount"`
+}
+
+type InvoiceResponse struct {
+	InvoiceID      uint64                `json:"invoice_id"`
+	SubscriptionID uint64                `json:"subscription_id"`
+	Status         string                `json:"status"`
+	Currency       CurrencyResponse      `json:"currency"`
+	TotalCents     uint                  `json:"total_cents"`
+	Total          string                `json:"total"`
+	PeriodStart    time.Time             `json:"period_start"`
+	PeriodEnd      *time.Time            `json:"period_end"`
+	PaidAt         *time.Time            `json:"paid_at"`
+	Lines          []InvoiceLineResponse `json:"lines"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+type PaymentResponse struct {
+	PaymentID         uint64    `json:"payment_id"`
+	Status            string    `json:"status"`
+	AmountCents       uint      `json:"amount_cents"`
+	Amount            string    `json:"amount"`
+	Currency          string    `json:"currency"`
+	ProviderPaymentID string    `json:"provider_payment_id"`
+	FailureReason     string    `json:"failure_reason"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type InvoiceDetailResponse struct {
+	InvoiceResponse
+	Payments []PaymentResponse `json:"payments"`
+}
+
+type ListInvoicesResponse struct {
+	Invoices []InvoiceResponse `json:"invoices"`
+}
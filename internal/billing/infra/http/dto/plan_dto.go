@@ -0,0 +1,61 @@
+package dto
+
+import "time"
+
+type CreatePlanRequest struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	AmountCents          uint   `json:"amount_cents"`
+	Currency             string `json:"currency"`
+	Interval             string `json:"interval"`
+	TrialPeriodDays      *uint  `json:"trial_period_days"`
+	MaxConcurrentStreams uint   `json:"max_concurrent_streams"`
+	MaxResolution        string `json:"max_resolution"`
+	MaxDownloads         uint   `json:"max_downloads"`
+	MaxProfiles          uint   `json:"max_profiles"`
+}
+
+type UpdatePlanRequest struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	AmountCents          uint   `json:"amount_cents"`
+	Currency             string `json:"currency"`
+	Interval             string `json:"interval"`
+	TrialPeriodDays      *uint  `json:"trial_period_days"`
+	MaxConcurrentStreams uint   `json:"max_concurrent_streams"`
+	MaxResolution        string `json:"max_resolution"`
+	MaxDownloads         uint   `json:"max_downloads"`
+	MaxProfiles          uint   `json:"max_profiles"`
+}
+
+type CurrencyResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Number     string `json:"number"`
+	MinorUnits uint   `json:"minor_units"`
+}
+
+type EntitlementsResponse struct {
+	MaxConcurrentStreams uint   `json:"max_concurrent_streams"`
+	MaxResolution        string `json:"max_resolution"`
+	MaxDownloads         uint   `json:"max_downloads"`
+	MaxProfiles          uint   `json:"max_profiles"`
+}
+
+type PlanResponse struct {
+	PlanID          uint64               `json:"plan_id"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	AmountCents     uint                 `json:"amount_cents"`
+	Amount          string               `json:"amount"`
+	Currency        CurrencyResponse     `json:"currency"`
+	Interval        string               `json:"interval"`
+	TrialPeriodDays *uint                `json:"trial_period_days"`
+	Entitlements    EntitlementsResponse `json:"entitlements"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+type ListPlansResponse struct {
+	Plans []PlanResponse `json:"plans"`
+}
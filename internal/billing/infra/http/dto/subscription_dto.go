@@ -3,7 +3,8 @@ package dto
 import "time"
 
 type CreateSubscriptionRequest struct {
-	PlanID uint64 `json:"plan_id"`
+	PlanID             uint64 `json:"plan_id"`
+	PaymentMethodToken string `json:"payment_method_token"`
 }
 
 type CreateSubscriptionResponse struct {
@@ -13,6 +14,7 @@ type CreateSubscriptionResponse struct {
 	Status         string     `json:"status"`
 	StartDate      time.Time  `json:"start_date"`
 	EndDate        *time.Time `json:"end_date"`
+	TrialEndDate   *time.Time `json:"trial_end_date"`
 	AutoRenew      bool       `json:"auto_renew"`
 }
 
@@ -23,6 +25,8 @@ type SubscriptionResponse struct {
 	Status         string     `json:"status"`
 	StartDate      time.Time  `json:"start_date"`
 	EndDate        *time.Time `json:"end_date"`
+	TrialEndDate   *time.Time `json:"trial_end_date"`
+	PausedAt       *time.Time `json:"paused_at"`
 	AutoRenew      bool       `json:"auto_renew"`
 }
 
@@ -33,3 +37,17 @@ type ListSubscriptionsResponse struct {
 type IsUserSubscriptionActiveResponse struct {
 	IsActive bool `json:"is_active"`
 }
+
+type CancelSubscriptionRequest struct {
+	Immediately bool `json:"immediately"`
+}
+
+type ChangeSubscriptionPlanRequest struct {
+	PlanID uint64 `json:"plan_id"`
+}
+
+type ChangeSubscriptionPlanResponse struct {
+	Subscription  SubscriptionResponse `json:"subscription"`
+	ChargedCents  uint                 `json:"charged_cents"`
+	RefundedCents uint                 `json:"refunded_cents"`
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+)
+
+var notFoundErrors = []error{
+	errs.ErrPlanNotFound,
+	errs.ErrSubscriptionNotFound,
+	errs.ErrInvoiceNotFound,
+}
+
+var conflictErrors = []error{
+	errs.ErrPlanHasSubscriptions,
+	errs.ErrInvalidSubscriptionStatusTransition,
+	errs.ErrSubscriptionNotActive,
+	errs.ErrSubscriptionNotPaused,
+}
+
+var paymentRequiredErrors = []error{
+	errs.ErrPaymentDeclined,
+}
+
+var badRequestErrors = []error{
+	errs.ErrAmountExceedsMaximum,
+	errs.ErrNameRequired,
+	errs.ErrNameTooShort,
+	errs.ErrNameTooLong,
+	errs.ErrNameMustStartWithLetterOrDigit,
+	errs.ErrNameMustEndWithLetterOrDigit,
+	errs.ErrNameConsecutiveSpaces,
+	errs.ErrNameInvalidCharacters,
+	errs.ErrNameCannotStartOrEndWithSpaces,
+	errs.ErrNameCannotStartWithPunctuation,
+	errs.ErrNameCannotEndWithPunctuation,
+	errs.ErrNameExcessiveConsecutivePunctuation,
+	errs.ErrDescriptionTooLong,
+	errs.ErrDescriptionInvalidCharacters,
+	errs.ErrDescriptionCannotStartOrEndWithSpaces,
+	errs.ErrDescriptionExcessiveConsecutiveSpaces,
+	errs.ErrDescriptionControlCharacters,
+	errs.ErrCurrencyCodeEmpty,
+	errs.ErrCurrencyCodeInvalidLength,
+	errs.ErrCurrencyCodeInvalid,
+	errs.ErrTrialPeriodTooShort,
+	errs.ErrTrialPeriodTooLong,
+	errs.ErrInvalidPlanInterval,
+	errs.ErrInvalidVideoResolution,
+	errs.ErrMaxConcurrentStreamsOutOfRange,
+	errs.ErrMaxProfilesOutOfRange,
+	errs.ErrMaxDownloadsTooHigh,
+	errs.ErrSubscriptionAlreadyOnPlan,
+	errs.ErrPlanIntervalMismatch,
+	errs.ErrPlanCurrencyMismatch,
+	errs.ErrPlanChangeRequiresEndDate,
+	errs.ErrPaymentMethodRequired,
+	errs.ErrPaymentMethodNotFound,
+}
+
+// mapError translates billing domain errors into HTTP errors, falling back to the shared mapper.
+func mapError(errorMapper shared_errs.ErrorMapper, err error) error {
+	for _, notFoundErr := range notFoundErrors {
+		if errors.Is(err, notFoundErr) {
+			return errorMapper.MapCustomError(http.StatusNotFound, err.Error())
+		}
+	}
+	for _, conflictErr := range conflictErrors {
+		if errors.Is(err, conflictErr) {
+			return errorMapper.MapCustomError(http.StatusConflict, err.Error())
+		}
+	}
+	for _, paymentRequiredErr := range paymentRequiredErrors {
+		if errors.Is(err, paymentRequiredErr) {
+			return errorMapper.MapCustomError(http.StatusPaymentRequired, err.Error())
+		}
+	}
+	for _, badRequestErr := range badRequestErrors {
+		if errors.Is(err, badRequestErr) {
+			return errorMapper.MapCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	return errorMapper.Map(err)
+}
+
+func parseIDParam(r *http.Request, name string) (uint64, error) {
+	id, err := strconv.ParseUint(request.Param(r, name), 10, 64)
+	if err != nil || id == 0 {
+		return 0, shared_errs.NewBadRequestError("invalid " + name)
+	}
+	return id, nil
+}
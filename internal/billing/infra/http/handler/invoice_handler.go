@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type InvoiceHandler struct {
+	errorMapper         shared_errs.ErrorMapper
+	listInvoicesUseCase *usecase.ListInvoicesUseCase
+	findInvoiceUseCase  *usecase.FindInvoiceUseCase
+}
+
+func NewInvoiceHandler(
+	errorMapper shared_errs.ErrorMapper,
+	listInvoicesUseCase *usecase.ListInvoicesUseCase,
+	findInvoiceUseCase *usecase.FindInvoiceUseCase,
+) *InvoiceHandler {
+	return &InvoiceHandler{
+		errorMapper,
+		listInvoicesUseCase,
+		findInvoiceUseCase,
+	}
+}
+
+// @Summary		List invoices
+// @Description	Retrieves the billing history of the authenticated user, most recent period first
+// @Tags		Invoices
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[dto.ListInvoicesResponse]	"Successfully retrieved invoices"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/billing/invoices [get]
+func (h *InvoiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "InvoiceHandler.List")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	output, err := h.listInvoicesUseCase.Execute(ctx, usecase.ListInvoicesInput{UserID: userID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	invoices := make([]dto.InvoiceResponse, 0, len(output))
+	for _, invoice := range output {
+		invoices = append(invoices, toInvoiceResponse(invoice))
+	}
+
+	envelope := response.NewEnvelope(dto.ListInvoicesResponse{Invoices: invoices})
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Find invoice
+// @Description	Retrieves an invoice of the authenticated user with its lines and payment attempts
+// @Tags		Invoices
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Invoice ID"
+// @Success		200	{object}	response.Envelope[dto.InvoiceDetailResponse]	"Successfully retrieved invoice"
+// @Failure		400	{object}	errs.Error	"Invalid invoice ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Invoice not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/billing/invoices/{id} [get]
+func (h *InvoiceHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "InvoiceHandler.Find")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	invoiceID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.FindInvoiceInput{UserID: userID, InvoiceID: invoiceID}
+	output, err := h.findInvoiceUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	payments := make([]dto.PaymentResponse, 0, len(output.Payments))
+	for _, payment := range output.Payments {
+		payments = append(payments, dto.PaymentResponse{
+			PaymentID:         payment.PaymentID,
+			Status:            payment.Status,
+			AmountCents:       payment.AmountCents,
+			Amount:            payment.Amount,
+			Currency:          payment.CurrencyCode,
+			ProviderPaymentID: payment.ProviderPaymentID,
+			FailureReason:     payment.FailureReason,
+			CreatedAt:         payment.CreatedAt,
+		})
+	}
+
+	resData := dto.InvoiceDetailResponse{
+		InvoiceResponse: toInvoiceResponse(output.Invoice),
+		Payments:        payments,
+	}
+
+	envelope := response.NewEnvelope(resData)
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+func toInvoiceResponse(output usecase.InvoiceOutput) dto.InvoiceResponse {
+	lines := make([]dto.InvoiceLineResponse, 0, len(output.Lines))
+	for _, line := range output.Lines {
+		lines = append(lines, dto.InvoiceLineResponse{
+			Description:     line.Description,
+			Quantity:        line.Quantity,
+			UnitAmountCents: line.UnitAmountCents,
+			UnitAmount:      line.UnitAmount,
+			AmountCents:     line.AmountCents,
+			Amount:          line.Amount,
+		})
+	}
+
+	return dto.InvoiceResponse{
+		InvoiceID:      output.InvoiceID,
+		SubscriptionID: output.SubscriptionID,
+		Status:         output.Status,
+		Currency: dto.CurrencyResponse{
+			Code:       output.CurrencyCode,
+			Name:       output.CurrencyName,
+			Number:     output.CurrencyNumber,
+			MinorUnits: output.MinorUnits,
+		},
+		TotalCents:  output.TotalCents,
+		Total:       output.Total,
+		PeriodStart: output.PeriodStart,
+		PeriodEnd:   output.PeriodEnd,
+		PaidAt:      output.PaidAt,
+		Lines:       lines,
+		CreatedAt:   output.CreatedAt,
+	}
+}
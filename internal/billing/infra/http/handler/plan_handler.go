@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type PlanHandler struct {
+	errorMapper       shared_errs.ErrorMapper
+	createPlanUseCase *usecase.CreatePlanUseCase
+	updatePlanUseCase *usecase.UpdatePlanUseCase
+	findPlanUseCase   *usecase.FindPlanUseCase
+	listPlansUseCase  *usecase.ListPlansUseCase
+	deletePlanUseCase *usecase.DeletePlanUseCase
+}
+
+func NewPlanHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createPlanUseCase *usecase.CreatePlanUseCase,
+	updatePlanUseCase *usecase.UpdatePlanUseCase,
+	findPlanUseCase *usecase.FindPlanUseCase,
+	listPlansUseCase *usecase.ListPlansUseCase,
+	deletePlanUseCase *usecase.DeletePlanUseCase,
+) *PlanHandler {
+	return &PlanHandler{
+		errorMapper,
+		createPlanUseCase,
+		updatePlanUseCase,
+		findPlanUseCase,
+		listPlansUseCase,
+		deletePlanUseCase,
+	}
+}
+
+// @Summary		Create plan
+// @Description	Creates a new subscription plan
+// @Tags		Plans
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreatePlanRequest	true	"Plan data"
+// @Success		201	{object}	response.Envelope[dto.PlanResponse]	"Successfully created plan"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/plans [post]
+func (h *PlanHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlanHandler.Create")
+	defer span.End()
+
+	var createPlanRequest dto.CreatePlanRequest
+	if err := request.ReadJSON(w, r, &createPlanRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreatePlanInput{
+		Name:                 createPlanRequest.Name,
+		Description:          createPlanRequest.Description,
+		AmountCents:          createPlanRequest.AmountCents,
+		Currency:             createPlanRequest.Currency,
+		Interval:             createPlanRequest.Interval,
+		TrialPeriodDays:      createPlanRequest.TrialPeriodDays,
+		MaxConcurrentStreams: createPlanRequest.MaxConcurrentStreams,
+		MaxResolution:        createPlanRequest.MaxResolution,
+		MaxDownloads:         createPlanRequest.MaxDownloads,
+		MaxProfiles:          createPlanRequest.MaxProfiles,
+	}
+
+	output, err := h.createPlanUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toPlanResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		List plans
+// @Description	Retrieves all subscription plans with their formatted prices. This endpoint is public.
+// @Tags		Plans
+// @Accept		json
+// @Produce		json
+// @Success		200	{object}	response.Envelope[dto.ListPlansResponse]	"Successfully retrieved plans"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/plans [get]
+func (h *PlanHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlanHandler.List")
+	defer span.End()
+
+	output, err := h.listPlansUseCase.Execute(ctx)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	plans := make([]dto.PlanResponse, 0, len(output))
+	for _, plan := range output {
+		plans = append(plans, toPlanResponse(plan))
+	}
+
+	envelope := response.NewEnvelope(dto.ListPlansResponse{Plans: plans})
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Find plan
+// @Description	Retrieves a subscription plan by its ID. This endpoint is public.
+// @Tags		Plans
+// @Accept		json
+// @Produce		json
+// @Param		id	path	int	true	"Plan ID"
+// @Success		200	{object}	response.Envelope[dto.PlanResponse]	"Successfully retrieved plan"
+// @Failure		400	{object}	errs.Error	"Invalid plan ID"
+// @Failure		404	{object}	errs.Error	"Plan not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/plans/{id} [get]
+func (h *PlanHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlanHandler.Find")
+	defer span.End()
+
+	planID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.findPlanUseCase.Execute(ctx, usecase.FindPlanInput{PlanID: planID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toPlanResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Update plan
+// @Description	Updates an existing subscription plan
+// @Tags		Plans
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Plan ID"
+// @Param		request	body	dto.UpdatePlanRequest	true	"Plan data"
+// @Success		200	{object}	response.Envelope[dto.PlanResponse]	"Successfully updated plan"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Plan not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/plans/{id} [put]
+func (h *PlanHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlanHandler.Update")
+	defer span.End()
+
+	planID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var updatePlanRequest dto.UpdatePlanRequest
+	if err = request.ReadJSON(w, r, &updatePlanRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UpdatePlanInput{
+		PlanID:               planID,
+		Name:                 updatePlanRequest.Name,
+		Description:          updatePlanRequest.Description,
+		AmountCents:          updatePlanRequest.AmountCents,
+		Currency:             updatePlanRequest.Currency,
+		Interval:             updatePlanRequest.Interval,
+		TrialPeriodDays:      updatePlanRequest.TrialPeriodDays,
+		MaxConcurrentStreams: updatePlanRequest.MaxConcurrentStreams,
+		MaxResolution:        updatePlanRequest.MaxResolution,
+		MaxDownloads:         updatePlanRequest.MaxDownloads,
+		MaxProfiles:          updatePlanRequest.MaxProfiles,
+	}
+
+	output, err := h.updatePlanUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toPlanResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Delete plan
+// @Description	Deletes a subscription plan that has no subscriptions
+// @Tags		Plans
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Plan ID"
+// @Success		204	"Successfully deleted plan"
+// @Failure		400	{object}	errs.Error	"Invalid plan ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Plan not found"
+// @Failure		409	{object}	errs.Error	"Plan has subscriptions"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/plans/{id} [delete]
+func (h *PlanHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlanHandler.Delete")
+	defer span.End()
+
+	planID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	err = h.deletePlanUseCase.Execute(ctx, usecase.DeletePlanInput{PlanID: planID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toPlanResponse(output usecase.PlanOutput) dto.PlanResponse {
+	return dto.PlanResponse{
+		PlanID:      output.PlanID,
+		Name:        output.Name,
+		Description: output.Description,
+		AmountCents: output.AmountCents,
+		Amount:      output.Amount,
+		Currency: dto.CurrencyResponse{
+			Code:       output.CurrencyCode,
+			Name:       output.CurrencyName,
+			Number:     output.CurrencyNumber,
+			MinorUnits: output.MinorUnits,
+		},
+		Interval:        output.Interval,
+		TrialPeriodDays: output.TrialPeriodDays,
+		Entitlements: dto.EntitlementsResponse{
+			MaxConcurrentStreams: output.MaxConcurrentStreams,
+			MaxResolution:        output.MaxResolution,
+			MaxDownloads:         output.MaxDownloads,
+			MaxProfiles:          output.MaxProfiles,
+		},
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	}
+}
@@ -15,19 +15,31 @@ import (
 )
 
 type SubscriptionHandler struct {
-	errorMapper               shared_errs.ErrorMapper
-	createSubscriptionUseCase *usecase.CreateSubscriptionUseCase
-	subscriptionRepository    repository.SubscriptionRepository
+	errorMapper                   shared_errs.ErrorMapper
+	createSubscriptionUseCase     *usecase.CreateSubscriptionUseCase
+	cancelSubscriptionUseCase     *usecase.CancelSubscriptionUseCase
+	pauseSubscriptionUseCase      *usecase.PauseSubscriptionUseCase
+	resumeSubscriptionUseCase     *usecase.ResumeSubscriptionUseCase
+	changeSubscriptionPlanUseCase *usecase.ChangeSubscriptionPlanUseCase
+	subscriptionRepository        repository.SubscriptionRepository
 }
 
 func NewSubscriptionHandler(
 	errorMapper shared_errs.ErrorMapper,
 	createSubscriptionUseCase *usecase.CreateSubscriptionUseCase,
+	cancelSubscriptionUseCase *usecase.CancelSubscriptionUseCase,
+	pauseSubscriptionUseCase *usecase.PauseSubscriptionUseCase,
+	resumeSubscriptionUseCase *usecase.ResumeSubscriptionUseCase,
+	changeSubscriptionPlanUseCase *usecase.ChangeSubscriptionPlanUseCase,
 	subscriptionRepository repository.SubscriptionRepository,
 ) *SubscriptionHandler {
 	return &SubscriptionHandler{
 		errorMapper,
 		createSubscriptionUseCase,
+		cancelSubscriptionUseCase,
+		pauseSubscriptionUseCase,
+		resumeSubscriptionUseCase,
+		changeSubscriptionPlanUseCase,
 		subscriptionRepository,
 	}
 }
@@ -39,10 +51,14 @@ func NewSubscriptionHandler(
 // @Produce		json
 // @Security 	BearerAuth
 // @Param		request	body	dto.CreateSubscriptionRequest	true	"Subscription data"
+// @Param		Idempotency-Key	header	string	false	"Replays the response to the first request with the key"
 // @Success		201	{object}	response.Envelope[dto.CreateSubscriptionResponse]	"Successfully created subscription"
 // @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
-// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		402	{object}	errs.Error	"Payment declined, the subscription is left past due"
+// @Failure		409	{object}	errs.Error	"A request with the idempotency key is still being processed"
+// @Failure		422	{object}	errs.Error	"Invalid request format, validation error or idempotency key reused"
+// @Failure		429	{object}	errs.Error	"Too many requests"
 // @Failure		500	{object}	errs.Error	"Internal server error"
 // @Router		/api/v1/subscriptions [post]
 func (h *SubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
@@ -62,8 +78,9 @@ func (h *SubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	input := usecase.CreateSubscriptionInput{
-		PlanID: createSubscriptionRequest.PlanID,
-		UserID: userID,
+		PlanID:             createSubscriptionRequest.PlanID,
+		UserID:             userID,
+		PaymentMethodToken: createSubscriptionRequest.PaymentMethodToken,
 	}
 
 	output, err := h.createSubscriptionUseCase.Execute(ctx, input)
@@ -73,11 +90,20 @@ func (h *SubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
 			response.Error(w, rError)
 			return
 		}
-		if errors.Is(err, errs.ErrUserAlreadyHasActiveSubscription) {
+		if errors.Is(err, errs.ErrUserAlreadyHasActiveSubscription) ||
+			errors.Is(err, errs.ErrUserHasPausedSubscription) ||
+			errors.Is(err, errs.ErrTrialAlreadyUsed) ||
+			errors.Is(err, errs.ErrPaymentMethodRequired) ||
+			errors.Is(err, errs.ErrPaymentMethodNotFound) {
 			rError := h.errorMapper.MapCustomError(http.StatusBadRequest, err.Error())
 			response.Error(w, rError)
 			return
 		}
+		if errors.Is(err, errs.ErrPaymentDeclined) {
+			rError := h.errorMapper.MapCustomError(http.StatusPaymentRequired, err.Error())
+			response.Error(w, rError)
+			return
+		}
 		rError := h.errorMapper.Map(err)
 		response.Error(w, rError)
 		return
@@ -90,6 +116,7 @@ func (h *SubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Status:         output.Status,
 		StartDate:      output.StartDate,
 		EndDate:        output.EndDate,
+		TrialEndDate:   output.TrialEndDate,
 		AutoRenew:      output.AutoRenew,
 	}
 
@@ -134,6 +161,8 @@ func (h *SubscriptionHandler) FindByUserID(w http.ResponseWriter, r *http.Reques
 			Status:         status.String(),
 			StartDate:      subscription.StartDate(),
 			EndDate:        subscription.EndDate(),
+			TrialEndDate:   subscription.TrialEndDate(),
+			PausedAt:       subscription.PausedAt(),
 			AutoRenew:      subscription.AutoRenew(),
 		}
 		subscriptionResponses = append(subscriptionResponses, subscriptionResponse)
@@ -177,3 +206,217 @@ func (h *SubscriptionHandler) IsUserSubscriptionActive(w http.ResponseWriter, r
 	envelope := response.NewEnvelope(resData)
 	response.JSON(w, http.StatusOK, envelope, nil)
 }
+
+// @Summary		Cancel subscription
+// @Description	Cancels a subscription of the authenticated user, immediately or at the end of the paid period
+// @Tags		Subscriptions
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Subscription ID"
+// @Param		request	body	dto.CancelSubscriptionRequest	true	"Cancellation options"
+// @Success		200	{object}	response.Envelope[dto.SubscriptionResponse]	"Successfully cancelled subscription"
+// @Failure		400	{object}	errs.Error	"Invalid request format"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Subscription not found"
+// @Failure		409	{object}	errs.Error	"Subscription cannot be cancelled in its current status"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/subscriptions/{id}/cancel [post]
+func (h *SubscriptionHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SubscriptionHandler.Cancel")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	subscriptionID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var cancelSubscriptionRequest dto.CancelSubscriptionRequest
+	if err = request.ReadJSON(w, r, &cancelSubscriptionRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.cancelSubscriptionUseCase.Execute(ctx, usecase.CancelSubscriptionInput{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+		Immediately:    cancelSubscriptionRequest.Immediately,
+	})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toSubscriptionResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Pause subscription
+// @Description	Pauses an active subscription of the authenticated user. The time left in the period is kept for when it resumes
+// @Tags		Subscriptions
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Subscription ID"
+// @Success		200	{object}	response.Envelope[dto.SubscriptionResponse]	"Successfully paused subscription"
+// @Failure		400	{object}	errs.Error	"Invalid subscription ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Subscription not found"
+// @Failure		409	{object}	errs.Error	"Subscription cannot be paused in its current status"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/subscriptions/{id}/pause [post]
+func (h *SubscriptionHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SubscriptionHandler.Pause")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	subscriptionID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.pauseSubscriptionUseCase.Execute(ctx, usecase.PauseSubscriptionInput{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+	})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toSubscriptionResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Resume subscription
+// @Description	Resumes a paused subscription of the authenticated user
+// @Tags		Subscriptions
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Subscription ID"
+// @Success		200	{object}	response.Envelope[dto.SubscriptionResponse]	"Successfully resumed subscription"
+// @Failure		400	{object}	errs.Error	"Invalid subscription ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Subscription not found"
+// @Failure		409	{object}	errs.Error	"Subscription is not paused"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/subscriptions/{id}/resume [post]
+func (h *SubscriptionHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SubscriptionHandler.Resume")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	subscriptionID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.resumeSubscriptionUseCase.Execute(ctx, usecase.ResumeSubscriptionInput{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+	})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toSubscriptionResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Change subscription plan
+// @Description	Upgrades or downgrades a subscription of the authenticated user to a plan with the same interval and currency. The difference for the rest of the period is charged or refunded
+// @Tags		Subscriptions
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Subscription ID"
+// @Param		request	body	dto.ChangeSubscriptionPlanRequest	true	"New plan"
+// @Success		200	{object}	response.Envelope[dto.ChangeSubscriptionPlanResponse]	"Successfully changed plan"
+// @Failure		400	{object}	errs.Error	"Invalid request format or incompatible plan"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		402	{object}	errs.Error	"Payment declined, the subscription keeps its current plan"
+// @Failure		404	{object}	errs.Error	"Subscription not found"
+// @Failure		409	{object}	errs.Error	"Subscription is not active"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/subscriptions/{id}/plan [put]
+func (h *SubscriptionHandler) ChangePlan(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SubscriptionHandler.ChangePlan")
+	defer span.End()
+
+	userID := request.GetUserID(r)
+	if userID == 0 {
+		response.JSON(w, http.StatusUnauthorized, nil, nil)
+		return
+	}
+
+	subscriptionID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var changePlanRequest dto.ChangeSubscriptionPlanRequest
+	if err = request.ReadJSON(w, r, &changePlanRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.changeSubscriptionPlanUseCase.Execute(ctx, usecase.ChangeSubscriptionPlanInput{
+		UserID:         userID,
+		SubscriptionID: subscriptionID,
+		PlanID:         changePlanRequest.PlanID,
+	})
+	if err != nil {
+		// The plan comes from the body, so a missing plan is a bad request like on create
+		if errors.Is(err, errs.ErrPlanNotFound) {
+			response.Error(w, h.errorMapper.MapCustomError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	resData := dto.ChangeSubscriptionPlanResponse{
+		Subscription:  toSubscriptionResponse(output.Subscription),
+		ChargedCents:  output.ChargedCents,
+		RefundedCents: output.RefundedCents,
+	}
+
+	envelope := response.NewEnvelope(resData)
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+func toSubscriptionResponse(output usecase.SubscriptionOutput) dto.SubscriptionResponse {
+	return dto.SubscriptionResponse{
+		SubscriptionID: output.SubscriptionID,
+		UserID:         output.UserID,
+		PlanID:         output.PlanID,
+		Status:         output.Status,
+		StartDate:      output.StartDate,
+		EndDate:        output.EndDate,
+		TrialEndDate:   output.TrialEndDate,
+		PausedAt:       output.PausedAt,
+		AutoRenew:      output.AutoRenew,
+	}
+}
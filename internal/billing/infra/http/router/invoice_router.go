@@ -0,0 +1,26 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupInvoiceRoutes(
+	r *Router,
+	invoiceHandler *handler.InvoiceHandler,
+	authMiddleware *middleware.AuthMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/billing/invoices",
+		authMiddleware.Middleware(invoiceHandler.List),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/billing/invoices/:id",
+		authMiddleware.Middleware(invoiceHandler.Find),
+	)
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupPlanRoutes(
+	r *Router,
+	planHandler *handler.PlanHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/plans",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(planHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/plans",
+		planHandler.List,
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/plans/:id",
+		planHandler.Find,
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/plans/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(planHandler.Update, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/plans/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(planHandler.Delete, enum.EnumRoleAdmin)),
+	)
+}
@@ -4,14 +4,55 @@ import (
 	"net/http"
 
 	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	shared_middleware "github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/middleware"
 )
 
 func SetupSubscriptionRoutes(
 	r *Router,
 	subscriptionHandler *handler.SubscriptionHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	idempotencyMiddleware *shared_middleware.IdempotencyMiddleware,
+	rateLimitMiddleware *shared_middleware.RateLimitMiddleware,
 ) {
 	router := r.Router()
-	router.HandlerFunc(http.MethodPost, "/api/v1/subscriptions", subscriptionHandler.Create)
-	router.HandlerFunc(http.MethodGet, "/api/v1/subscriptions", subscriptionHandler.FindByUserID)
-	router.HandlerFunc(http.MethodGet, "/api/v1/subscriptions/active", subscriptionHandler.IsUserSubscriptionActive)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/subscriptions",
+		authMiddleware.Middleware(rateLimitMiddleware.Middleware(
+			config.RateLimitPolicySubscriptionsCreate,
+			idempotencyMiddleware.Middleware(subscriptionHandler.Create),
+		)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/subscriptions",
+		authMiddleware.Middleware(subscriptionHandler.FindByUserID),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/subscriptions/active",
+		authMiddleware.Middleware(subscriptionHandler.IsUserSubscriptionActive),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/subscriptions/:id/cancel",
+		authMiddleware.Middleware(subscriptionHandler.Cancel),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/subscriptions/:id/pause",
+		authMiddleware.Middleware(subscriptionHandler.Pause),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/subscriptions/:id/resume",
+		authMiddleware.Middleware(subscriptionHandler.Resume),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/subscriptions/:id/plan",
+		authMiddleware.Middleware(subscriptionHandler.ChangePlan),
+	)
 }
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/scheduler"
+)
+
+type RenewSubscriptionsJobResult struct {
+	fx.Out
+
+	Job scheduler.Job `group:"scheduler_jobs"`
+}
+
+// NewRenewSubscriptionsJob renews and expires subscriptions on the interval set by
+// BILLING_RENEWAL_INTERVAL_IN_SECONDS. The billing:renew command runs the same use case on demand.
+func NewRenewSubscriptionsJob(
+	renewSubscriptionsUseCase *usecase.RenewSubscriptionsUseCase,
+	conf config.Config,
+	logger logger.Logger,
+) RenewSubscriptionsJobResult {
+	job := scheduler.Job{
+		Name:     "billing:renew",
+		Interval: time.Duration(conf.Billing.RenewalIntervalInSeconds) * time.Second,
+		Run: func(ctx context.Context) error {
+			output, err := renewSubscriptionsUseCase.Execute(ctx, NewRenewSubscriptionsInput(conf))
+			if err != nil {
+				return err
+			}
+
+			logger.Info(
+				"subscriptions renewed",
+				"renewed", output.Renewed,
+				"pastDue", output.PastDue,
+				"expired", output.Expired,
+				"failed", output.Failed,
+			)
+			return nil
+		},
+	}
+
+	return RenewSubscriptionsJobResult{Job: job}
+}
+
+// NewRenewSubscriptionsInput builds the renewal schedule from the billing settings.
+func NewRenewSubscriptionsInput(conf config.Config) usecase.RenewSubscriptionsInput {
+	return usecase.RenewSubscriptionsInput{
+		Now:           time.Now().UTC(),
+		LeadTime:      time.Duration(conf.Billing.RenewalLeadTimeInSeconds) * time.Second,
+		RetryInterval: time.Duration(conf.Billing.RetryIntervalInSeconds) * time.Second,
+		GracePeriod:   time.Duration(conf.Billing.GracePeriodInSeconds) * time.Second,
+	}
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type InvoiceEntity struct {
+	ID             uint64              `gorm:"primarykey;autoIncrement;column:id"`
+	UserID         uint64              `gorm:"type:bigint;not null;column:user_id"`
+	SubscriptionID uint64              `gorm:"type:bigint;not null;column:subscription_id"`
+	Status         string              `gorm:"type:varchar(20);not null;column:status"`
+	Currency       string              `gorm:"type:varchar(3);not null;column:currency"`
+	TotalCents     uint                `gorm:"type:integer;not null;column:total_cents"`
+	PeriodStart    time.Time           `gorm:"type:timestamptz;not null;column:period_start"`
+	PeriodEnd      *time.Time          `gorm:"type:timestamptz;column:period_end"`
+	PaidAt         *time.Time          `gorm:"type:timestamptz;column:paid_at"`
+	Lines          []InvoiceLineEntity `gorm:"foreignKey:InvoiceID"`
+	CreatedAt      time.Time           `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt      time.Time           `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*InvoiceEntity) TableName() string {
+	return "invoice"
+}
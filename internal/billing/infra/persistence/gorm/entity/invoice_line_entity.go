@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type InvoiceLineEntity struct {
+	ID              uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	InvoiceID       uint64    `gorm:"type:bigint;not null;column:invoice_id"`
+	Description     string    `gorm:"type:varchar(255);not null;column:description"`
+	Quantity        uint      `gorm:"type:integer;not null;column:quantity"`
+	UnitAmountCents uint      `gorm:"type:integer;not null;column:unit_amount_cents"`
+	AmountCents     uint      `gorm:"type:integer;not null;column:amount_cents"`
+	CreatedAt       time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+}
+
+func (*InvoiceLineEntity) TableName() string {
+	return "invoice_line"
+}
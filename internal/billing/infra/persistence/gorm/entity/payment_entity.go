@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type PaymentEntity struct {
+	ID                uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	InvoiceID         uint64    `gorm:"type:bigint;not null;column:invoice_id"`
+	Status            string    `gorm:"type:varchar(20);not null;column:status"`
+	AmountCents       uint      `gorm:"type:integer;not null;column:amount_cents"`
+	Currency          string    `gorm:"type:varchar(3);not null;column:currency"`
+	ProviderPaymentID string    `gorm:"type:varchar(255);not null;column:provider_payment_id"`
+	FailureReason     string    `gorm:"type:text;not null;column:failure_reason"`
+	CreatedAt         time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt         time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*PaymentEntity) TableName() string {
+	return "payment"
+}
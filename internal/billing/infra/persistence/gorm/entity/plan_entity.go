@@ -3,15 +3,19 @@ package entity
 import "time"
 
 type PlanEntity struct {
-	ID          uint64    `gorm:"primarykey;autoIncrement;column:id"`
-	Name        string    `gorm:"type:varchar(100);not null;column:name"`
-	Description string    `gorm:"type:varchar(1000);not null;column:description"`
-	AmountCents uint      `gorm:"type:integer;not null;column:amount_cents"`
-	Currency    string    `gorm:"type:varchar(3);not null;column:currency"`
-	Interval    string    `gorm:"type:varchar(10);not null;column:interval"`
-	TrialPeriod uint      `gorm:"type:integer;not null;column:trial_period"`
-	CreatedAt   time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
-	UpdatedAt   time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+	ID                   uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	Name                 string    `gorm:"type:varchar(100);not null;column:name"`
+	Description          string    `gorm:"type:varchar(1000);not null;column:description"`
+	AmountCents          uint      `gorm:"type:integer;not null;column:amount_cents"`
+	Currency             string    `gorm:"type:varchar(3);not null;column:currency"`
+	Interval             string    `gorm:"type:varchar(10);not null;column:interval"`
+	TrialPeriod          uint      `gorm:"type:integer;not null;column:trial_period"`
+	MaxConcurrentStreams uint      `gorm:"type:integer;not null;column:max_concurrent_streams"`
+	MaxResolution        string    `gorm:"type:video_resolution_enum;not null;column:max_resolution"`
+	MaxDownloads         uint      `gorm:"type:integer;not null;column:max_downloads"`
+	MaxProfiles          uint      `gorm:"type:integer;not null;column:max_profiles"`
+	CreatedAt            time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt            time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
 }
 
 func (*PlanEntity) TableName() string {
@@ -3,15 +3,25 @@ package entity
 import "time"
 
 type SubscriptionEntity struct {
-	ID        uint64    `gorm:"primarykey;autoIncrement;column:id"`
-	UserID    uint64    `gorm:"type:bigint;not null;column:user_id"`
-	PlanID    uint64    `gorm:"type:bigint;not null;column:plan_id"`
-	Status    string    `gorm:"type:varchar(20);not null;column:status"`
-	StartDate time.Time `gorm:"type:timestamptz;not null;column:start_date"`
-	EndDate   time.Time `gorm:"type:timestamptz;column:end_date"`
-	AutoRenew bool      `gorm:"type:boolean;not null;default:true;column:auto_renew"`
-	CreatedAt time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
-	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+	ID           uint64     `gorm:"primarykey;autoIncrement;column:id"`
+	UserID       uint64     `gorm:"type:bigint;not null;column:user_id"`
+	PlanID       uint64     `gorm:"type:bigint;not null;column:plan_id"`
+	Status       string     `gorm:"type:varchar(20);not null;column:status"`
+	StartDate    time.Time  `gorm:"type:timestamptz;not null;column:start_date"`
+	EndDate      time.Time  `gorm:"type:timestamptz;column:end_date"`
+	TrialEndDate *time.Time `gorm:"type:timestamptz;column:trial_end_date"`
+	AutoRenew    bool       `gorm:"type:boolean;not null;default:true;column:auto_renew"`
+	CreatedAt    time.Time  `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt    time.Time  `gorm:"type:timestamptz;default:now();column:updated_at"`
+
+	PaymentCustomerID string `gorm:"type:varchar(255);column:payment_customer_id"`
+	PaymentMethodID   string `gorm:"type:varchar(255);column:payment_method_id"`
+
+	RetryCount  uint       `gorm:"type:integer;not null;default:0;column:retry_count"`
+	NextRetryAt *time.Time `gorm:"type:timestamptz;column:next_retry_at"`
+
+	CurrentPeriodStart time.Time  `gorm:"type:timestamptz;not null;column:current_period_start"`
+	PausedAt           *time.Time `gorm:"type:timestamptz;column:paused_at"`
 }
 
 func (*SubscriptionEntity) TableName() string {
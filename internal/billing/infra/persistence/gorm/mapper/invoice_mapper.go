@@ -0,0 +1,90 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+)
+
+type InvoiceMapper interface {
+	ToModel(entity entity.InvoiceEntity) (model.InvoiceModel, error)
+	ToEntity(model model.InvoiceModel) entity.InvoiceEntity
+}
+
+type invoiceMapper struct {
+}
+
+func NewInvoiceMapper() InvoiceMapper {
+	return &invoiceMapper{}
+}
+
+func (m *invoiceMapper) ToModel(entity entity.InvoiceEntity) (model.InvoiceModel, error) {
+	lines := make([]model.InvoiceLineModel, 0, len(entity.Lines))
+	for _, lineEntity := range entity.Lines {
+		line, err := model.RestoreInvoiceLineModel(
+			lineEntity.ID,
+			lineEntity.InvoiceID,
+			lineEntity.Description,
+			lineEntity.Quantity,
+			lineEntity.UnitAmountCents,
+			lineEntity.CreatedAt,
+		)
+		if err != nil {
+			return model.InvoiceModel{}, err
+		}
+		lines = append(lines, line)
+	}
+
+	invoiceModel, err := model.RestoreInvoiceModel(
+		entity.ID,
+		entity.UserID,
+		entity.SubscriptionID,
+		entity.Status,
+		entity.Currency,
+		entity.PeriodStart,
+		entity.PeriodEnd,
+		lines,
+		entity.PaidAt,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+	return invoiceModel, nil
+}
+
+func (m *invoiceMapper) ToEntity(model model.InvoiceModel) entity.InvoiceEntity {
+	lines := make([]entity.InvoiceLineEntity, 0, len(model.Lines()))
+	for _, line := range model.Lines() {
+		unitAmount := line.UnitAmount()
+		amount := line.Amount()
+		lines = append(lines, entity.InvoiceLineEntity{
+			ID:              line.ID(),
+			InvoiceID:       line.InvoiceID(),
+			Description:     line.Description(),
+			Quantity:        line.Quantity(),
+			UnitAmountCents: unitAmount.Cents(),
+			AmountCents:     amount.Cents(),
+			CreatedAt:       line.CreatedAt(),
+		})
+	}
+
+	statusEnum := model.Status()
+	currencyModel := model.Currency()
+	totalModel := model.Total()
+
+	return entity.InvoiceEntity{
+		ID:             model.ID(),
+		UserID:         model.UserID(),
+		SubscriptionID: model.SubscriptionID(),
+		Status:         statusEnum.String(),
+		Currency:       currencyModel.Code(),
+		TotalCents:     totalModel.Cents(),
+		PeriodStart:    model.PeriodStart(),
+		PeriodEnd:      model.PeriodEnd(),
+		PaidAt:         model.PaidAt(),
+		Lines:          lines,
+		CreatedAt:      model.CreatedAt(),
+		UpdatedAt:      model.UpdatedAt(),
+	}
+}
@@ -0,0 +1,116 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+)
+
+type InvoiceMapperTestSuite struct {
+	suite.Suite
+	sut mapper.InvoiceMapper
+}
+
+func (s *InvoiceMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewInvoiceMapper()
+}
+
+func TestInvoiceMapperSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceMapperTestSuite))
+}
+
+func (s *InvoiceMapperTestSuite) TestToModel_ValidInvoiceEntityWithLines_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	periodEnd := now.AddDate(0, 1, 0)
+	invoiceEntity := entity.InvoiceEntity{
+		ID:             10,
+		UserID:         1,
+		SubscriptionID: 2,
+		Status:         "Paid",
+		Currency:       "USD",
+		TotalCents:     1999,
+		PeriodStart:    now,
+		PeriodEnd:      &periodEnd,
+		PaidAt:         &now,
+		Lines: []entity.InvoiceLineEntity{
+			{
+				ID:              20,
+				InvoiceID:       10,
+				Description:     "Premium Plan (Month)",
+				Quantity:        1,
+				UnitAmountCents: 1999,
+				AmountCents:     1999,
+				CreatedAt:       now,
+			},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Act
+	invoiceModel, err := s.sut.ToModel(invoiceEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(10), invoiceModel.ID())
+	s.Equal(uint64(1), invoiceModel.UserID())
+	s.Equal(uint64(2), invoiceModel.SubscriptionID())
+	s.True(invoiceModel.IsPaid())
+	total := invoiceModel.Total()
+	s.Equal(uint(1999), total.Cents())
+	s.Equal(&periodEnd, invoiceModel.PeriodEnd())
+	s.Require().Len(invoiceModel.Lines(), 1)
+	line := invoiceModel.Lines()[0]
+	s.Equal(uint64(20), line.ID())
+	s.Equal(uint64(10), line.InvoiceID())
+	s.Equal("Premium Plan (Month)", line.Description())
+}
+
+func (s *InvoiceMapperTestSuite) TestToModel_InvalidStatus_ReturnsError() {
+	// Arrange
+	invoiceEntity := entity.InvoiceEntity{
+		ID:             10,
+		UserID:         1,
+		SubscriptionID: 2,
+		Status:         "Pending",
+		Currency:       "USD",
+		PeriodStart:    time.Now().UTC(),
+	}
+
+	// Act
+	_, err := s.sut.ToModel(invoiceEntity)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidInvoiceStatus)
+}
+
+func (s *InvoiceMapperTestSuite) TestToEntity_NewInvoice_MapsLinesAndTotal() {
+	// Arrange
+	now := time.Now().UTC()
+	line, err := model.CreateInvoiceLineModel("Premium Plan (Month)", 2, 1000)
+	s.Require().NoError(err)
+	invoiceModel, err := model.CreateInvoiceModel(1, 2, "EUR", now, nil, []model.InvoiceLineModel{line})
+	s.Require().NoError(err)
+
+	// Act
+	invoiceEntity := s.sut.ToEntity(invoiceModel)
+
+	// Assert
+	s.Equal(uint64(0), invoiceEntity.ID)
+	s.Equal("Open", invoiceEntity.Status)
+	s.Equal("EUR", invoiceEntity.Currency)
+	s.Equal(uint(2000), invoiceEntity.TotalCents)
+	s.Nil(invoiceEntity.PeriodEnd)
+	s.Nil(invoiceEntity.PaidAt)
+	s.Require().Len(invoiceEntity.Lines, 1)
+	s.Equal(uint(2), invoiceEntity.Lines[0].Quantity)
+	s.Equal(uint(1000), invoiceEntity.Lines[0].UnitAmountCents)
+	s.Equal(uint(2000), invoiceEntity.Lines[0].AmountCents)
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+)
+
+type PaymentMapper interface {
+	ToModel(entity entity.PaymentEntity) (model.PaymentModel, error)
+	ToEntity(model model.PaymentModel) entity.PaymentEntity
+}
+
+type paymentMapper struct {
+}
+
+func NewPaymentMapper() PaymentMapper {
+	return &paymentMapper{}
+}
+
+func (m *paymentMapper) ToModel(entity entity.PaymentEntity) (model.PaymentModel, error) {
+	paymentModel, err := model.RestorePaymentModel(
+		entity.ID,
+		entity.InvoiceID,
+		entity.Status,
+		entity.AmountCents,
+		entity.Currency,
+		entity.ProviderPaymentID,
+		entity.FailureReason,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.PaymentModel{}, err
+	}
+	return paymentModel, nil
+}
+
+func (m *paymentMapper) ToEntity(model model.PaymentModel) entity.PaymentEntity {
+	statusEnum := model.Status()
+	amountModel := model.Amount()
+	currencyModel := model.Currency()
+
+	return entity.PaymentEntity{
+		ID:                model.ID(),
+		InvoiceID:         model.InvoiceID(),
+		Status:            statusEnum.String(),
+		AmountCents:       amountModel.Cents(),
+		Currency:          currencyModel.Code(),
+		ProviderPaymentID: model.ProviderPaymentID(),
+		FailureReason:     model.FailureReason(),
+		CreatedAt:         model.CreatedAt(),
+		UpdatedAt:         model.UpdatedAt(),
+	}
+}
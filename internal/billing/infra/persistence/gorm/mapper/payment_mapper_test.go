@@ -0,0 +1,87 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+)
+
+type PaymentMapperTestSuite struct {
+	suite.Suite
+	sut mapper.PaymentMapper
+}
+
+func (s *PaymentMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewPaymentMapper()
+}
+
+func TestPaymentMapperSuite(t *testing.T) {
+	suite.Run(t, new(PaymentMapperTestSuite))
+}
+
+func (s *PaymentMapperTestSuite) TestToModel_ValidPaymentEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	paymentEntity := entity.PaymentEntity{
+		ID:                5,
+		InvoiceID:         10,
+		Status:            "Succeeded",
+		AmountCents:       1999,
+		Currency:          "USD",
+		ProviderPaymentID: "pay_123",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	// Act
+	paymentModel, err := s.sut.ToModel(paymentEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(5), paymentModel.ID())
+	s.Equal(uint64(10), paymentModel.InvoiceID())
+	status := paymentModel.Status()
+	s.Equal("Succeeded", status.String())
+	amount := paymentModel.Amount()
+	s.Equal(uint(1999), amount.Cents())
+	s.Equal("pay_123", paymentModel.ProviderPaymentID())
+}
+
+func (s *PaymentMapperTestSuite) TestToModel_InvalidStatus_ReturnsError() {
+	// Arrange
+	paymentEntity := entity.PaymentEntity{
+		ID:        5,
+		InvoiceID: 10,
+		Status:    "Pending",
+		Currency:  "USD",
+	}
+
+	// Act
+	_, err := s.sut.ToModel(paymentEntity)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidPaymentStatus)
+}
+
+func (s *PaymentMapperTestSuite) TestToEntity_FailedPayment_KeepsFailureReason() {
+	// Arrange
+	paymentModel, err := model.CreateFailedPaymentModel(10, 1999, "USD", "payment was declined")
+	s.Require().NoError(err)
+
+	// Act
+	paymentEntity := s.sut.ToEntity(paymentModel)
+
+	// Assert
+	s.Equal(uint64(10), paymentEntity.InvoiceID)
+	s.Equal("Failed", paymentEntity.Status)
+	s.Equal(uint(1999), paymentEntity.AmountCents)
+	s.Equal("USD", paymentEntity.Currency)
+	s.Empty(paymentEntity.ProviderPaymentID)
+	s.Equal("payment was declined", paymentEntity.FailureReason)
+}
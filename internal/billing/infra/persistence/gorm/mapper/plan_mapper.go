@@ -23,6 +23,16 @@ func (p *planMapper) ToModel(entity entity.PlanEntity) (model.PlanModel, error)
 		trialPeriod = &entity.TrialPeriod
 	}
 
+	entitlements, err := model.CreateEntitlementsModel(
+		entity.MaxConcurrentStreams,
+		entity.MaxResolution,
+		entity.MaxDownloads,
+		entity.MaxProfiles,
+	)
+	if err != nil {
+		return model.PlanModel{}, err
+	}
+
 	planModel, err := model.RestorePlanModel(
 		entity.ID,
 		entity.Name,
@@ -31,6 +41,7 @@ func (p *planMapper) ToModel(entity entity.PlanEntity) (model.PlanModel, error)
 		entity.Interval,
 		entity.AmountCents,
 		trialPeriod,
+		entitlements,
 		entity.CreatedAt,
 		entity.UpdatedAt,
 	)
@@ -55,16 +66,22 @@ func (p *planMapper) ToEntity(model model.PlanModel) entity.PlanEntity {
 	currencyModel := model.Currency()
 	amountModel := model.Amount()
 	intervalModel := model.Interval()
+	entitlements := model.Entitlements()
+	maxResolution := entitlements.MaxResolution()
 
 	return entity.PlanEntity{
-		ID:          model.ID(),
-		Name:        (&nameModel).String(),
-		Description: description,
-		AmountCents: (&amountModel).Cents(),
-		Currency:    currencyModel.Code(),
-		Interval:    intervalModel.String(),
-		TrialPeriod: trialPeriod,
-		CreatedAt:   model.CreatedAt(),
-		UpdatedAt:   model.UpdatedAt(),
+		ID:                   model.ID(),
+		Name:                 (&nameModel).String(),
+		Description:          description,
+		AmountCents:          (&amountModel).Cents(),
+		Currency:             currencyModel.Code(),
+		Interval:             intervalModel.String(),
+		TrialPeriod:          trialPeriod,
+		MaxConcurrentStreams: entitlements.MaxConcurrentStreams(),
+		MaxResolution:        maxResolution.String(),
+		MaxDownloads:         entitlements.MaxDownloads(),
+		MaxProfiles:          entitlements.MaxProfiles(),
+		CreatedAt:            model.CreatedAt(),
+		UpdatedAt:            model.UpdatedAt(),
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
 	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
@@ -28,15 +29,18 @@ func (s *PlanMapperTestSuite) TestToModel_ValidPlanEntityWithAllFields_ReturnsMo
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          123,
-		Name:        "Premium Plan",
-		Description: "Premium subscription plan",
-		AmountCents: 2999,
-		Currency:    "USD",
-		Interval:    "Month",
-		TrialPeriod: 7,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   123,
+		Name:                 "Premium Plan",
+		Description:          "Premium subscription plan",
+		AmountCents:          2999,
+		Currency:             "USD",
+		Interval:             "Month",
+		TrialPeriod:          7,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -64,6 +68,13 @@ func (s *PlanMapperTestSuite) TestToModel_ValidPlanEntityWithAllFields_ReturnsMo
 	s.NotNil(planModel.TrialPeriod())
 	s.Equal(uint(7), planModel.TrialPeriod().Days())
 
+	entitlements := planModel.Entitlements()
+	maxResolution := entitlements.MaxResolution()
+	s.Equal(uint(2), entitlements.MaxConcurrentStreams())
+	s.Equal("1080p", maxResolution.String())
+	s.Equal(uint(0), entitlements.MaxDownloads())
+	s.Equal(uint(3), entitlements.MaxProfiles())
+
 	s.Equal(now.Unix(), planModel.CreatedAt().Unix())
 	s.Equal(now.Unix(), planModel.UpdatedAt().Unix())
 }
@@ -72,15 +83,18 @@ func (s *PlanMapperTestSuite) TestToModel_ValidPlanEntityWithoutDescription_Retu
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          456,
-		Name:        "Basic Plan",
-		Description: "",
-		AmountCents: 999,
-		Currency:    "EUR",
-		Interval:    "Year",
-		TrialPeriod: 14,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   456,
+		Name:                 "Basic Plan",
+		Description:          "",
+		AmountCents:          999,
+		Currency:             "EUR",
+		Interval:             "Year",
+		TrialPeriod:          14,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -112,15 +126,18 @@ func (s *PlanMapperTestSuite) TestToModel_ValidPlanEntityWithoutTrialPeriod_Retu
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          789,
-		Name:        "Enterprise Plan",
-		Description: "Enterprise subscription plan",
-		AmountCents: 9999,
-		Currency:    "USD",
-		Interval:    "Month",
-		TrialPeriod: 0,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   789,
+		Name:                 "Enterprise Plan",
+		Description:          "Enterprise subscription plan",
+		AmountCents:          9999,
+		Currency:             "USD",
+		Interval:             "Month",
+		TrialPeriod:          0,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -146,15 +163,18 @@ func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithDifferentValidIntervals_
 
 	for _, interval := range validIntervals {
 		planEntity := entity.PlanEntity{
-			ID:          100,
-			Name:        "Test Plan",
-			Description: "Test description",
-			AmountCents: 1999,
-			Currency:    "USD",
-			Interval:    interval,
-			TrialPeriod: 7,
-			CreatedAt:   now,
-			UpdatedAt:   now,
+			ID:                   100,
+			Name:                 "Test Plan",
+			Description:          "Test description",
+			AmountCents:          1999,
+			Currency:             "USD",
+			Interval:             interval,
+			TrialPeriod:          7,
+			MaxConcurrentStreams: 2,
+			MaxResolution:        "1080p",
+			MaxProfiles:          3,
+			CreatedAt:            now,
+			UpdatedAt:            now,
 		}
 
 		// Act
@@ -171,15 +191,18 @@ func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithInvalidName_ReturnsError
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          123,
-		Name:        "A",
-		Description: "Valid description",
-		AmountCents: 999,
-		Currency:    "USD",
-		Interval:    "Month",
-		TrialPeriod: 7,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   123,
+		Name:                 "A",
+		Description:          "Valid description",
+		AmountCents:          999,
+		Currency:             "USD",
+		Interval:             "Month",
+		TrialPeriod:          7,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -194,15 +217,18 @@ func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithInvalidCurrency_ReturnsE
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          123,
-		Name:        "Valid Plan",
-		Description: "Valid description",
-		AmountCents: 999,
-		Currency:    "INVALID",
-		Interval:    "Month",
-		TrialPeriod: 7,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   123,
+		Name:                 "Valid Plan",
+		Description:          "Valid description",
+		AmountCents:          999,
+		Currency:             "INVALID",
+		Interval:             "Month",
+		TrialPeriod:          7,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -217,15 +243,18 @@ func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithInvalidInterval_ReturnsE
 	// Arrange
 	now := time.Now().UTC()
 	planEntity := entity.PlanEntity{
-		ID:          123,
-		Name:        "Valid Plan",
-		Description: "Valid description",
-		AmountCents: 999,
-		Currency:    "USD",
-		Interval:    "Invalid",
-		TrialPeriod: 7,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   123,
+		Name:                 "Valid Plan",
+		Description:          "Valid description",
+		AmountCents:          999,
+		Currency:             "USD",
+		Interval:             "Invalid",
+		TrialPeriod:          7,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -236,6 +265,29 @@ func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithInvalidInterval_ReturnsE
 	s.Contains(err.Error(), "invalid plan interval")
 }
 
+func (s *PlanMapperTestSuite) TestToModel_PlanEntityWithInvalidResolution_ReturnsError() {
+	// Arrange
+	now := time.Now().UTC()
+	planEntity := entity.PlanEntity{
+		ID:                   123,
+		Name:                 "Premium Plan",
+		AmountCents:          2999,
+		Currency:             "USD",
+		Interval:             "Month",
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "8K",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	// Act
+	_, err := s.sut.ToModel(planEntity)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrInvalidVideoResolution)
+}
+
 func (s *PlanMapperTestSuite) TestToEntity_ValidPlanModelWithAllFields_ReturnsEntity() {
 	// Arrange
 	now := time.Now().UTC()
@@ -248,6 +300,7 @@ func (s *PlanMapperTestSuite) TestToEntity_ValidPlanModelWithAllFields_ReturnsEn
 		"Month",
 		2999,
 		&trialPeriod,
+		s.newEntitlements(),
 		now,
 		now,
 	)
@@ -264,6 +317,10 @@ func (s *PlanMapperTestSuite) TestToEntity_ValidPlanModelWithAllFields_ReturnsEn
 	s.Equal("USD", planEntity.Currency)
 	s.Equal("Month", planEntity.Interval)
 	s.Equal(uint(7), planEntity.TrialPeriod)
+	s.Equal(uint(2), planEntity.MaxConcurrentStreams)
+	s.Equal("1080p", planEntity.MaxResolution)
+	s.Equal(uint(0), planEntity.MaxDownloads)
+	s.Equal(uint(3), planEntity.MaxProfiles)
 	s.Equal(now.Unix(), planEntity.CreatedAt.Unix())
 	s.Equal(now.Unix(), planEntity.UpdatedAt.Unix())
 }
@@ -280,6 +337,7 @@ func (s *PlanMapperTestSuite) TestToEntity_ValidPlanModelWithoutDescription_Retu
 		"Year",
 		999,
 		&trialPeriod,
+		s.newEntitlements(),
 		now,
 		now,
 	)
@@ -309,6 +367,7 @@ func (s *PlanMapperTestSuite) TestToEntity_ValidPlanModelWithoutTrialPeriod_Retu
 		"Month",
 		9999,
 		nil,
+		s.newEntitlements(),
 		now,
 		now,
 	)
@@ -341,6 +400,7 @@ func (s *PlanMapperTestSuite) TestToEntity_PlanModelWithDifferentValidIntervals_
 			interval,
 			1999,
 			nil,
+			s.newEntitlements(),
 			now,
 			now,
 		)
@@ -363,6 +423,7 @@ func (s *PlanMapperTestSuite) TestToEntity_PlanModelWithZeroID_ReturnsEntity() {
 		"Month",
 		1999,
 		nil,
+		s.newEntitlements(),
 	)
 	s.Require().NoError(err)
 
@@ -383,15 +444,18 @@ func (s *PlanMapperTestSuite) TestRoundTrip_EntityToModelToEntity_PreservesData(
 	// Arrange
 	now := time.Now().UTC()
 	originalEntity := entity.PlanEntity{
-		ID:          123,
-		Name:        "Round Trip Plan",
-		Description: "Round trip test description",
-		AmountCents: 2999,
-		Currency:    "USD",
-		Interval:    "Month",
-		TrialPeriod: 7,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:                   123,
+		Name:                 "Round Trip Plan",
+		Description:          "Round trip test description",
+		AmountCents:          2999,
+		Currency:             "USD",
+		Interval:             "Month",
+		TrialPeriod:          7,
+		MaxConcurrentStreams: 2,
+		MaxResolution:        "1080p",
+		MaxProfiles:          3,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}
 
 	// Act
@@ -424,6 +488,7 @@ func (s *PlanMapperTestSuite) TestRoundTrip_ModelToEntityToModel_PreservesData()
 		"Year",
 		9999,
 		&trialPeriod,
+		s.newEntitlements(),
 		now,
 		now,
 	)
@@ -464,3 +529,9 @@ func (s *PlanMapperTestSuite) TestRoundTrip_ModelToEntityToModel_PreservesData()
 	s.Equal(originalModel.CreatedAt().Unix(), resultModel.CreatedAt().Unix())
 	s.Equal(originalModel.UpdatedAt().Unix(), resultModel.UpdatedAt().Unix())
 }
+
+func (s *PlanMapperTestSuite) newEntitlements() model.EntitlementsModel {
+	entitlements, err := model.CreateEntitlementsModel(2, "1080p", 0, 3)
+	s.Require().NoError(err)
+	return entitlements
+}
@@ -32,9 +32,16 @@ func (s *subscriptionMapper) ToModel(entity entity.SubscriptionEntity) (model.Su
 		entity.Status,
 		entity.StartDate,
 		endDate,
+		entity.TrialEndDate,
 		entity.AutoRenew,
 		entity.CreatedAt,
 		entity.UpdatedAt,
+		entity.PaymentCustomerID,
+		entity.PaymentMethodID,
+		entity.RetryCount,
+		entity.NextRetryAt,
+		entity.CurrentPeriodStart,
+		entity.PausedAt,
 	)
 	if err != nil {
 		return model.SubscriptionModel{}, err
@@ -51,14 +58,24 @@ func (s *subscriptionMapper) ToEntity(model model.SubscriptionModel) entity.Subs
 	statusEnum := model.Status()
 
 	return entity.SubscriptionEntity{
-		ID:        model.ID(),
-		UserID:    model.UserID(),
-		PlanID:    model.PlanID(),
-		Status:    (&statusEnum).String(),
-		StartDate: model.StartDate(),
-		EndDate:   endDate,
-		AutoRenew: model.AutoRenew(),
-		CreatedAt: model.CreatedAt(),
-		UpdatedAt: model.UpdatedAt(),
+		ID:           model.ID(),
+		UserID:       model.UserID(),
+		PlanID:       model.PlanID(),
+		Status:       (&statusEnum).String(),
+		StartDate:    model.StartDate(),
+		EndDate:      endDate,
+		TrialEndDate: model.TrialEndDate(),
+		AutoRenew:    model.AutoRenew(),
+		CreatedAt:    model.CreatedAt(),
+		UpdatedAt:    model.UpdatedAt(),
+
+		PaymentCustomerID: model.PaymentCustomerID(),
+		PaymentMethodID:   model.PaymentMethodID(),
+
+		RetryCount:  model.RetryCount(),
+		NextRetryAt: model.NextRetryAt(),
+
+		CurrentPeriodStart: model.CurrentPeriodStart(),
+		PausedAt:           model.PausedAt(),
 	}
 }
@@ -222,9 +222,16 @@ func (s *SubscriptionMapperTestSuite) TestToEntity_ValidSubscriptionModelWithAll
 		"Active",
 		now,
 		&endDate,
+		nil,
 		true,
 		now,
 		now,
+		"",
+		"",
+		0,
+		nil,
+		now,
+		nil,
 	)
 	s.Require().NoError(err)
 
@@ -253,9 +260,16 @@ func (s *SubscriptionMapperTestSuite) TestToEntity_ValidSubscriptionModelWithout
 		"Cancelled",
 		now,
 		nil,
+		nil,
 		false,
 		now,
 		now,
+		"",
+		"",
+		0,
+		nil,
+		now,
+		nil,
 	)
 	s.Require().NoError(err)
 
@@ -285,9 +299,16 @@ func (s *SubscriptionMapperTestSuite) TestToEntity_SubscriptionModelWithDifferen
 			status,
 			now,
 			nil,
+			nil,
 			true,
 			now,
 			now,
+			"",
+			"",
+			0,
+			nil,
+			now,
+			nil,
 		)
 		s.Require().NoError(err)
 
@@ -317,7 +338,7 @@ func (s *SubscriptionMapperTestSuite) TestToEntity_SubscriptionModelWithZeroID_R
 	s.Equal(uint64(0), subscriptionEntity.ID)
 	s.Equal(uint64(456), subscriptionEntity.UserID)
 	s.Equal(uint64(789), subscriptionEntity.PlanID)
-	s.Equal("Active", subscriptionEntity.Status)
+	s.Equal("Inactive", subscriptionEntity.Status)
 	s.Equal(now.Unix(), subscriptionEntity.StartDate.Unix())
 	s.True(subscriptionEntity.EndDate.IsZero())
 	s.True(subscriptionEntity.AutoRenew)
@@ -368,9 +389,16 @@ func (s *SubscriptionMapperTestSuite) TestRoundTrip_ModelToEntityToModel_Preserv
 		"Expired",
 		now,
 		&endDate,
+		nil,
 		false,
 		now,
 		now,
+		"",
+		"",
+		0,
+		nil,
+		now,
+		nil,
 	)
 	s.Require().NoError(err)
 
@@ -398,3 +426,24 @@ func (s *SubscriptionMapperTestSuite) TestRoundTrip_ModelToEntityToModel_Preserv
 	s.Equal(originalModel.CreatedAt().Unix(), resultModel.CreatedAt().Unix())
 	s.Equal(originalModel.UpdatedAt().Unix(), resultModel.UpdatedAt().Unix())
 }
+
+func (s *SubscriptionMapperTestSuite) TestToEntity_TrialSubscription_PreservesTrialEndDate() {
+	// Arrange
+	now := time.Now().UTC()
+	trialEndDate := now.AddDate(0, 0, 14)
+	subscriptionModel, err := model.CreateTrialSubscriptionModel(1, 2, now, trialEndDate)
+	s.Require().NoError(err)
+
+	// Act
+	subscriptionEntity := s.sut.ToEntity(subscriptionModel)
+	restoredModel, err := s.sut.ToModel(subscriptionEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal("Trialing", subscriptionEntity.Status)
+	s.Require().NotNil(subscriptionEntity.TrialEndDate)
+	s.Equal(trialEndDate, *subscriptionEntity.TrialEndDate)
+	s.Require().NotNil(restoredModel.TrialEndDate())
+	s.Equal(trialEndDate, *restoredModel.TrialEndDate())
+	s.True(restoredModel.IsTrialing())
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type InvoiceRepository interface {
+	repository.InvoiceRepository
+}
+
+type invoiceRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.InvoiceMapper
+}
+
+func NewInvoiceRepository(db *database.GoflixDB, mapper mapper.InvoiceMapper) InvoiceRepository {
+	return &invoiceRepository{db, mapper}
+}
+
+func (r *invoiceRepository) Create(ctx context.Context, invoiceModel model.InvoiceModel) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.Create")
+	defer span.End()
+
+	// The lines are inserted in the same transaction through the association
+	invoiceEntity := r.mapper.ToEntity(invoiceModel)
+	result := r.db.FromContext(ctx).Create(&invoiceEntity)
+	if result.Error != nil {
+		return model.InvoiceModel{}, result.Error
+	}
+
+	invoiceModel, err := r.mapper.ToModel(invoiceEntity)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	return invoiceModel, nil
+}
+
+func (r *invoiceRepository) Update(ctx context.Context, invoiceModel model.InvoiceModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.Update")
+	defer span.End()
+
+	invoiceEntity := r.mapper.ToEntity(invoiceModel)
+	result := r.db.FromContext(ctx).Omit(clause.Associations).Save(&invoiceEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *invoiceRepository) FindByID(ctx context.Context, id uint64) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.FindByID")
+	defer span.End()
+
+	var invoiceEntity entity.InvoiceEntity
+	result := r.db.FromContext(ctx).Preload("Lines").First(&invoiceEntity, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.InvoiceModel{}, errs.ErrInvoiceNotFound
+		}
+		return model.InvoiceModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(invoiceEntity)
+}
+
+func (r *invoiceRepository) FindByUserID(ctx context.Context, userID uint64) ([]model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.FindByUserID")
+	defer span.End()
+
+	var invoiceEntities []entity.InvoiceEntity
+	result := r.db.FromContext(ctx).
+		Preload("Lines").
+		Where("user_id = ?", userID).
+		Order("period_start DESC, id DESC").
+		Find(&invoiceEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	invoiceModels := make([]model.InvoiceModel, 0, len(invoiceEntities))
+	for _, invoiceEntity := range invoiceEntities {
+		invoiceModel, err := r.mapper.ToModel(invoiceEntity)
+		if err != nil {
+			return nil, err
+		}
+		invoiceModels = append(invoiceModels, invoiceModel)
+	}
+
+	return invoiceModels, nil
+}
+
+func (r *invoiceRepository) FindBySubscriptionPeriod(
+	ctx context.Context,
+	subscriptionID uint64,
+	periodStart time.Time,
+) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.FindBySubscriptionPeriod")
+	defer span.End()
+
+	var invoiceEntity entity.InvoiceEntity
+	result := r.db.FromContext(ctx).
+		Preload("Lines").
+		Where("subscription_id = ? AND period_start = ?", subscriptionID, periodStart).
+		First(&invoiceEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.InvoiceModel{}, errs.ErrInvoiceNotFound
+		}
+		return model.InvoiceModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(invoiceEntity)
+}
+
+func (r *invoiceRepository) FindOpenBySubscriptionID(
+	ctx context.Context,
+	subscriptionID uint64,
+) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "InvoiceRepository.FindOpenBySubscriptionID")
+	defer span.End()
+
+	var invoiceEntity entity.InvoiceEntity
+	result := r.db.FromContext(ctx).
+		Preload("Lines").
+		Where("subscription_id = ? AND status = ?", subscriptionID, enum.EnumInvoiceStatusOpen).
+		Order("period_start DESC").
+		First(&invoiceEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.InvoiceModel{}, errs.ErrInvoiceNotFound
+		}
+		return model.InvoiceModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(invoiceEntity)
+}
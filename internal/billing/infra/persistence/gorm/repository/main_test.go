@@ -0,0 +1,14 @@
+package repository_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+func TestMain(m *testing.M) {
+	otel.Init(config.Config{})
+	os.Exit(m.Run())
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type PaymentRepository interface {
+	repository.PaymentRepository
+}
+
+type paymentRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.PaymentMapper
+}
+
+func NewPaymentRepository(db *database.GoflixDB, mapper mapper.PaymentMapper) PaymentRepository {
+	return &paymentRepository{db, mapper}
+}
+
+func (r *paymentRepository) Create(ctx context.Context, paymentModel model.PaymentModel) (model.PaymentModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "PaymentRepository.Create")
+	defer span.End()
+
+	paymentEntity := r.mapper.ToEntity(paymentModel)
+	result := r.db.FromContext(ctx).Create(&paymentEntity)
+	if result.Error != nil {
+		return model.PaymentModel{}, result.Error
+	}
+
+	paymentModel, err := r.mapper.ToModel(paymentEntity)
+	if err != nil {
+		return model.PaymentModel{}, err
+	}
+
+	return paymentModel, nil
+}
+
+func (r *paymentRepository) FindByInvoiceID(ctx context.Context, invoiceID uint64) ([]model.PaymentModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "PaymentRepository.FindByInvoiceID")
+	defer span.End()
+
+	var paymentEntities []entity.PaymentEntity
+	result := r.db.FromContext(ctx).Where("invoice_id = ?", invoiceID).Order("id").Find(&paymentEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	paymentModels := make([]model.PaymentModel, 0, len(paymentEntities))
+	for _, paymentEntity := range paymentEntities {
+		paymentModel, err := r.mapper.ToModel(paymentEntity)
+		if err != nil {
+			return nil, err
+		}
+		paymentModels = append(paymentModels, paymentModel)
+	}
+
+	return paymentModels, nil
+}
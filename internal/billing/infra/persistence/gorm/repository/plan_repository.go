@@ -30,7 +30,7 @@ func (r *planRepository) Create(ctx context.Context, planModel model.PlanModel)
 	defer span.End()
 
 	planEntity := r.mapper.ToEntity(planModel)
-	result := r.db.WithContext(ctx).Create(&planEntity)
+	result := r.db.FromContext(ctx).Create(&planEntity)
 	if result.Error != nil {
 		return model.PlanModel{}, result.Error
 	}
@@ -48,7 +48,7 @@ func (r *planRepository) Update(ctx context.Context, planModel model.PlanModel)
 	defer span.End()
 
 	planEntity := r.mapper.ToEntity(planModel)
-	result := r.db.WithContext(ctx).Save(&planEntity)
+	result := r.db.FromContext(ctx).Save(&planEntity)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -59,11 +59,15 @@ func (r *planRepository) Delete(ctx context.Context, id uint64) error {
 	ctx, span := otel.Trace().StartSpan(ctx, "PlanRepository.Delete")
 	defer span.End()
 
-	result := r.db.WithContext(ctx).Delete(&entity.PlanEntity{}, id)
+	result := r.db.FromContext(ctx).Delete(&entity.PlanEntity{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
 
+	if result.RowsAffected == 0 {
+		return errs.ErrPlanNotFound
+	}
+
 	return nil
 }
 
@@ -72,7 +76,7 @@ func (r *planRepository) FindByID(ctx context.Context, id uint64) (model.PlanMod
 	defer span.End()
 
 	var planEntity entity.PlanEntity
-	r.db.WithContext(ctx).First(&planEntity, id)
+	r.db.FromContext(ctx).First(&planEntity, id)
 	if planEntity.ID == 0 {
 		return model.PlanModel{}, errs.ErrPlanNotFound
 	}
@@ -90,7 +94,7 @@ func (r *planRepository) FindAll(ctx context.Context) ([]model.PlanModel, error)
 	defer span.End()
 
 	var planEntities []entity.PlanEntity
-	result := r.db.WithContext(ctx).Find(&planEntities)
+	result := r.db.FromContext(ctx).Order("id").Find(&planEntities)
 	if result.Error != nil {
 		return nil, result.Error
 	}
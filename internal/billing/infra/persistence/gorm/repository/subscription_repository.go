@@ -2,27 +2,54 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
 	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
 	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	pkg_database "github.com/cristiano-pacheco/goflix/pkg/database"
+)
+
+const (
+	subscriptionUserActiveIndex = "idx_subscription_user_active"
+	subscriptionUserTrialIndex  = "idx_subscription_user_trial"
 )
 
 type SubscriptionRepository interface {
 	repository.SubscriptionRepository
 }
 
+// subscriptionRepository invalidates the cached subscription access of the user on every
+// write, once the write is committed, so access checks never outlive a change of status.
 type subscriptionRepository struct {
-	db     *database.GoflixDB
-	mapper mapper.SubscriptionMapper
+	db              *database.GoflixDB
+	mapper          mapper.SubscriptionMapper
+	accessCache     service.SubscriptionAccessCache
+	errorTranslator pkg_database.ErrorTranslator
 }
 
-func NewSubscriptionRepository(db *database.GoflixDB, mapper mapper.SubscriptionMapper) SubscriptionRepository {
-	return &subscriptionRepository{db, mapper}
+func NewSubscriptionRepository(
+	db *database.GoflixDB,
+	mapper mapper.SubscriptionMapper,
+	accessCache service.SubscriptionAccessCache,
+) SubscriptionRepository {
+	// The unique indexes are the last line of defense against concurrent subscriptions of a
+	// user: one subscription at most is active, trialing or paused, and a user gets a single
+	// trial ever, whatever became of it.
+	errorTranslator := pkg_database.NewErrorTranslator(map[string]error{
+		subscriptionUserActiveIndex: errs.ErrUserAlreadyHasActiveSubscription,
+		subscriptionUserTrialIndex:  errs.ErrTrialAlreadyUsed,
+	})
+	return &subscriptionRepository{db, mapper, accessCache, errorTranslator}
 }
 
 func (r *subscriptionRepository) Create(
@@ -33,12 +60,17 @@ func (r *subscriptionRepository) Create(
 	defer span.End()
 
 	subscriptionEntity := r.mapper.ToEntity(subscriptionModel)
-	result := r.db.WithContext(ctx).Create(&subscriptionEntity)
+	result := r.db.FromContext(ctx).Create(&subscriptionEntity)
 	if result.Error != nil {
-		return model.SubscriptionModel{}, result.Error
+		return model.SubscriptionModel{}, r.errorTranslator.Translate(result.Error)
 	}
 
-	subscriptionModel, err := r.mapper.ToModel(subscriptionEntity)
+	err := r.invalidateAccess(ctx, subscriptionEntity.UserID)
+	if err != nil {
+		return model.SubscriptionModel{}, err
+	}
+
+	subscriptionModel, err = r.mapper.ToModel(subscriptionEntity)
 	if err != nil {
 		return model.SubscriptionModel{}, err
 	}
@@ -51,23 +83,33 @@ func (r *subscriptionRepository) Update(ctx context.Context, subscriptionModel m
 	defer span.End()
 
 	subscriptionEntity := r.mapper.ToEntity(subscriptionModel)
-	result := r.db.WithContext(ctx).Save(&subscriptionEntity)
+	result := r.db.FromContext(ctx).Save(&subscriptionEntity)
 	if result.Error != nil {
-		return result.Error
+		return r.errorTranslator.Translate(result.Error)
 	}
-	return nil
+
+	return r.invalidateAccess(ctx, subscriptionEntity.UserID)
 }
 
 func (r *subscriptionRepository) Delete(ctx context.Context, id uint64) error {
 	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionRepository.Delete")
 	defer span.End()
 
-	result := r.db.WithContext(ctx).Delete(&entity.SubscriptionEntity{}, id)
+	var subscriptionEntity entity.SubscriptionEntity
+	result := r.db.FromContext(ctx).First(&subscriptionEntity, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return errs.ErrSubscriptionNotFound
+		}
+		return result.Error
+	}
+
+	result = r.db.FromContext(ctx).Delete(&entity.SubscriptionEntity{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
 
-	return nil
+	return r.invalidateAccess(ctx, subscriptionEntity.UserID)
 }
 
 func (r *subscriptionRepository) FindByID(ctx context.Context, id uint64) (model.SubscriptionModel, error) {
@@ -75,7 +117,7 @@ func (r *subscriptionRepository) FindByID(ctx context.Context, id uint64) (model
 	defer span.End()
 
 	var subscriptionEntity entity.SubscriptionEntity
-	r.db.WithContext(ctx).First(&subscriptionEntity, id)
+	r.db.FromContext(ctx).First(&subscriptionEntity, id)
 	if subscriptionEntity.ID == 0 {
 		return model.SubscriptionModel{}, errs.ErrSubscriptionNotFound
 	}
@@ -93,7 +135,7 @@ func (r *subscriptionRepository) FindByUserID(ctx context.Context, userID uint64
 	defer span.End()
 
 	var subscriptionEntities []entity.SubscriptionEntity
-	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&subscriptionEntities)
+	result := r.db.FromContext(ctx).Where("user_id = ?", userID).Find(&subscriptionEntities)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -110,6 +152,19 @@ func (r *subscriptionRepository) FindByUserID(ctx context.Context, userID uint64
 	return subscriptionModels, nil
 }
 
+func (r *subscriptionRepository) ExistsByPlanID(ctx context.Context, planID uint64) (bool, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionRepository.ExistsByPlanID")
+	defer span.End()
+
+	var count int64
+	result := r.db.FromContext(ctx).Model(&entity.SubscriptionEntity{}).Where("plan_id = ?", planID).Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
 func (r *subscriptionRepository) FindActiveSubscriptionByUserID(
 	ctx context.Context,
 	userID uint64,
@@ -118,15 +173,18 @@ func (r *subscriptionRepository) FindActiveSubscriptionByUserID(
 	defer span.End()
 
 	var subscriptionEntity entity.SubscriptionEntity
-	result := r.db.WithContext(ctx).Where("user_id = ? AND status = ?", userID, "Active").First(&subscriptionEntity)
+	result := r.db.FromContext(ctx).Where(
+		"user_id = ? AND status IN ?",
+		userID,
+		[]string{enum.EnumSubscriptionStatusActive, enum.EnumSubscriptionStatusTrialing},
+	).First(&subscriptionEntity)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.SubscriptionModel{}, errs.ErrSubscriptionNotFound
+		}
 		return model.SubscriptionModel{}, result.Error
 	}
 
-	if subscriptionEntity.ID == 0 {
-		return model.SubscriptionModel{}, errs.ErrSubscriptionNotFound
-	}
-
 	subscriptionModel, err := r.mapper.ToModel(subscriptionEntity)
 	if err != nil {
 		return model.SubscriptionModel{}, err
@@ -134,3 +192,70 @@ func (r *subscriptionRepository) FindActiveSubscriptionByUserID(
 
 	return subscriptionModel, nil
 }
+
+func (r *subscriptionRepository) FindDueForRenewal(
+	ctx context.Context,
+	date time.Time,
+) ([]model.SubscriptionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionRepository.FindDueForRenewal")
+	defer span.End()
+
+	// Subscriptions without an end date are stored with the zero time, not NULL
+	var subscriptionEntities []entity.SubscriptionEntity
+	result := r.db.FromContext(ctx).
+		Where(
+			"status IN ? AND end_date > ? AND end_date <= ?",
+			[]string{enum.EnumSubscriptionStatusActive, enum.EnumSubscriptionStatusTrialing},
+			time.Time{},
+			date,
+		).
+		Order("id").
+		Find(&subscriptionEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toModels(subscriptionEntities)
+}
+
+func (r *subscriptionRepository) FindDueForRetry(
+	ctx context.Context,
+	date time.Time,
+) ([]model.SubscriptionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionRepository.FindDueForRetry")
+	defer span.End()
+
+	var subscriptionEntities []entity.SubscriptionEntity
+	result := r.db.FromContext(ctx).
+		Where("status = ? AND next_retry_at <= ?", enum.EnumSubscriptionStatusPastDue, date).
+		Order("id").
+		Find(&subscriptionEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toModels(subscriptionEntities)
+}
+
+func (r *subscriptionRepository) toModels(
+	subscriptionEntities []entity.SubscriptionEntity,
+) ([]model.SubscriptionModel, error) {
+	subscriptionModels := make([]model.SubscriptionModel, 0, len(subscriptionEntities))
+	for _, subscriptionEntity := range subscriptionEntities {
+		subscriptionModel, err := r.mapper.ToModel(subscriptionEntity)
+		if err != nil {
+			return nil, err
+		}
+		subscriptionModels = append(subscriptionModels, subscriptionModel)
+	}
+
+	return subscriptionModels, nil
+}
+
+// invalidateAccess waits for the transaction of ctx to commit: a concurrent access check would
+// otherwise cache the status it still reads for the whole cache TTL.
+func (r *subscriptionRepository) invalidateAccess(ctx context.Context, userID uint64) error {
+	return database.AfterCommit(ctx, func(ctx context.Context) error {
+		return r.accessCache.Invalidate(ctx, userID)
+	})
+}
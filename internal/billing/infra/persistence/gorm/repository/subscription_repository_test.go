@@ -0,0 +1,143 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/billing/domain/service/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/test/dbtest"
+)
+
+const (
+	testUserID              = uint64(1)
+	updateSubscriptionQuery = `UPDATE "subscription" SET .+ WHERE "id" = .+`
+)
+
+func TestSubscriptionRepository_Create(t *testing.T) {
+	t.Run("second active subscription of the user returns error", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionRepositorySUT(t)
+		sut.expectInsertViolating("idx_subscription_user_active")
+
+		// Act
+		_, err := sut.subscriptionRepository.Create(context.Background(), newSubscription(t))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrUserAlreadyHasActiveSubscription)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("second trial of the user returns error", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionRepositorySUT(t)
+		sut.expectInsertViolating("idx_subscription_user_trial")
+
+		// Act
+		_, err := sut.subscriptionRepository.Create(context.Background(), newSubscription(t))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTrialAlreadyUsed)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestSubscriptionRepository_Update(t *testing.T) {
+	t.Run("cached access is invalidated once the transaction commits", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionRepositorySUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateSubscriptionQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectCommit()
+		invalidated := false
+		sut.accessCache.EXPECT().Invalidate(mock.Anything, testUserID).
+			RunAndReturn(func(context.Context, uint64) error {
+				invalidated = true
+				return nil
+			}).Once()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			err := sut.subscriptionRepository.Update(ctx, newSubscription(t))
+			require.False(t, invalidated)
+			return err
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, invalidated)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("cached access is kept when the transaction rolls back", func(t *testing.T) {
+		// Arrange
+		errRollback := errors.New("payment failed")
+		sut := newSubscriptionRepositorySUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateSubscriptionQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectRollback()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			err := sut.subscriptionRepository.Update(ctx, newSubscription(t))
+			require.NoError(t, err)
+			return errRollback
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errRollback)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+}
+
+type subscriptionRepositorySUT struct {
+	subscriptionRepository repository.SubscriptionRepository
+	txManager              database.TxManager
+	accessCache            *service_mocks.MockSubscriptionAccessCache
+	sqlMock                sqlmock.Sqlmock
+}
+
+func newSubscriptionRepositorySUT(t *testing.T) *subscriptionRepositorySUT {
+	t.Helper()
+
+	sqlDB, db, sqlMock := dbtest.NewDBMock(t)
+	t.Cleanup(func() { dbtest.CloseWithErrorCheck(sqlDB) })
+
+	accessCache := service_mocks.NewMockSubscriptionAccessCache(t)
+	return &subscriptionRepositorySUT{
+		subscriptionRepository: repository.NewSubscriptionRepository(db, mapper.NewSubscriptionMapper(), accessCache),
+		txManager:              database.NewTxManager(db),
+		accessCache:            accessCache,
+		sqlMock:                sqlMock,
+	}
+}
+
+func (s *subscriptionRepositorySUT) expectInsertViolating(constraintName string) {
+	s.sqlMock.ExpectBegin()
+	s.sqlMock.ExpectQuery(`INSERT INTO "subscription"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: constraintName})
+	s.sqlMock.ExpectRollback()
+}
+
+func newSubscription(t *testing.T) model.SubscriptionModel {
+	t.Helper()
+
+	now := time.Now().UTC()
+	subscription, err := model.RestoreSubscriptionModel(
+		1, testUserID, 1, enum.EnumSubscriptionStatusActive, now, nil, nil, true, now, now, "", "", 0, nil, now, nil,
+	)
+	require.NoError(t, err)
+	return subscription
+}
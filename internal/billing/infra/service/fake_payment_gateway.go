@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+// FakeDeclinedPaymentMethodToken is a payment method token the fake gateway accepts
+// but declines on every authorization, to exercise the failure paths.
+const FakeDeclinedPaymentMethodToken = "pm_card_declined"
+
+const (
+	fakePaymentMethodPrefix         = "pm"
+	fakeDeclinedPaymentMethodPrefix = "pm_declined"
+)
+
+type FakePaymentGateway interface {
+	service.PaymentGateway
+}
+
+type fakePaymentMethod struct {
+	customerID string
+	declined   bool
+}
+
+type fakeAuthorization struct {
+	amountCents uint
+	captured    bool
+}
+
+type fakePayment struct {
+	amountCents   uint
+	refundedCents uint
+}
+
+// fakePaymentGateway keeps everything in memory and never talks to a provider. It is
+// meant for local development and tests. Payment method ids carry whether they decline,
+// so ids created by another process (e.g. the API before a billing:renew run) still work.
+type fakePaymentGateway struct {
+	mu             sync.Mutex
+	customers      map[string]uint64
+	paymentMethods map[string]fakePaymentMethod
+	authorizations map[string]fakeAuthorization
+	payments       map[string]fakePayment
+	// idempotencyKeys maps the idempotency key of an authorization to its id
+	idempotencyKeys map[string]string
+}
+
+func NewFakePaymentGateway() FakePaymentGateway {
+	return &fakePaymentGateway{
+		customers:       make(map[string]uint64),
+		paymentMethods:  make(map[string]fakePaymentMethod),
+		authorizations:  make(map[string]fakeAuthorization),
+		payments:        make(map[string]fakePayment),
+		idempotencyKeys: make(map[string]string),
+	}
+}
+
+func (g *fakePaymentGateway) CreateCustomer(ctx context.Context, userID uint64) (string, error) {
+	_, span := otel.Trace().StartSpan(ctx, "FakePaymentGateway.CreateCustomer")
+	defer span.End()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	customerID := newFakeID("cus")
+	g.customers[customerID] = userID
+	return customerID, nil
+}
+
+func (g *fakePaymentGateway) AttachPaymentMethod(
+	ctx context.Context,
+	customerID string,
+	paymentMethodToken string,
+) (string, error) {
+	_, span := otel.Trace().StartSpan(ctx, "FakePaymentGateway.AttachPaymentMethod")
+	defer span.End()
+
+	if paymentMethodToken == "" {
+		return "", errs.ErrPaymentMethodRequired
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.customers[customerID]; !ok {
+		return "", errs.ErrPaymentCustomerNotFound
+	}
+
+	declined := paymentMethodToken == FakeDeclinedPaymentMethodToken
+	prefix := fakePaymentMethodPrefix
+	if declined {
+		prefix = fakeDeclinedPaymentMethodPrefix
+	}
+
+	paymentMethodID := newFakeID(prefix)
+	g.paymentMethods[paymentMethodID] = fakePaymentMethod{
+		customerID: customerID,
+		declined:   declined,
+	}
+	return paymentMethodID, nil
+}
+
+func (g *fakePaymentGateway) Authorize(ctx context.Context, input service.AuthorizeInput) (string, error) {
+	_, span := otel.Trace().StartSpan(ctx, "FakePaymentGateway.Authorize")
+	defer span.End()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	paymentMethod, ok := g.paymentMethods[input.PaymentMethodID]
+	if !ok {
+		paymentMethod, ok = restoreFakePaymentMethod(input.PaymentMethodID, input.CustomerID)
+	}
+	if !ok || paymentMethod.customerID != input.CustomerID {
+		return "", errs.ErrPaymentMethodNotFound
+	}
+
+	if paymentMethod.declined {
+		return "", errs.ErrPaymentDeclined
+	}
+
+	if authorizationID, found := g.idempotencyKeys[input.IdempotencyKey]; found {
+		return authorizationID, nil
+	}
+
+	authorizationID := newFakeID("auth")
+	g.authorizations[authorizationID] = fakeAuthorization{amountCents: input.AmountCents}
+	if input.IdempotencyKey != "" {
+		g.idempotencyKeys[input.IdempotencyKey] = authorizationID
+	}
+	return authorizationID, nil
+}
+
+func (g *fakePaymentGateway) Capture(ctx context.Context, authorizationID string) (string, error) {
+	_, span := otel.Trace().StartSpan(ctx, "FakePaymentGateway.Capture")
+	defer span.End()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	authorization, ok := g.authorizations[authorizationID]
+	if !ok {
+		return "", errs.ErrAuthorizationNotFound
+	}
+
+	if authorization.captured {
+		return "", errs.ErrAuthorizationAlreadyUsed
+	}
+
+	authorization.captured = true
+	g.authorizations[authorizationID] = authorization
+
+	paymentID := newFakeID("pay")
+	g.payments[paymentID] = fakePayment{amountCents: authorization.amountCents}
+	return paymentID, nil
+}
+
+func (g *fakePaymentGateway) Refund(ctx context.Context, paymentID string, amountCents uint) (string, error) {
+	_, span := otel.Trace().StartSpan(ctx, "FakePaymentGateway.Refund")
+	defer span.End()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[paymentID]
+	if !ok {
+		return "", errs.ErrPaymentNotFound
+	}
+
+	if payment.refundedCents+amountCents > payment.amountCents {
+		return "", errs.ErrRefundExceedsPaymentAmount
+	}
+
+	payment.refundedCents += amountCents
+	g.payments[paymentID] = payment
+	return newFakeID("re"), nil
+}
+
+// restoreFakePaymentMethod rebuilds a payment method issued by another fake gateway instance
+// from its id alone.
+func restoreFakePaymentMethod(paymentMethodID, customerID string) (fakePaymentMethod, bool) {
+	if !strings.HasPrefix(customerID, "cus_fake_") {
+		return fakePaymentMethod{}, false
+	}
+
+	if strings.HasPrefix(paymentMethodID, fakeDeclinedPaymentMethodPrefix+"_fake_") {
+		return fakePaymentMethod{customerID: customerID, declined: true}, true
+	}
+
+	if strings.HasPrefix(paymentMethodID, fakePaymentMethodPrefix+"_fake_") {
+		return fakePaymentMethod{customerID: customerID}, true
+	}
+
+	return fakePaymentMethod{}, false
+}
+
+func newFakeID(prefix string) string {
+	return fmt.Sprintf("%s_fake_%s", prefix, uuid.NewString())
+}
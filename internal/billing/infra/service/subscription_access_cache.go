@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	redis_client "github.com/redis/go-redis/v9"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const subscriptionAccessKeyPrefix = "billing:subscription_active:"
+
+type SubscriptionAccessCache interface {
+	service.SubscriptionAccessCache
+}
+
+type subscriptionAccessCache struct {
+	redis redis.Redis
+	conf  config.Config
+}
+
+func NewSubscriptionAccessCache(redis redis.Redis, conf config.Config) SubscriptionAccessCache {
+	return &subscriptionAccessCache{redis, conf}
+}
+
+func (c *subscriptionAccessCache) Get(ctx context.Context, userID uint64) (bool, bool, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionAccessCache.Get")
+	defer span.End()
+
+	active, err := c.redis.Client().Get(ctx, c.key(userID)).Bool()
+	if err != nil {
+		if errors.Is(err, redis_client.Nil) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+
+	return active, true, nil
+}
+
+// Set stores the answer for a limited time, the TTL bounds how stale it gets if an invalidation is lost.
+func (c *subscriptionAccessCache) Set(ctx context.Context, userID uint64, active bool) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionAccessCache.Set")
+	defer span.End()
+
+	ttl := time.Duration(c.conf.Billing.SubscriptionCacheTTLInSeconds) * time.Second
+	if ttl <= 0 {
+		return nil
+	}
+
+	return c.redis.Client().Set(ctx, c.key(userID), active, ttl).Err()
+}
+
+func (c *subscriptionAccessCache) Invalidate(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionAccessCache.Invalidate")
+	defer span.End()
+
+	return c.redis.Client().Del(ctx, c.key(userID)).Err()
+}
+
+func (c *subscriptionAccessCache) key(userID uint64) string {
+	return subscriptionAccessKeyPrefix + strconv.FormatUint(userID, 10)
+}
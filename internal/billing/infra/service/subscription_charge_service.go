@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type SubscriptionChargeService interface {
+	service.SubscriptionChargeService
+}
+
+type subscriptionChargeService struct {
+	invoiceRepository repository.InvoiceRepository
+	paymentRepository repository.PaymentRepository
+	paymentGateway    service.PaymentGateway
+	logger            logger.Logger
+}
+
+func NewSubscriptionChargeService(
+	invoiceRepository repository.InvoiceRepository,
+	paymentRepository repository.PaymentRepository,
+	paymentGateway service.PaymentGateway,
+	logger logger.Logger,
+) SubscriptionChargeService {
+	return &subscriptionChargeService{
+		invoiceRepository,
+		paymentRepository,
+		paymentGateway,
+		logger,
+	}
+}
+
+func (s *subscriptionChargeService) Charge(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	plan model.PlanModel,
+	periodStart time.Time,
+	periodEnd *time.Time,
+) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionChargeService.Charge")
+	defer span.End()
+
+	invoice, err := s.findOrCreateInvoice(ctx, subscription, plan, periodStart, periodEnd)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	return s.pay(ctx, subscription, invoice)
+}
+
+func (s *subscriptionChargeService) ChargeProration(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	plan model.PlanModel,
+	periodStart time.Time,
+	proration service.Proration,
+) (model.InvoiceModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionChargeService.ChargeProration")
+	defer span.End()
+
+	nameModel := plan.Name()
+	currencyModel := plan.Currency()
+
+	description := fmt.Sprintf(
+		"%s (prorated, %d of %d days)",
+		nameModel.String(),
+		proration.RemainingDays,
+		proration.PeriodDays,
+	)
+	line, err := model.CreateInvoiceLineModel(description, 1, proration.ChargeCents)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	invoice, err := model.CreateInvoiceModel(
+		subscription.UserID(),
+		subscription.ID(),
+		currencyModel.Code(),
+		periodStart,
+		subscription.EndDate(),
+		[]model.InvoiceLineModel{line},
+	)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	invoice, err = s.invoiceRepository.Create(ctx, invoice)
+	if err != nil {
+		message := "error creating proration invoice"
+		s.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return model.InvoiceModel{}, err
+	}
+
+	paidInvoice, errCharge := s.pay(ctx, subscription, invoice)
+	if errCharge != nil {
+		if err = invoice.Void(); err == nil {
+			err = s.invoiceRepository.Update(ctx, invoice)
+		}
+		if err != nil {
+			message := "error voiding proration invoice"
+			s.logger.Error(message, "error", err, "invoiceID", invoice.ID())
+		}
+		return model.InvoiceModel{}, errCharge
+	}
+
+	return paidInvoice, nil
+}
+
+func (s *subscriptionChargeService) RefundProration(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	amountCents uint,
+) (uint, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SubscriptionChargeService.RefundProration")
+	defer span.End()
+
+	invoice, err := s.invoiceRepository.FindBySubscriptionPeriod(ctx, subscription.ID(), subscription.CurrentPeriodStart())
+	if err != nil {
+		message := "error finding current period invoice"
+		s.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return 0, err
+	}
+
+	payments, err := s.paymentRepository.FindByInvoiceID(ctx, invoice.ID())
+	if err != nil {
+		message := "error finding current period payments"
+		s.logger.Error(message, "error", err, "invoiceID", invoice.ID())
+		return 0, err
+	}
+
+	var captured model.PaymentModel
+	var refundedCents uint
+	for _, payment := range payments {
+		status := payment.Status()
+		amount := payment.Amount()
+		switch status.String() {
+		case enum.EnumPaymentStatusSucceeded:
+			captured = payment
+		case enum.EnumPaymentStatusRefunded:
+			refundedCents += amount.Cents()
+		}
+	}
+
+	if captured.ID() == 0 {
+		return 0, errs.ErrPaymentNotFound
+	}
+
+	// Earlier plan changes may already have refunded part of the payment
+	capturedAmount := captured.Amount()
+	if refundedCents >= capturedAmount.Cents() {
+		return 0, nil
+	}
+	amountCents = min(amountCents, capturedAmount.Cents()-refundedCents)
+
+	providerRefundID, err := s.paymentGateway.Refund(ctx, captured.ProviderPaymentID(), amountCents)
+	if err != nil {
+		message := "error refunding payment"
+		s.logger.Error(message, "error", err, "paymentID", captured.ID())
+		return 0, err
+	}
+
+	currency := captured.Currency()
+	refund, err := model.CreateRefundedPaymentModel(invoice.ID(), amountCents, currency.Code(), providerRefundID)
+	if err != nil {
+		return 0, err
+	}
+
+	// The money has been refunded at this point, so a failure here needs manual reconciliation
+	_, err = s.paymentRepository.Create(ctx, refund)
+	if err != nil {
+		message := "error recording refund"
+		s.logger.Error(message, "error", err, "invoiceID", invoice.ID(), "providerRefundID", providerRefundID)
+		return 0, err
+	}
+
+	return amountCents, nil
+}
+
+// pay charges an open invoice through the payment gateway and records the payment.
+func (s *subscriptionChargeService) pay(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	invoice model.InvoiceModel,
+) (model.InvoiceModel, error) {
+	if invoice.IsPaid() {
+		return invoice, nil
+	}
+
+	// Free plans are not sent to the gateway
+	total := invoice.Total()
+	if total.Cents() == 0 {
+		return s.markPaid(ctx, invoice)
+	}
+
+	if !subscription.HasPaymentMethod() {
+		return model.InvoiceModel{}, errs.ErrPaymentMethodRequired
+	}
+
+	providerPaymentID, errCharge := s.authorizeAndCapture(ctx, subscription, invoice)
+	if errCharge != nil {
+		s.recordFailedPayment(ctx, invoice, errCharge)
+		return model.InvoiceModel{}, errCharge
+	}
+
+	currency := invoice.Currency()
+	payment, err := model.CreateSucceededPaymentModel(invoice.ID(), total.Cents(), currency.Code(), providerPaymentID)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	// The money has been captured at this point, so a failure here needs manual reconciliation
+	_, err = s.paymentRepository.Create(ctx, payment)
+	if err != nil {
+		message := "error recording captured payment"
+		s.logger.Error(message, "error", err, "invoiceID", invoice.ID(), "providerPaymentID", providerPaymentID)
+		return model.InvoiceModel{}, err
+	}
+
+	return s.markPaid(ctx, invoice)
+}
+
+func (s *subscriptionChargeService) findOrCreateInvoice(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	plan model.PlanModel,
+	periodStart time.Time,
+	periodEnd *time.Time,
+) (model.InvoiceModel, error) {
+	invoice, err := s.invoiceRepository.FindBySubscriptionPeriod(ctx, subscription.ID(), periodStart)
+	if err == nil {
+		return invoice, nil
+	}
+
+	if !errors.Is(err, errs.ErrInvoiceNotFound) {
+		message := "error finding subscription period invoice"
+		s.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return model.InvoiceModel{}, err
+	}
+
+	nameModel := plan.Name()
+	intervalEnum := plan.Interval()
+	amountModel := plan.Amount()
+	currencyModel := plan.Currency()
+
+	description := fmt.Sprintf("%s (%s)", nameModel.String(), intervalEnum.String())
+	line, err := model.CreateInvoiceLineModel(description, 1, amountModel.Cents())
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	invoice, err = model.CreateInvoiceModel(
+		subscription.UserID(),
+		subscription.ID(),
+		currencyModel.Code(),
+		periodStart,
+		periodEnd,
+		[]model.InvoiceLineModel{line},
+	)
+	if err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	invoice, err = s.invoiceRepository.Create(ctx, invoice)
+	if err != nil {
+		message := "error creating invoice"
+		s.logger.Error(message, "error", err, "subscriptionID", subscription.ID())
+		return model.InvoiceModel{}, err
+	}
+
+	return invoice, nil
+}
+
+func (s *subscriptionChargeService) authorizeAndCapture(
+	ctx context.Context,
+	subscription model.SubscriptionModel,
+	invoice model.InvoiceModel,
+) (string, error) {
+	total := invoice.Total()
+	currency := invoice.Currency()
+
+	// Each recorded attempt, failed ones included, gets its own key, so a declined charge can be
+	// retried while an attempt sent again is not charged twice. The key is built from the period
+	// rather than the invoice id, which changes when the transaction creating the invoice rolls back
+	payments, err := s.paymentRepository.FindByInvoiceID(ctx, invoice.ID())
+	if err != nil {
+		return "", err
+	}
+	idempotencyKey := fmt.Sprintf(
+		"subscription:%d:period:%d:attempt:%d",
+		subscription.ID(),
+		invoice.PeriodStart().Unix(),
+		len(payments)+1,
+	)
+
+	authorizationID, err := s.paymentGateway.Authorize(ctx, service.AuthorizeInput{
+		CustomerID:      subscription.PaymentCustomerID(),
+		PaymentMethodID: subscription.PaymentMethodID(),
+		AmountCents:     total.Cents(),
+		CurrencyCode:    currency.Code(),
+		Reference:       fmt.Sprintf("invoice:%d", invoice.ID()),
+		IdempotencyKey:  idempotencyKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.paymentGateway.Capture(ctx, authorizationID)
+}
+
+// recordFailedPayment keeps the failed attempt in the ledger. It only logs on error so
+// the charge error, which matters more to the caller, is the one returned.
+func (s *subscriptionChargeService) recordFailedPayment(ctx context.Context, invoice model.InvoiceModel, errCharge error) {
+	total := invoice.Total()
+	currency := invoice.Currency()
+
+	payment, err := model.CreateFailedPaymentModel(invoice.ID(), total.Cents(), currency.Code(), errCharge.Error())
+	if err == nil {
+		_, err = s.paymentRepository.Create(ctx, payment)
+	}
+
+	if err != nil {
+		message := "error recording failed payment"
+		s.logger.Error(message, "error", err, "invoiceID", invoice.ID())
+	}
+}
+
+func (s *subscriptionChargeService) markPaid(ctx context.Context, invoice model.InvoiceModel) (model.InvoiceModel, error) {
+	if err := invoice.MarkPaid(time.Now().UTC()); err != nil {
+		return model.InvoiceModel{}, err
+	}
+
+	if err := s.invoiceRepository.Update(ctx, invoice); err != nil {
+		message := "error marking invoice as paid"
+		s.logger.Error(message, "error", err, "invoiceID", invoice.ID())
+		return model.InvoiceModel{}, err
+	}
+
+	return invoice, nil
+}
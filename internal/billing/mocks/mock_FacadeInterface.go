@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	billing "github.com/cristiano-pacheco/goflix/internal/billing"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFacadeInterface is an autogenerated mock type for the FacadeInterface type
+type MockFacadeInterface struct {
+	mock.Mock
+}
+
+type MockFacadeInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFacadeInterface) EXPECT() *MockFacadeInterface_Expecter {
+	return &MockFacadeInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetEntitlements provides a mock function with given fields: ctx, userID
+func (_m *MockFacadeInterface) GetEntitlements(ctx context.Context, userID uint64) (billing.Entitlements, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntitlements")
+	}
+
+	var r0 billing.Entitlements
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (billing.Entitlements, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) billing.Entitlements); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(billing.Entitlements)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFacadeInterface_GetEntitlements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntitlements'
+type MockFacadeInterface_GetEntitlements_Call struct {
+	*mock.Call
+}
+
+// GetEntitlements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockFacadeInterface_Expecter) GetEntitlements(ctx interface{}, userID interface{}) *MockFacadeInterface_GetEntitlements_Call {
+	return &MockFacadeInterface_GetEntitlements_Call{Call: _e.mock.On("GetEntitlements", ctx, userID)}
+}
+
+func (_c *MockFacadeInterface_GetEntitlements_Call) Run(run func(ctx context.Context, userID uint64)) *MockFacadeInterface_GetEntitlements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockFacadeInterface_GetEntitlements_Call) Return(_a0 billing.Entitlements, _a1 error) *MockFacadeInterface_GetEntitlements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFacadeInterface_GetEntitlements_Call) RunAndReturn(run func(context.Context, uint64) (billing.Entitlements, error)) *MockFacadeInterface_GetEntitlements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserSubscriptionActive provides a mock function with given fields: ctx, userID
+func (_m *MockFacadeInterface) IsUserSubscriptionActive(ctx context.Context, userID uint64) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserSubscriptionActive")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFacadeInterface_IsUserSubscriptionActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserSubscriptionActive'
+type MockFacadeInterface_IsUserSubscriptionActive_Call struct {
+	*mock.Call
+}
+
+// IsUserSubscriptionActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockFacadeInterface_Expecter) IsUserSubscriptionActive(ctx interface{}, userID interface{}) *MockFacadeInterface_IsUserSubscriptionActive_Call {
+	return &MockFacadeInterface_IsUserSubscriptionActive_Call{Call: _e.mock.On("IsUserSubscriptionActive", ctx, userID)}
+}
+
+func (_c *MockFacadeInterface_IsUserSubscriptionActive_Call) Run(run func(ctx context.Context, userID uint64)) *MockFacadeInterface_IsUserSubscriptionActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockFacadeInterface_IsUserSubscriptionActive_Call) Return(_a0 bool, _a1 error) *MockFacadeInterface_IsUserSubscriptionActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFacadeInterface_IsUserSubscriptionActive_Call) RunAndReturn(run func(context.Context, uint64) (bool, error)) *MockFacadeInterface_IsUserSubscriptionActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFacadeInterface creates a new instance of MockFacadeInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFacadeInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFacadeInterface {
+	mock := &MockFacadeInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
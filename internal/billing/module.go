@@ -2,8 +2,103 @@ package billing
 
 import (
 	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/application/usecase"
+	domain_mapper "github.com/cristiano-pacheco/goflix/internal/billing/domain/mapper"
+	domain_repository "github.com/cristiano-pacheco/goflix/internal/billing/domain/repository"
+	domain_service "github.com/cristiano-pacheco/goflix/internal/billing/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/http/router"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/job"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/repository"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/service"
 )
 
 var Module = fx.Module(
 	"billing",
+	fx.Provide(
+		// #################### APPLICATION ####################################
+		// usecases
+		usecase.NewCreateSubscriptionUseCase,
+		usecase.NewRenewSubscriptionsUseCase,
+		usecase.NewCancelSubscriptionUseCase,
+		usecase.NewPauseSubscriptionUseCase,
+		usecase.NewResumeSubscriptionUseCase,
+		usecase.NewChangeSubscriptionPlanUseCase,
+		usecase.NewCreatePlanUseCase,
+		usecase.NewUpdatePlanUseCase,
+		usecase.NewFindPlanUseCase,
+		usecase.NewListPlansUseCase,
+		usecase.NewDeletePlanUseCase,
+		usecase.NewListInvoicesUseCase,
+		usecase.NewFindInvoiceUseCase,
+
+		// #################### DOMAIN #########################################
+		domain_mapper.NewEndDateMapper,
+		domain_service.NewProrationService,
+
+		// #################### INFRA ##########################################
+		router.NewRouter,
+
+		// handlers
+		handler.NewSubscriptionHandler,
+		handler.NewPlanHandler,
+		handler.NewInvoiceHandler,
+
+		// mappers
+		mapper.NewPlanMapper,
+		mapper.NewSubscriptionMapper,
+		mapper.NewInvoiceMapper,
+		mapper.NewPaymentMapper,
+
+		// repositories
+		fx.Annotate(
+			repository.NewPlanRepository,
+			fx.As(new(domain_repository.PlanRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewSubscriptionRepository,
+			fx.As(new(domain_repository.SubscriptionRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewInvoiceRepository,
+			fx.As(new(domain_repository.InvoiceRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewPaymentRepository,
+			fx.As(new(domain_repository.PaymentRepository)),
+		),
+
+		// services
+		// The fake gateway is the only implementation until a real provider is integrated
+		fx.Annotate(
+			service.NewFakePaymentGateway,
+			fx.As(new(domain_service.PaymentGateway)),
+		),
+
+		fx.Annotate(
+			service.NewSubscriptionChargeService,
+			fx.As(new(domain_service.SubscriptionChargeService)),
+		),
+
+		fx.Annotate(
+			service.NewSubscriptionAccessCache,
+			fx.As(new(domain_service.SubscriptionAccessCache)),
+		),
+
+		// jobs
+		job.NewRenewSubscriptionsJob,
+
+		// #################### FACADE #########################################
+		NewFacade,
+	),
+	fx.Invoke(
+		router.SetupSubscriptionRoutes,
+		router.SetupPlanRoutes,
+		router.SetupInvoiceRoutes,
+	),
 )
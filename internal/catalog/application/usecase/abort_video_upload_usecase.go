@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// AbortVideoUploadUseCase gives up a pending upload and frees the chunks already stored.
+type AbortVideoUploadUseCase struct {
+	videoUploadRepository repository.VideoUploadRepository
+	videoStorage          service.VideoStorage
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewAbortVideoUploadUseCase(
+	videoUploadRepository repository.VideoUploadRepository,
+	videoStorage service.VideoStorage,
+	validate validator.Validate,
+	logger logger.Logger,
+) *AbortVideoUploadUseCase {
+	return &AbortVideoUploadUseCase{videoUploadRepository, videoStorage, validate, logger}
+}
+
+type AbortVideoUploadInput struct {
+	UploadID string `validate:"required,uuid"`
+}
+
+func (uc *AbortVideoUploadUseCase) Execute(ctx context.Context, input AbortVideoUploadInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "AbortVideoUploadUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	uploadModel, err := uc.videoUploadRepository.FindByID(ctx, input.UploadID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoUploadNotFound) {
+			message := "error finding video upload by id"
+			uc.logger.Error(message, "error", err, "uploadID", input.UploadID)
+		}
+		return err
+	}
+
+	previousReceivedBytes := uploadModel.ReceivedBytes()
+	err = uploadModel.Abort()
+	if err != nil {
+		return err
+	}
+
+	// The upload is marked aborted first so no chunk can be recorded against the discarded storage upload
+	err = uc.videoUploadRepository.Update(ctx, uploadModel, previousReceivedBytes)
+	if err != nil {
+		return err
+	}
+
+	err = uc.videoStorage.AbortUpload(ctx, uploadModel.ObjectKey(), uploadModel.StorageUploadID())
+	if err != nil {
+		message := "error aborting video storage upload"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		return err
+	}
+
+	return nil
+}
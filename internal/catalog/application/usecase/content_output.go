@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type contentOutput struct {
+	Title             string
+	Description       string
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+}
+
+func newContentOutput(content model.ContentModel) contentOutput {
+	title := content.Title()
+	description := content.Description()
+
+	var ageRecommendation *uint
+	if content.AgeRecommendation() != nil {
+		years := content.AgeRecommendation().Years()
+		ageRecommendation = &years
+	}
+
+	return contentOutput{
+		Title:             title.String(),
+		Description:       description.String(),
+		AgeRecommendation: ageRecommendation,
+		ReleaseDate:       content.ReleaseDate(),
+	}
+}
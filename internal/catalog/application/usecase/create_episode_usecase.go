@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CreateEpisodeUseCase struct {
+	seasonRepository  repository.SeasonRepository
+	episodeRepository repository.EpisodeRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewCreateEpisodeUseCase(
+	seasonRepository repository.SeasonRepository,
+	episodeRepository repository.EpisodeRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateEpisodeUseCase {
+	return &CreateEpisodeUseCase{seasonRepository, episodeRepository, validate, logger}
+}
+
+type CreateEpisodeInput struct {
+	SeasonID      uint64 `validate:"required,number"`
+	EpisodeNumber uint   `validate:"required,number"`
+	Title         string `validate:"required"`
+	Description   string `validate:"required"`
+}
+
+func (uc *CreateEpisodeUseCase) Execute(ctx context.Context, input CreateEpisodeInput) (EpisodeOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateEpisodeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return EpisodeOutput{}, err
+	}
+
+	// Verify the season exists
+	_, err = uc.seasonRepository.FindByID(ctx, input.SeasonID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrSeasonNotFound) {
+			message := "error finding season by id"
+			uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		}
+		return EpisodeOutput{}, err
+	}
+
+	// Episode numbers are unique per season
+	_, err = uc.episodeRepository.FindBySeasonIDAndNumber(ctx, input.SeasonID, input.EpisodeNumber)
+	if err == nil {
+		return EpisodeOutput{}, errs.ErrEpisodeAlreadyExists
+	}
+	if !errors.Is(err, errs.ErrEpisodeNotFound) {
+		message := "error finding episode by number"
+		uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		return EpisodeOutput{}, err
+	}
+
+	episodeModel, err := model.CreateEpisodeModel(
+		input.SeasonID,
+		input.EpisodeNumber,
+		input.Title,
+		input.Description,
+	)
+	if err != nil {
+		return EpisodeOutput{}, err
+	}
+
+	createdEpisode, err := uc.episodeRepository.Create(ctx, episodeModel)
+	if err != nil {
+		message := "error creating episode"
+		uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		return EpisodeOutput{}, err
+	}
+
+	return newEpisodeOutput(createdEpisode), nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CreateMovieUseCase struct {
+	movieRepository repository.MovieRepository
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewCreateMovieUseCase(
+	movieRepository repository.MovieRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateMovieUseCase {
+	return &CreateMovieUseCase{movieRepository, validate, logger}
+}
+
+type CreateMovieInput struct {
+	Title             string `validate:"required"`
+	Description       string `validate:"required"`
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+	ExternalRating    *float64
+}
+
+func (uc *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInput) (MovieOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateMovieUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return MovieOutput{}, err
+	}
+
+	movieModel, err := model.CreateMovieModel(
+		input.Title,
+		input.Description,
+		input.AgeRecommendation,
+		input.ReleaseDate,
+		input.ExternalRating,
+	)
+	if err != nil {
+		return MovieOutput{}, err
+	}
+
+	createdMovie, err := uc.movieRepository.Create(ctx, movieModel)
+	if err != nil {
+		message := "error creating movie"
+		uc.logger.Error(message, "error", err)
+		return MovieOutput{}, err
+	}
+
+	return newMovieOutput(createdMovie), nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// CreatePlaybackURLUseCase signs a grant for the user to stream a video without an
+// Authorization header. The grant is only as good as its signature, so it is issued to
+// users whose access has already been checked. It is tied to a live playback session of the
+// video, which is how the concurrent stream limit of the plan applies to the URL.
+type CreatePlaybackURLUseCase struct {
+	videoRepository   repository.VideoRepository
+	sessionStore      service.PlaybackSessionStore
+	playbackURLSigner service.PlaybackURLSigner
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewCreatePlaybackURLUseCase(
+	videoRepository repository.VideoRepository,
+	sessionStore service.PlaybackSessionStore,
+	playbackURLSigner service.PlaybackURLSigner,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreatePlaybackURLUseCase {
+	return &CreatePlaybackURLUseCase{videoRepository, sessionStore, playbackURLSigner, validate, logger}
+}
+
+type CreatePlaybackURLInput struct {
+	UserID  uint64 `validate:"required,number"`
+	VideoID uint64 `validate:"required,number"`
+	// SessionID is the playback session started for the video, see StartPlaybackSessionUseCase.
+	SessionID string `validate:"required,uuid"`
+	// ClientIP is the address the grant gets bound to when IP binding is enabled.
+	ClientIP string
+}
+
+type CreatePlaybackURLOutput struct {
+	UserID          uint64
+	VideoID         uint64
+	SessionID       string
+	ExpiresAt       time.Time
+	BoundToClientIP bool
+	Signature       string
+}
+
+func (uc *CreatePlaybackURLUseCase) Execute(
+	ctx context.Context,
+	input CreatePlaybackURLInput,
+) (CreatePlaybackURLOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreatePlaybackURLUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return CreatePlaybackURLOutput{}, err
+	}
+
+	_, err = uc.videoRepository.FindByID(ctx, input.VideoID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoNotFound) {
+			message := "error finding video by id"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return CreatePlaybackURLOutput{}, err
+	}
+
+	session, err := uc.sessionStore.Find(ctx, input.UserID, input.SessionID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlaybackSessionNotFound) {
+			message := "error finding playback session"
+			uc.logger.Error(message, "error", err, "sessionID", input.SessionID)
+		}
+		return CreatePlaybackURLOutput{}, err
+	}
+
+	if session.VideoID() != input.VideoID {
+		return CreatePlaybackURLOutput{}, errs.ErrPlaybackSessionVideoMismatch
+	}
+
+	signedGrant, err := uc.playbackURLSigner.Sign(input.UserID, input.VideoID, input.SessionID, input.ClientIP)
+	if err != nil {
+		message := "error signing playback url"
+		uc.logger.Error(message, "error", err, "userID", input.UserID, "videoID", input.VideoID)
+		return CreatePlaybackURLOutput{}, err
+	}
+
+	grant := signedGrant.Grant
+	return CreatePlaybackURLOutput{
+		UserID:          grant.UserID(),
+		VideoID:         grant.VideoID(),
+		SessionID:       grant.SessionID(),
+		ExpiresAt:       grant.ExpiresAt(),
+		BoundToClientIP: grant.IsBoundToClientIP(),
+		Signature:       signedGrant.Signature,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CreateSeasonUseCase struct {
+	tvShowRepository repository.TvShowRepository
+	seasonRepository repository.SeasonRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewCreateSeasonUseCase(
+	tvShowRepository repository.TvShowRepository,
+	seasonRepository repository.SeasonRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateSeasonUseCase {
+	return &CreateSeasonUseCase{tvShowRepository, seasonRepository, validate, logger}
+}
+
+type CreateSeasonInput struct {
+	TvShowID     uint64 `validate:"required,number"`
+	SeasonNumber uint   `validate:"required,number"`
+	Title        string
+}
+
+func (uc *CreateSeasonUseCase) Execute(ctx context.Context, input CreateSeasonInput) (SeasonOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateSeasonUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return SeasonOutput{}, err
+	}
+
+	// Verify the tv show exists
+	_, err = uc.tvShowRepository.FindByID(ctx, input.TvShowID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTvShowNotFound) {
+			message := "error finding tv show by id"
+			uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		}
+		return SeasonOutput{}, err
+	}
+
+	// Season numbers are unique per tv show
+	_, err = uc.seasonRepository.FindByTvShowIDAndNumber(ctx, input.TvShowID, input.SeasonNumber)
+	if err == nil {
+		return SeasonOutput{}, errs.ErrSeasonAlreadyExists
+	}
+	if !errors.Is(err, errs.ErrSeasonNotFound) {
+		message := "error finding season by number"
+		uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		return SeasonOutput{}, err
+	}
+
+	seasonModel, err := model.CreateSeasonModel(input.TvShowID, input.SeasonNumber, input.Title)
+	if err != nil {
+		return SeasonOutput{}, err
+	}
+
+	createdSeason, err := uc.seasonRepository.Create(ctx, seasonModel)
+	if err != nil {
+		message := "error creating season"
+		uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		return SeasonOutput{}, err
+	}
+
+	return newSeasonOutput(createdSeason), nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// CreateTranscodingJobUseCase queues the packaging of the current file of a video again, e.g.
+// after its last job failed. Uploads queue their job on their own.
+type CreateTranscodingJobUseCase struct {
+	videoRepository          repository.VideoRepository
+	transcodingJobRepository repository.TranscodingJobRepository
+	transcodingQueue         service.TranscodingQueue
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewCreateTranscodingJobUseCase(
+	videoRepository repository.VideoRepository,
+	transcodingJobRepository repository.TranscodingJobRepository,
+	transcodingQueue service.TranscodingQueue,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateTranscodingJobUseCase {
+	return &CreateTranscodingJobUseCase{videoRepository, transcodingJobRepository, transcodingQueue, validate, logger}
+}
+
+type CreateTranscodingJobInput struct {
+	VideoID uint64 `validate:"required,number"`
+}
+
+func (uc *CreateTranscodingJobUseCase) Execute(
+	ctx context.Context,
+	input CreateTranscodingJobInput,
+) (TranscodingJobOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateTranscodingJobUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return TranscodingJobOutput{}, err
+	}
+
+	videoModel, err := uc.videoRepository.FindByID(ctx, input.VideoID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoNotFound) {
+			message := "error finding video by id"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return TranscodingJobOutput{}, err
+	}
+
+	latestJob, err := uc.transcodingJobRepository.FindLatestByVideoID(ctx, input.VideoID)
+	if err != nil && !errors.Is(err, errs.ErrTranscodingJobNotFound) {
+		message := "error finding latest transcoding job of video"
+		uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		return TranscodingJobOutput{}, err
+	}
+
+	if err == nil && latestJob.IsActive() {
+		return TranscodingJobOutput{}, errs.ErrTranscodingJobAlreadyQueued
+	}
+
+	jobModel, err := model.CreateTranscodingJobModel(videoModel.ID(), videoModel.URL())
+	if err != nil {
+		return TranscodingJobOutput{}, err
+	}
+
+	jobModel, err = uc.transcodingJobRepository.Create(ctx, jobModel)
+	if err != nil {
+		message := "error creating transcoding job"
+		uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		return TranscodingJobOutput{}, err
+	}
+
+	// The job is saved, when its message is lost it is queued again once stale
+	err = uc.transcodingQueue.EnqueueTranscoding(ctx, jobModel.ID())
+	if err != nil {
+		message := "error queuing transcoding job"
+		uc.logger.Error(message, "error", err, "jobID", jobModel.ID())
+	}
+
+	return newTranscodingJobOutput(jobModel), nil
+}
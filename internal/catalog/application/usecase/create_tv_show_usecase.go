@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type CreateTvShowUseCase struct {
+	tvShowRepository repository.TvShowRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewCreateTvShowUseCase(
+	tvShowRepository repository.TvShowRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateTvShowUseCase {
+	return &CreateTvShowUseCase{tvShowRepository, validate, logger}
+}
+
+type CreateTvShowInput struct {
+	Title             string `validate:"required"`
+	Description       string `validate:"required"`
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+}
+
+func (uc *CreateTvShowUseCase) Execute(ctx context.Context, input CreateTvShowInput) (TvShowOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateTvShowUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return TvShowOutput{}, err
+	}
+
+	tvShowModel, err := model.CreateTvShowModel(
+		input.Title,
+		input.Description,
+		input.AgeRecommendation,
+		input.ReleaseDate,
+	)
+	if err != nil {
+		return TvShowOutput{}, err
+	}
+
+	createdTvShow, err := uc.tvShowRepository.Create(ctx, tvShowModel)
+	if err != nil {
+		message := "error creating tv show"
+		uc.logger.Error(message, "error", err)
+		return TvShowOutput{}, err
+	}
+
+	return newTvShowOutput(createdTvShow), nil
+}
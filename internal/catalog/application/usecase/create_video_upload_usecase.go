@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// CreateVideoUploadUseCase opens a resumable upload for the video of a movie or of an episode.
+// The size and the SHA-256 checksum of the whole file are declared upfront, the file itself
+// is sent afterwards in chunks.
+type CreateVideoUploadUseCase struct {
+	movieRepository       repository.MovieRepository
+	episodeRepository     repository.EpisodeRepository
+	videoUploadRepository repository.VideoUploadRepository
+	videoStorage          service.VideoStorage
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewCreateVideoUploadUseCase(
+	movieRepository repository.MovieRepository,
+	episodeRepository repository.EpisodeRepository,
+	videoUploadRepository repository.VideoUploadRepository,
+	videoStorage service.VideoStorage,
+	validate validator.Validate,
+	logger logger.Logger,
+) *CreateVideoUploadUseCase {
+	return &CreateVideoUploadUseCase{
+		movieRepository,
+		episodeRepository,
+		videoUploadRepository,
+		videoStorage,
+		validate,
+		logger,
+	}
+}
+
+type CreateVideoUploadInput struct {
+	MovieID        *uint64
+	EpisodeID      *uint64
+	ContentType    string `validate:"required"`
+	SizeInBytes    uint64 `validate:"required,number"`
+	ChecksumSHA256 string `validate:"required"`
+}
+
+func (uc *CreateVideoUploadUseCase) Execute(
+	ctx context.Context,
+	input CreateVideoUploadInput,
+) (VideoUploadOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "CreateVideoUploadUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	uploadModel, err := model.CreateVideoUploadModel(
+		input.MovieID,
+		input.EpisodeID,
+		input.ContentType,
+		input.SizeInBytes,
+		input.ChecksumSHA256,
+	)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	err = uc.ensureContentExists(ctx, input)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	storageUploadID, err := uc.videoStorage.StartUpload(ctx, uploadModel.ObjectKey(), uploadModel.ContentType())
+	if err != nil {
+		message := "error starting video storage upload"
+		uc.logger.Error(message, "error", err, "objectKey", uploadModel.ObjectKey())
+		return VideoUploadOutput{}, err
+	}
+
+	err = uploadModel.StartStorageUpload(storageUploadID)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	err = uc.videoUploadRepository.Create(ctx, uploadModel)
+	if err != nil {
+		message := "error creating video upload"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+
+		if errAbort := uc.videoStorage.AbortUpload(ctx, uploadModel.ObjectKey(), storageUploadID); errAbort != nil {
+			message = "error aborting orphan video storage upload"
+			uc.logger.Error(message, "error", errAbort, "objectKey", uploadModel.ObjectKey())
+		}
+		return VideoUploadOutput{}, err
+	}
+
+	return newVideoUploadOutput(uploadModel), nil
+}
+
+func (uc *CreateVideoUploadUseCase) ensureContentExists(ctx context.Context, input CreateVideoUploadInput) error {
+	if input.MovieID != nil {
+		_, err := uc.movieRepository.FindByID(ctx, *input.MovieID)
+		if err != nil && !errors.Is(err, errs.ErrMovieNotFound) {
+			message := "error finding movie by id"
+			uc.logger.Error(message, "error", err, "movieID", *input.MovieID)
+		}
+		return err
+	}
+
+	_, err := uc.episodeRepository.FindByID(ctx, *input.EpisodeID)
+	if err != nil && !errors.Is(err, errs.ErrEpisodeNotFound) {
+		message := "error finding episode by id"
+		uc.logger.Error(message, "error", err, "episodeID", *input.EpisodeID)
+	}
+	return err
+}
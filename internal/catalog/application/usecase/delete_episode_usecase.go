@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type DeleteEpisodeUseCase struct {
+	episodeRepository repository.EpisodeRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewDeleteEpisodeUseCase(
+	episodeRepository repository.EpisodeRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *DeleteEpisodeUseCase {
+	return &DeleteEpisodeUseCase{episodeRepository, validate, logger}
+}
+
+type DeleteEpisodeInput struct {
+	EpisodeID uint64 `validate:"required,number"`
+}
+
+func (uc *DeleteEpisodeUseCase) Execute(ctx context.Context, input DeleteEpisodeInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "DeleteEpisodeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.episodeRepository.Delete(ctx, input.EpisodeID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrEpisodeNotFound) {
+			message := "error deleting episode"
+			uc.logger.Error(message, "error", err, "episodeID", input.EpisodeID)
+		}
+		return err
+	}
+
+	return nil
+}
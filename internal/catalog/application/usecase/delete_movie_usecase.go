@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type DeleteMovieUseCase struct {
+	movieRepository repository.MovieRepository
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewDeleteMovieUseCase(
+	movieRepository repository.MovieRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *DeleteMovieUseCase {
+	return &DeleteMovieUseCase{movieRepository, validate, logger}
+}
+
+type DeleteMovieInput struct {
+	MovieID uint64 `validate:"required,number"`
+}
+
+func (uc *DeleteMovieUseCase) Execute(ctx context.Context, input DeleteMovieInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "DeleteMovieUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.movieRepository.Delete(ctx, input.MovieID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrMovieNotFound) {
+			message := "error deleting movie"
+			uc.logger.Error(message, "error", err, "movieID", input.MovieID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type DeleteSeasonUseCase struct {
+	seasonRepository repository.SeasonRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewDeleteSeasonUseCase(
+	seasonRepository repository.SeasonRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *DeleteSeasonUseCase {
+	return &DeleteSeasonUseCase{seasonRepository, validate, logger}
+}
+
+type DeleteSeasonInput struct {
+	SeasonID uint64 `validate:"required,number"`
+}
+
+func (uc *DeleteSeasonUseCase) Execute(ctx context.Context, input DeleteSeasonInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "DeleteSeasonUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.seasonRepository.Delete(ctx, input.SeasonID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrSeasonNotFound) {
+			message := "error deleting season"
+			uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type DeleteTvShowUseCase struct {
+	tvShowRepository repository.TvShowRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewDeleteTvShowUseCase(
+	tvShowRepository repository.TvShowRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *DeleteTvShowUseCase {
+	return &DeleteTvShowUseCase{tvShowRepository, validate, logger}
+}
+
+type DeleteTvShowInput struct {
+	TvShowID uint64 `validate:"required,number"`
+}
+
+func (uc *DeleteTvShowUseCase) Execute(ctx context.Context, input DeleteTvShowInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "DeleteTvShowUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.tvShowRepository.Delete(ctx, input.TvShowID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTvShowNotFound) {
+			message := "error deleting tv show"
+			uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// EndPlaybackSessionUseCase frees a stream slot right away, either when the player stops
+// or when the user kills a stream from another device.
+type EndPlaybackSessionUseCase struct {
+	sessionStore service.PlaybackSessionStore
+	validate     validator.Validate
+	logger       logger.Logger
+}
+
+func NewEndPlaybackSessionUseCase(
+	sessionStore service.PlaybackSessionStore,
+	validate validator.Validate,
+	logger logger.Logger,
+) *EndPlaybackSessionUseCase {
+	return &EndPlaybackSessionUseCase{sessionStore, validate, logger}
+}
+
+type EndPlaybackSessionInput struct {
+	UserID    uint64 `validate:"required,number"`
+	SessionID string `validate:"required,uuid"`
+}
+
+func (uc *EndPlaybackSessionUseCase) Execute(ctx context.Context, input EndPlaybackSessionInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "EndPlaybackSessionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.sessionStore.Remove(ctx, input.UserID, input.SessionID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlaybackSessionNotFound) {
+			message := "error ending playback session"
+			uc.logger.Error(message, "error", err, "sessionID", input.SessionID)
+		}
+		return err
+	}
+
+	return nil
+}
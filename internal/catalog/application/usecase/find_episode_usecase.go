@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type FindEpisodeUseCase struct {
+	episodeRepository repository.EpisodeRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewFindEpisodeUseCase(
+	episodeRepository repository.EpisodeRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindEpisodeUseCase {
+	return &FindEpisodeUseCase{episodeRepository, validate, logger}
+}
+
+type FindEpisodeInput struct {
+	EpisodeID uint64 `validate:"required,number"`
+}
+
+func (uc *FindEpisodeUseCase) Execute(ctx context.Context, input FindEpisodeInput) (EpisodeOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindEpisodeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return EpisodeOutput{}, err
+	}
+
+	episodeModel, err := uc.episodeRepository.FindByID(ctx, input.EpisodeID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrEpisodeNotFound) {
+			message := "error finding episode by id"
+			uc.logger.Error(message, "error", err, "episodeID", input.EpisodeID)
+		}
+		return EpisodeOutput{}, err
+	}
+
+	return newEpisodeOutput(episodeModel), nil
+}
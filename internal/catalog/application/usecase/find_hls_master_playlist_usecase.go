@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// FindHLSMasterPlaylistUseCase writes the master playlist of a packaged video. The URIs of the
+// renditions are relative to the playlist: renditions/<name>/index.m3u8.
+type FindHLSMasterPlaylistUseCase struct {
+	videoRenditionRepository repository.VideoRenditionRepository
+	hlsPlaylistService       service.HLSPlaylistService
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewFindHLSMasterPlaylistUseCase(
+	videoRenditionRepository repository.VideoRenditionRepository,
+	hlsPlaylistService service.HLSPlaylistService,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindHLSMasterPlaylistUseCase {
+	return &FindHLSMasterPlaylistUseCase{videoRenditionRepository, hlsPlaylistService, validate, logger}
+}
+
+type FindHLSMasterPlaylistInput struct {
+	VideoID uint64 `validate:"required,number"`
+	// AccessQuery is appended to every URI of the playlist, so that players request them with
+	// the same grant as the playlist.
+	AccessQuery string
+}
+
+type HLSPlaylistOutput struct {
+	Playlist string
+}
+
+func (uc *FindHLSMasterPlaylistUseCase) Execute(
+	ctx context.Context,
+	input FindHLSMasterPlaylistInput,
+) (HLSPlaylistOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindHLSMasterPlaylistUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return HLSPlaylistOutput{}, err
+	}
+
+	renditions, err := uc.videoRenditionRepository.FindByVideoID(ctx, input.VideoID)
+	if err != nil {
+		message := "error finding renditions of video"
+		uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		return HLSPlaylistOutput{}, err
+	}
+
+	// Either the video does not exist or it was not packaged yet
+	if len(renditions) == 0 {
+		return HLSPlaylistOutput{}, errs.ErrVideoRenditionNotFound
+	}
+
+	playlist := uc.hlsPlaylistService.MasterPlaylist(renditions, func(rendition model.VideoRenditionModel) string {
+		return withAccessQuery("renditions/"+rendition.Name()+"/index.m3u8", input.AccessQuery)
+	})
+
+	return HLSPlaylistOutput{Playlist: playlist}, nil
+}
+
+func withAccessQuery(uri, accessQuery string) string {
+	if accessQuery == "" {
+		return uri
+	}
+	return uri + "?" + accessQuery
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// FindHLSMediaPlaylistUseCase writes the playlist of one rendition of a video. The URIs of the
+// segments are relative to the playlist: segments/<sequence>.ts.
+type FindHLSMediaPlaylistUseCase struct {
+	videoRenditionRepository repository.VideoRenditionRepository
+	hlsPlaylistService       service.HLSPlaylistService
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewFindHLSMediaPlaylistUseCase(
+	videoRenditionRepository repository.VideoRenditionRepository,
+	hlsPlaylistService service.HLSPlaylistService,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindHLSMediaPlaylistUseCase {
+	return &FindHLSMediaPlaylistUseCase{videoRenditionRepository, hlsPlaylistService, validate, logger}
+}
+
+type FindHLSMediaPlaylistInput struct {
+	VideoID       uint64 `validate:"required,number"`
+	RenditionName string `validate:"required"`
+	// AccessQuery is appended to the URI of every segment.
+	AccessQuery string
+}
+
+func (uc *FindHLSMediaPlaylistUseCase) Execute(
+	ctx context.Context,
+	input FindHLSMediaPlaylistInput,
+) (HLSPlaylistOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindHLSMediaPlaylistUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return HLSPlaylistOutput{}, err
+	}
+
+	rendition, err := uc.videoRenditionRepository.FindByVideoIDAndName(ctx, input.VideoID, input.RenditionName)
+	if err != nil {
+		if !isHLSLookupError(err) {
+			message := "error finding video rendition"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID, "rendition", input.RenditionName)
+		}
+		return HLSPlaylistOutput{}, err
+	}
+
+	playlist := uc.hlsPlaylistService.MediaPlaylist(rendition, func(segment model.VideoSegmentModel) string {
+		uri := "segments/" + strconv.FormatUint(uint64(segment.Sequence()), 10) + ".ts"
+		return withAccessQuery(uri, input.AccessQuery)
+	})
+
+	return HLSPlaylistOutput{Playlist: playlist}, nil
+}
+
+func isHLSLookupError(err error) bool {
+	return errors.Is(err, errs.ErrVideoRenditionNotFound) || errors.Is(err, errs.ErrVideoSegmentNotFound)
+}
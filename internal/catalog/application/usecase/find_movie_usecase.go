@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type FindMovieUseCase struct {
+	movieRepository repository.MovieRepository
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewFindMovieUseCase(
+	movieRepository repository.MovieRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindMovieUseCase {
+	return &FindMovieUseCase{movieRepository, validate, logger}
+}
+
+type FindMovieInput struct {
+	MovieID uint64 `validate:"required,number"`
+}
+
+func (uc *FindMovieUseCase) Execute(ctx context.Context, input FindMovieInput) (MovieOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindMovieUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return MovieOutput{}, err
+	}
+
+	movieModel, err := uc.movieRepository.FindByID(ctx, input.MovieID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrMovieNotFound) {
+			message := "error finding movie by id"
+			uc.logger.Error(message, "error", err, "movieID", input.MovieID)
+		}
+		return MovieOutput{}, err
+	}
+
+	return newMovieOutput(movieModel), nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// FindTranscodingJobUseCase returns the latest transcoding job of a video.
+type FindTranscodingJobUseCase struct {
+	transcodingJobRepository repository.TranscodingJobRepository
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewFindTranscodingJobUseCase(
+	transcodingJobRepository repository.TranscodingJobRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindTranscodingJobUseCase {
+	return &FindTranscodingJobUseCase{transcodingJobRepository, validate, logger}
+}
+
+type FindTranscodingJobInput struct {
+	VideoID uint64 `validate:"required,number"`
+}
+
+func (uc *FindTranscodingJobUseCase) Execute(
+	ctx context.Context,
+	input FindTranscodingJobInput,
+) (TranscodingJobOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindTranscodingJobUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return TranscodingJobOutput{}, err
+	}
+
+	jobModel, err := uc.transcodingJobRepository.FindLatestByVideoID(ctx, input.VideoID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTranscodingJobNotFound) {
+			message := "error finding latest transcoding job of video"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return TranscodingJobOutput{}, err
+	}
+
+	return newTranscodingJobOutput(jobModel), nil
+}
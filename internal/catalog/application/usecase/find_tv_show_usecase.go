@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type FindTvShowUseCase struct {
+	tvShowRepository  repository.TvShowRepository
+	seasonRepository  repository.SeasonRepository
+	episodeRepository repository.EpisodeRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewFindTvShowUseCase(
+	tvShowRepository repository.TvShowRepository,
+	seasonRepository repository.SeasonRepository,
+	episodeRepository repository.EpisodeRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindTvShowUseCase {
+	return &FindTvShowUseCase{
+		tvShowRepository,
+		seasonRepository,
+		episodeRepository,
+		validate,
+		logger,
+	}
+}
+
+type FindTvShowInput struct {
+	TvShowID uint64 `validate:"required,number"`
+}
+
+type FindTvShowOutput struct {
+	TvShowOutput
+	Seasons []SeasonOutput
+}
+
+func (uc *FindTvShowUseCase) Execute(ctx context.Context, input FindTvShowInput) (FindTvShowOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindTvShowUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return FindTvShowOutput{}, err
+	}
+
+	tvShowModel, err := uc.tvShowRepository.FindByID(ctx, input.TvShowID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTvShowNotFound) {
+			message := "error finding tv show by id"
+			uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		}
+		return FindTvShowOutput{}, err
+	}
+
+	seasonModels, err := uc.seasonRepository.FindByTvShowID(ctx, input.TvShowID)
+	if err != nil {
+		message := "error finding seasons by tv show id"
+		uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		return FindTvShowOutput{}, err
+	}
+
+	seasonIDs := make([]uint64, len(seasonModels))
+	seasons := make([]SeasonOutput, len(seasonModels))
+	seasonIndex := make(map[uint64]int, len(seasonModels))
+	for i, seasonModel := range seasonModels {
+		seasonIDs[i] = seasonModel.ID()
+		seasons[i] = newSeasonOutput(seasonModel)
+		seasonIndex[seasonModel.ID()] = i
+	}
+
+	if len(seasonIDs) > 0 {
+		episodeModels, err := uc.episodeRepository.FindBySeasonIDs(ctx, seasonIDs)
+		if err != nil {
+			message := "error finding episodes by season ids"
+			uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+			return FindTvShowOutput{}, err
+		}
+
+		for _, episodeModel := range episodeModels {
+			i := seasonIndex[episodeModel.SeasonID()]
+			seasons[i].Episodes = append(seasons[i].Episodes, newEpisodeOutput(episodeModel))
+		}
+	}
+
+	output := FindTvShowOutput{
+		TvShowOutput: newTvShowOutput(tvShowModel),
+		Seasons:      seasons,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// FindVideoUploadUseCase returns the state of an upload, clients resume an interrupted
+// upload from its received bytes.
+type FindVideoUploadUseCase struct {
+	videoUploadRepository repository.VideoUploadRepository
+	validate              validator.Validate
+	logger                logger.Logger
+}
+
+func NewFindVideoUploadUseCase(
+	videoUploadRepository repository.VideoUploadRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *FindVideoUploadUseCase {
+	return &FindVideoUploadUseCase{videoUploadRepository, validate, logger}
+}
+
+type FindVideoUploadInput struct {
+	UploadID string `validate:"required,uuid"`
+}
+
+func (uc *FindVideoUploadUseCase) Execute(ctx context.Context, input FindVideoUploadInput) (VideoUploadOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "FindVideoUploadUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	uploadModel, err := uc.videoUploadRepository.FindByID(ctx, input.UploadID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoUploadNotFound) {
+			message := "error finding video upload by id"
+			uc.logger.Error(message, "error", err, "uploadID", input.UploadID)
+		}
+		return VideoUploadOutput{}, err
+	}
+
+	return newVideoUploadOutput(uploadModel), nil
+}
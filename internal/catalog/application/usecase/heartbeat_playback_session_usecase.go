@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// HeartbeatPlaybackSessionUseCase keeps a playback session alive. Players that stop sending
+// heartbeats lose their session, and the stream slot is freed once it expires.
+type HeartbeatPlaybackSessionUseCase struct {
+	sessionStore service.PlaybackSessionStore
+	validate     validator.Validate
+	logger       logger.Logger
+}
+
+func NewHeartbeatPlaybackSessionUseCase(
+	sessionStore service.PlaybackSessionStore,
+	validate validator.Validate,
+	logger logger.Logger,
+) *HeartbeatPlaybackSessionUseCase {
+	return &HeartbeatPlaybackSessionUseCase{sessionStore, validate, logger}
+}
+
+type HeartbeatPlaybackSessionInput struct {
+	UserID    uint64 `validate:"required,number"`
+	SessionID string `validate:"required,uuid"`
+}
+
+func (uc *HeartbeatPlaybackSessionUseCase) Execute(
+	ctx context.Context,
+	input HeartbeatPlaybackSessionInput,
+) (PlaybackSessionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "HeartbeatPlaybackSessionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return PlaybackSessionOutput{}, err
+	}
+
+	session, err := uc.sessionStore.Find(ctx, input.UserID, input.SessionID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlaybackSessionNotFound) {
+			message := "error finding playback session"
+			uc.logger.Error(message, "error", err, "sessionID", input.SessionID)
+		}
+		return PlaybackSessionOutput{}, err
+	}
+
+	session.Heartbeat(time.Now().UTC())
+
+	err = uc.sessionStore.Refresh(ctx, session)
+	if err != nil {
+		if !errors.Is(err, errs.ErrPlaybackSessionNotFound) {
+			message := "error refreshing playback session"
+			uc.logger.Error(message, "error", err, "sessionID", input.SessionID)
+		}
+		return PlaybackSessionOutput{}, err
+	}
+
+	return newPlaybackSessionOutput(session), nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type ListMoviesUseCase struct {
+	movieRepository repository.MovieRepository
+	logger          logger.Logger
+}
+
+func NewListMoviesUseCase(
+	movieRepository repository.MovieRepository,
+	logger logger.Logger,
+) *ListMoviesUseCase {
+	return &ListMoviesUseCase{movieRepository, logger}
+}
+
+func (uc *ListMoviesUseCase) Execute(ctx context.Context) ([]MovieOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ListMoviesUseCase.Execute")
+	defer span.End()
+
+	movieModels, err := uc.movieRepository.FindAll(ctx)
+	if err != nil {
+		message := "error listing movies"
+		uc.logger.Error(message, "error", err)
+		return nil, err
+	}
+
+	output := make([]MovieOutput, len(movieModels))
+	for i, movieModel := range movieModels {
+		output[i] = newMovieOutput(movieModel)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type ListPlaybackSessionsUseCase struct {
+	sessionStore service.PlaybackSessionStore
+	validate     validator.Validate
+	logger       logger.Logger
+}
+
+func NewListPlaybackSessionsUseCase(
+	sessionStore service.PlaybackSessionStore,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ListPlaybackSessionsUseCase {
+	return &ListPlaybackSessionsUseCase{sessionStore, validate, logger}
+}
+
+type ListPlaybackSessionsInput struct {
+	UserID uint64 `validate:"required,number"`
+}
+
+func (uc *ListPlaybackSessionsUseCase) Execute(
+	ctx context.Context,
+	input ListPlaybackSessionsInput,
+) ([]PlaybackSessionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ListPlaybackSessionsUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionStore.List(ctx, input.UserID)
+	if err != nil {
+		message := "error listing playback sessions"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return nil, err
+	}
+
+	output := make([]PlaybackSessionOutput, len(sessions))
+	for i, session := range sessions {
+		output[i] = newPlaybackSessionOutput(session)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type ListTvShowsUseCase struct {
+	tvShowRepository repository.TvShowRepository
+	logger           logger.Logger
+}
+
+func NewListTvShowsUseCase(
+	tvShowRepository repository.TvShowRepository,
+	logger logger.Logger,
+) *ListTvShowsUseCase {
+	return &ListTvShowsUseCase{tvShowRepository, logger}
+}
+
+func (uc *ListTvShowsUseCase) Execute(ctx context.Context) ([]TvShowOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ListTvShowsUseCase.Execute")
+	defer span.End()
+
+	tvShowModels, err := uc.tvShowRepository.FindAll(ctx)
+	if err != nil {
+		message := "error listing tv shows"
+		uc.logger.Error(message, "error", err)
+		return nil, err
+	}
+
+	output := make([]TvShowOutput, len(tvShowModels))
+	for i, tvShowModel := range tvShowModels {
+		output[i] = newTvShowOutput(tvShowModel)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type MovieOutput struct {
+	MovieID           uint64
+	Title             string
+	Description       string
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+	ExternalRating    *float64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func newMovieOutput(movieModel model.MovieModel) MovieOutput {
+	content := movieModel.Content()
+	details := newContentOutput(content)
+
+	var externalRating *float64
+	if movieModel.ExternalRating() != nil {
+		rating := movieModel.ExternalRating().Value()
+		externalRating = &rating
+	}
+
+	return MovieOutput{
+		MovieID:           movieModel.ID(),
+		Title:             details.Title,
+		Description:       details.Description,
+		AgeRecommendation: details.AgeRecommendation,
+		ReleaseDate:       details.ReleaseDate,
+		ExternalRating:    externalRating,
+		CreatedAt:         movieModel.CreatedAt(),
+		UpdatedAt:         movieModel.UpdatedAt(),
+	}
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type PlaybackSessionOutput struct {
+	SessionID       string
+	VideoID         uint64
+	DeviceName      string
+	StartedAt       time.Time
+	LastHeartbeatAt time.Time
+}
+
+func newPlaybackSessionOutput(session model.PlaybackSessionModel) PlaybackSessionOutput {
+	return PlaybackSessionOutput{
+		SessionID:       session.ID(),
+		VideoID:         session.VideoID(),
+		DeviceName:      session.DeviceName(),
+		StartedAt:       session.StartedAt(),
+		LastHeartbeatAt: session.LastHeartbeatAt(),
+	}
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+const (
+	transcodingTimedOutError       = "transcoding timed out"
+	transcodingSourceReplacedError = "video file was replaced before transcoding finished"
+)
+
+// ProcessTranscodingJobUseCase claims a queued transcoding job and packages its video into HLS
+// renditions, which replace the previous ones of the video. A job that fails is set back to
+// pending until it runs out of attempts.
+type ProcessTranscodingJobUseCase struct {
+	transcodingJobRepository repository.TranscodingJobRepository
+	videoRepository          repository.VideoRepository
+	videoRenditionRepository repository.VideoRenditionRepository
+	videoTranscoder          service.VideoTranscoder
+	videoStorage             service.VideoStorage
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewProcessTranscodingJobUseCase(
+	transcodingJobRepository repository.TranscodingJobRepository,
+	videoRepository repository.VideoRepository,
+	videoRenditionRepository repository.VideoRenditionRepository,
+	videoTranscoder service.VideoTranscoder,
+	videoStorage service.VideoStorage,
+	validate validator.Validate,
+	logger logger.Logger,
+) *ProcessTranscodingJobUseCase {
+	return &ProcessTranscodingJobUseCase{
+		transcodingJobRepository,
+		videoRepository,
+		videoRenditionRepository,
+		videoTranscoder,
+		videoStorage,
+		validate,
+		logger,
+	}
+}
+
+type ProcessTranscodingJobInput struct {
+	JobID uint64 `validate:"required"`
+	// JobTimeout is how long a job may run before its worker is presumed dead and the job is
+	// claimed again.
+	JobTimeout  time.Duration `validate:"required"`
+	MaxAttempts uint          `validate:"required,number"`
+}
+
+type ProcessTranscodingJobOutput struct {
+	// Processed is false when the job was already done or is being run by another worker.
+	Processed bool
+	Job       TranscodingJobOutput
+}
+
+func (uc *ProcessTranscodingJobUseCase) Execute(
+	ctx context.Context,
+	input ProcessTranscodingJobInput,
+) (ProcessTranscodingJobOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "ProcessTranscodingJobUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return ProcessTranscodingJobOutput{}, err
+	}
+
+	jobModel, err := uc.transcodingJobRepository.FindByID(ctx, input.JobID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTranscodingJobNotFound) {
+			uc.logger.Error("error finding transcoding job by id", "error", err, "jobID", input.JobID)
+		}
+		return ProcessTranscodingJobOutput{}, err
+	}
+
+	// Messages are delivered at least once, the job may have been run by an earlier delivery
+	now := time.Now().UTC()
+	startedAt := jobModel.StartedAt()
+	isLeased := jobModel.IsRunning() && startedAt != nil && startedAt.After(now.Add(-input.JobTimeout))
+	if !jobModel.IsActive() || isLeased {
+		return ProcessTranscodingJobOutput{Job: newTranscodingJobOutput(jobModel)}, nil
+	}
+
+	// A running job is only run again when its worker died, it gets no other attempt when it has used them all
+	if jobModel.IsRunning() && jobModel.Attempts() >= input.MaxAttempts {
+		err = uc.fail(ctx, &jobModel, transcodingTimedOutError, 0)
+		return ProcessTranscodingJobOutput{Processed: true, Job: newTranscodingJobOutput(jobModel)}, err
+	}
+
+	claimedAttempts := jobModel.Attempts()
+	err = jobModel.Claim(now)
+	if err != nil {
+		return ProcessTranscodingJobOutput{}, err
+	}
+
+	err = uc.transcodingJobRepository.Update(ctx, jobModel, claimedAttempts)
+	if err != nil {
+		if errors.Is(err, errs.ErrTranscodingJobClaimed) {
+			return ProcessTranscodingJobOutput{}, nil
+		}
+		uc.logger.Error("error claiming transcoding job", "error", err, "jobID", jobModel.ID())
+		return ProcessTranscodingJobOutput{}, err
+	}
+
+	err = uc.transcode(ctx, &jobModel, input.MaxAttempts)
+	return ProcessTranscodingJobOutput{Processed: true, Job: newTranscodingJobOutput(jobModel)}, err
+}
+
+func (uc *ProcessTranscodingJobUseCase) transcode(
+	ctx context.Context,
+	jobModel *model.TranscodingJobModel,
+	maxAttempts uint,
+) error {
+	renditions, err := uc.videoTranscoder.Transcode(ctx, service.TranscodeInput{
+		VideoID:      jobModel.VideoID(),
+		SourceKey:    jobModel.SourceKey(),
+		OutputPrefix: jobModel.OutputPrefix(),
+		Profiles:     model.DefaultRenditionProfiles(),
+	})
+	if err != nil {
+		uc.logger.Error("error transcoding video", "error", err, "jobID", jobModel.ID(), "videoID", jobModel.VideoID())
+		// Recorded even when the transcoding was cut short by a shutdown, so that the job does not
+		// wait for its timeout to run again
+		return uc.fail(context.WithoutCancel(ctx), jobModel, err.Error(), maxAttempts)
+	}
+
+	// A newer upload has queued its own job, the renditions of this one are already outdated
+	videoModel, err := uc.videoRepository.FindByID(ctx, jobModel.VideoID())
+	if err != nil || videoModel.URL() != jobModel.SourceKey() {
+		uc.deleteSegments(ctx, renditions)
+		if err != nil && !errors.Is(err, errs.ErrVideoNotFound) {
+			uc.logger.Error("error finding video by id", "error", err, "videoID", jobModel.VideoID())
+			return uc.fail(ctx, jobModel, err.Error(), maxAttempts)
+		}
+		return uc.fail(ctx, jobModel, transcodingSourceReplacedError, 0)
+	}
+
+	previousRenditions, err := uc.videoRenditionRepository.ReplaceByVideoID(ctx, jobModel.VideoID(), renditions)
+	if err != nil {
+		uc.logger.Error("error saving video renditions", "error", err, "videoID", jobModel.VideoID())
+		uc.deleteSegments(ctx, renditions)
+		return uc.fail(ctx, jobModel, err.Error(), maxAttempts)
+	}
+	uc.deleteSegments(ctx, previousRenditions)
+
+	err = jobModel.Complete(time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return uc.update(ctx, *jobModel)
+}
+
+// fail records the failure of the job. With no attempts allowed the job fails for good.
+func (uc *ProcessTranscodingJobUseCase) fail(
+	ctx context.Context,
+	jobModel *model.TranscodingJobModel,
+	reason string,
+	maxAttempts uint,
+) error {
+	err := jobModel.Fail(reason, time.Now().UTC(), maxAttempts)
+	if err != nil {
+		return err
+	}
+
+	return uc.update(ctx, *jobModel)
+}
+
+func (uc *ProcessTranscodingJobUseCase) update(ctx context.Context, jobModel model.TranscodingJobModel) error {
+	err := uc.transcodingJobRepository.Update(ctx, jobModel, jobModel.Attempts())
+	if err != nil && !errors.Is(err, errs.ErrTranscodingJobClaimed) {
+		uc.logger.Error("error updating transcoding job", "error", err, "jobID", jobModel.ID())
+		return err
+	}
+	return nil
+}
+
+func (uc *ProcessTranscodingJobUseCase) deleteSegments(ctx context.Context, renditions []model.VideoRenditionModel) {
+	for _, rendition := range renditions {
+		for _, segment := range rendition.Segments() {
+			err := uc.videoStorage.Delete(ctx, segment.ObjectKey())
+			if err != nil {
+				message := "error deleting video segment"
+				uc.logger.Error(message, "error", err, "objectKey", segment.ObjectKey())
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// RequeueStaleTranscodingJobsUseCase queues again the jobs that went stale: pending jobs whose
+// message was lost, e.g. with the memory queue on a restart, and running jobs whose worker died.
+type RequeueStaleTranscodingJobsUseCase struct {
+	transcodingJobRepository repository.TranscodingJobRepository
+	transcodingQueue         service.TranscodingQueue
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewRequeueStaleTranscodingJobsUseCase(
+	transcodingJobRepository repository.TranscodingJobRepository,
+	transcodingQueue service.TranscodingQueue,
+	validate validator.Validate,
+	logger logger.Logger,
+) *RequeueStaleTranscodingJobsUseCase {
+	return &RequeueStaleTranscodingJobsUseCase{transcodingJobRepository, transcodingQueue, validate, logger}
+}
+
+type RequeueStaleTranscodingJobsInput struct {
+	// JobTimeout is how long a job may go without an update before it is presumed lost.
+	JobTimeout time.Duration `validate:"required"`
+}
+
+type RequeueStaleTranscodingJobsOutput struct {
+	Requeued int
+	Failed   int
+}
+
+func (uc *RequeueStaleTranscodingJobsUseCase) Execute(
+	ctx context.Context,
+	input RequeueStaleTranscodingJobsInput,
+) (RequeueStaleTranscodingJobsOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "RequeueStaleTranscodingJobsUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return RequeueStaleTranscodingJobsOutput{}, err
+	}
+
+	staleJobs, err := uc.transcodingJobRepository.FindStale(ctx, time.Now().UTC().Add(-input.JobTimeout))
+	if err != nil {
+		message := "error finding stale transcoding jobs"
+		uc.logger.Error(message, "error", err)
+		return RequeueStaleTranscodingJobsOutput{}, err
+	}
+
+	output := RequeueStaleTranscodingJobsOutput{}
+	for _, jobModel := range staleJobs {
+		err = uc.transcodingQueue.EnqueueTranscoding(ctx, jobModel.ID())
+		if err != nil {
+			message := "error queuing stale transcoding job"
+			uc.logger.Error(message, "error", err, "jobID", jobModel.ID())
+			output.Failed++
+			continue
+		}
+		output.Requeued++
+	}
+
+	return output, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// StartPlaybackSessionUseCase registers a new stream for the user, as long as the plan
+// of the user allows one more concurrent stream.
+type StartPlaybackSessionUseCase struct {
+	videoRepository repository.VideoRepository
+	sessionStore    service.PlaybackSessionStore
+	billingFacade   billing.FacadeInterface
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewStartPlaybackSessionUseCase(
+	videoRepository repository.VideoRepository,
+	sessionStore service.PlaybackSessionStore,
+	billingFacade billing.FacadeInterface,
+	validate validator.Validate,
+	logger logger.Logger,
+) *StartPlaybackSessionUseCase {
+	return &StartPlaybackSessionUseCase{videoRepository, sessionStore, billingFacade, validate, logger}
+}
+
+type StartPlaybackSessionInput struct {
+	UserID     uint64 `validate:"required,number"`
+	VideoID    uint64 `validate:"required,number"`
+	DeviceName string
+}
+
+func (uc *StartPlaybackSessionUseCase) Execute(
+	ctx context.Context,
+	input StartPlaybackSessionInput,
+) (PlaybackSessionOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "StartPlaybackSessionUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return PlaybackSessionOutput{}, err
+	}
+
+	// A session of an unknown video would take up one of the streams of the plan for nothing
+	_, err = uc.videoRepository.FindByID(ctx, input.VideoID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoNotFound) {
+			message := "error finding video by id"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return PlaybackSessionOutput{}, err
+	}
+
+	entitlements, err := uc.billingFacade.GetEntitlements(ctx, input.UserID)
+	if err != nil {
+		if !errors.Is(err, shared_errs.ErrSubscriptionRequired) {
+			message := "error getting plan entitlements"
+			uc.logger.Error(message, "error", err, "userID", input.UserID)
+		}
+		return PlaybackSessionOutput{}, err
+	}
+
+	session, err := model.CreatePlaybackSessionModel(input.UserID, input.VideoID, input.DeviceName)
+	if err != nil {
+		return PlaybackSessionOutput{}, err
+	}
+
+	err = uc.sessionStore.Add(ctx, session, entitlements.MaxConcurrentStreams)
+	if err != nil {
+		if !errors.Is(err, errs.ErrConcurrentStreamLimitReached) {
+			message := "error starting playback session"
+			uc.logger.Error(message, "error", err, "userID", input.UserID)
+		}
+		return PlaybackSessionOutput{}, err
+	}
+
+	return newPlaybackSessionOutput(session), nil
+}
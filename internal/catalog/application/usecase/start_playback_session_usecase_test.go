@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	billing_mocks "github.com/cristiano-pacheco/goflix/internal/billing/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/catalog/domain/service/mocks"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+func TestStartPlaybackSessionUseCase_Execute(t *testing.T) {
+	input := usecase.StartPlaybackSessionInput{UserID: 1, VideoID: 10, DeviceName: "Living room TV"}
+
+	t.Run("session is started for an existing video", func(t *testing.T) {
+		// Arrange
+		sut := newStartPlaybackSessionUseCaseSUT(t)
+		sut.videoRepository.EXPECT().FindByID(mock.Anything, uint64(10)).Return(model.VideoModel{}, nil).Once()
+		sut.billingFacade.EXPECT().GetEntitlements(mock.Anything, uint64(1)).
+			Return(billing.Entitlements{MaxConcurrentStreams: 2}, nil).Once()
+		sut.sessionStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(session model.PlaybackSessionModel) bool {
+			return session.UserID() == 1 && session.VideoID() == 10
+		}), uint(2)).Return(nil).Once()
+
+		// Act
+		output, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), output.VideoID)
+	})
+
+	t.Run("unknown video returns error without taking a stream", func(t *testing.T) {
+		// Arrange
+		sut := newStartPlaybackSessionUseCaseSUT(t)
+		sut.videoRepository.EXPECT().FindByID(mock.Anything, uint64(10)).
+			Return(model.VideoModel{}, errs.ErrVideoNotFound).Once()
+
+		// Act
+		_, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoNotFound)
+	})
+}
+
+type startPlaybackSessionUseCaseSUT struct {
+	useCase         *usecase.StartPlaybackSessionUseCase
+	videoRepository *repository_mocks.MockVideoRepository
+	sessionStore    *service_mocks.MockPlaybackSessionStore
+	billingFacade   *billing_mocks.MockFacadeInterface
+}
+
+func newStartPlaybackSessionUseCaseSUT(t *testing.T) *startPlaybackSessionUseCaseSUT {
+	t.Helper()
+
+	sut := &startPlaybackSessionUseCaseSUT{
+		videoRepository: repository_mocks.NewMockVideoRepository(t),
+		sessionStore:    service_mocks.NewMockPlaybackSessionStore(t),
+		billingFacade:   billing_mocks.NewMockFacadeInterface(t),
+	}
+	sut.useCase = usecase.NewStartPlaybackSessionUseCase(
+		sut.videoRepository,
+		sut.sessionStore,
+		sut.billingFacade,
+		validator.New(),
+		logger_mocks.NewMockLogger(t),
+	)
+	return sut
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+const hlsSegmentContentType = "video/mp2t"
+
+// StreamHLSSegmentUseCase opens a segment of a rendition for playback. The caller closes the file.
+type StreamHLSSegmentUseCase struct {
+	videoRenditionRepository repository.VideoRenditionRepository
+	videoStorage             service.VideoStorage
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewStreamHLSSegmentUseCase(
+	videoRenditionRepository repository.VideoRenditionRepository,
+	videoStorage service.VideoStorage,
+	validate validator.Validate,
+	logger logger.Logger,
+) *StreamHLSSegmentUseCase {
+	return &StreamHLSSegmentUseCase{videoRenditionRepository, videoStorage, validate, logger}
+}
+
+type StreamHLSSegmentInput struct {
+	VideoID       uint64 `validate:"required,number"`
+	RenditionName string `validate:"required"`
+	Sequence      uint
+}
+
+func (uc *StreamHLSSegmentUseCase) Execute(
+	ctx context.Context,
+	input StreamHLSSegmentInput,
+) (StreamVideoOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "StreamHLSSegmentUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return StreamVideoOutput{}, err
+	}
+
+	segment, err := uc.videoRenditionRepository.FindSegment(ctx, input.VideoID, input.RenditionName, input.Sequence)
+	if err != nil {
+		if !isHLSLookupError(err) {
+			message := "error finding video segment"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID, "rendition", input.RenditionName)
+		}
+		return StreamVideoOutput{}, err
+	}
+
+	segmentFile, err := uc.videoStorage.Open(ctx, segment.ObjectKey())
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoFileNotFound) {
+			message := "error opening video segment"
+			uc.logger.Error(message, "error", err, "objectKey", segment.ObjectKey())
+		}
+		return StreamVideoOutput{}, err
+	}
+
+	info := segmentFile.Info()
+	return StreamVideoOutput{
+		File:         segmentFile,
+		SizeInBytes:  info.SizeInBytes,
+		ContentType:  hlsSegmentContentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// StreamVideoUseCase opens the file of a video for playback. The caller closes the file.
+type StreamVideoUseCase struct {
+	videoRepository repository.VideoRepository
+	videoStorage    service.VideoStorage
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewStreamVideoUseCase(
+	videoRepository repository.VideoRepository,
+	videoStorage service.VideoStorage,
+	validate validator.Validate,
+	logger logger.Logger,
+) *StreamVideoUseCase {
+	return &StreamVideoUseCase{videoRepository, videoStorage, validate, logger}
+}
+
+type StreamVideoInput struct {
+	VideoID uint64 `validate:"required,number"`
+}
+
+type StreamVideoOutput struct {
+	File        service.VideoFile
+	SizeInBytes uint64
+	ContentType string
+	// ETag changes whenever the file of the video is replaced.
+	ETag         string
+	LastModified time.Time
+}
+
+func (uc *StreamVideoUseCase) Execute(ctx context.Context, input StreamVideoInput) (StreamVideoOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "StreamVideoUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return StreamVideoOutput{}, err
+	}
+
+	videoModel, err := uc.videoRepository.FindByID(ctx, input.VideoID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoNotFound) {
+			message := "error finding video by id"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return StreamVideoOutput{}, err
+	}
+
+	videoFile, err := uc.videoStorage.Open(ctx, videoModel.URL())
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoFileNotFound) {
+			message := "error opening video file"
+			uc.logger.Error(message, "error", err, "videoID", input.VideoID)
+		}
+		return StreamVideoOutput{}, err
+	}
+
+	info := videoFile.Info()
+	output := StreamVideoOutput{
+		File:         videoFile,
+		SizeInBytes:  info.SizeInBytes,
+		ContentType:  videoModel.ContentType(),
+		ETag:         videoModel.ChecksumSHA256(),
+		LastModified: info.LastModified,
+	}
+
+	// Videos that were not uploaded through the catalog have no content type nor checksum
+	if output.ContentType == "" {
+		output.ContentType = info.ContentType
+	}
+	if output.ETag == "" {
+		output.ETag = info.ETag
+	}
+
+	return output, nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type TranscodingJobOutput struct {
+	JobID      uint64
+	VideoID    uint64
+	Status     string
+	Attempts   uint
+	LastError  string
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func newTranscodingJobOutput(job model.TranscodingJobModel) TranscodingJobOutput {
+	status := job.Status()
+	return TranscodingJobOutput{
+		JobID:      job.ID(),
+		VideoID:    job.VideoID(),
+		Status:     status.String(),
+		Attempts:   job.Attempts(),
+		LastError:  job.LastError(),
+		StartedAt:  job.StartedAt(),
+		FinishedAt: job.FinishedAt(),
+		CreatedAt:  job.CreatedAt(),
+		UpdatedAt:  job.UpdatedAt(),
+	}
+}
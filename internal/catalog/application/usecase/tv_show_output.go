@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type TvShowOutput struct {
+	TvShowID          uint64
+	Title             string
+	Description       string
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type SeasonOutput struct {
+	SeasonID     uint64
+	TvShowID     uint64
+	SeasonNumber uint
+	Title        *string
+	Episodes     []EpisodeOutput
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type EpisodeOutput struct {
+	EpisodeID     uint64
+	SeasonID      uint64
+	EpisodeNumber uint
+	Title         string
+	Description   string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func newTvShowOutput(tvShowModel model.TvShowModel) TvShowOutput {
+	details := newContentOutput(tvShowModel.Content())
+
+	return TvShowOutput{
+		TvShowID:          tvShowModel.ID(),
+		Title:             details.Title,
+		Description:       details.Description,
+		AgeRecommendation: details.AgeRecommendation,
+		ReleaseDate:       details.ReleaseDate,
+		CreatedAt:         tvShowModel.CreatedAt(),
+		UpdatedAt:         tvShowModel.UpdatedAt(),
+	}
+}
+
+func newSeasonOutput(seasonModel model.SeasonModel) SeasonOutput {
+	var title *string
+	if seasonModel.Title() != nil {
+		value := seasonModel.Title().String()
+		title = &value
+	}
+
+	return SeasonOutput{
+		SeasonID:     seasonModel.ID(),
+		TvShowID:     seasonModel.TvShowID(),
+		SeasonNumber: seasonModel.SeasonNumber(),
+		Title:        title,
+		Episodes:     []EpisodeOutput{},
+		CreatedAt:    seasonModel.CreatedAt(),
+		UpdatedAt:    seasonModel.UpdatedAt(),
+	}
+}
+
+func newEpisodeOutput(episodeModel model.EpisodeModel) EpisodeOutput {
+	title := episodeModel.Title()
+	description := episodeModel.Description()
+
+	return EpisodeOutput{
+		EpisodeID:     episodeModel.ID(),
+		SeasonID:      episodeModel.SeasonID(),
+		EpisodeNumber: episodeModel.EpisodeNumber(),
+		Title:         title.String(),
+		Description:   description.String(),
+		CreatedAt:     episodeModel.CreatedAt(),
+		UpdatedAt:     episodeModel.UpdatedAt(),
+	}
+}
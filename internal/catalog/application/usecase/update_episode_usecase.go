@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UpdateEpisodeUseCase struct {
+	episodeRepository repository.EpisodeRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewUpdateEpisodeUseCase(
+	episodeRepository repository.EpisodeRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UpdateEpisodeUseCase {
+	return &UpdateEpisodeUseCase{episodeRepository, validate, logger}
+}
+
+type UpdateEpisodeInput struct {
+	EpisodeID     uint64 `validate:"required,number"`
+	EpisodeNumber uint   `validate:"required,number"`
+	Title         string `validate:"required"`
+	Description   string `validate:"required"`
+}
+
+func (uc *UpdateEpisodeUseCase) Execute(ctx context.Context, input UpdateEpisodeInput) (EpisodeOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UpdateEpisodeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return EpisodeOutput{}, err
+	}
+
+	episodeModel, err := uc.episodeRepository.FindByID(ctx, input.EpisodeID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrEpisodeNotFound) {
+			message := "error finding episode by id"
+			uc.logger.Error(message, "error", err, "episodeID", input.EpisodeID)
+		}
+		return EpisodeOutput{}, err
+	}
+
+	if episodeModel.EpisodeNumber() != input.EpisodeNumber {
+		_, err = uc.episodeRepository.FindBySeasonIDAndNumber(ctx, episodeModel.SeasonID(), input.EpisodeNumber)
+		if err == nil {
+			return EpisodeOutput{}, errs.ErrEpisodeAlreadyExists
+		}
+		if !errors.Is(err, errs.ErrEpisodeNotFound) {
+			message := "error finding episode by number"
+			uc.logger.Error(message, "error", err, "episodeID", input.EpisodeID)
+			return EpisodeOutput{}, err
+		}
+	}
+
+	err = episodeModel.Update(input.EpisodeNumber, input.Title, input.Description)
+	if err != nil {
+		return EpisodeOutput{}, err
+	}
+
+	err = uc.episodeRepository.Update(ctx, episodeModel)
+	if err != nil {
+		message := "error updating episode"
+		uc.logger.Error(message, "error", err, "episodeID", input.EpisodeID)
+		return EpisodeOutput{}, err
+	}
+
+	return newEpisodeOutput(episodeModel), nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UpdateMovieUseCase struct {
+	movieRepository repository.MovieRepository
+	validate        validator.Validate
+	logger          logger.Logger
+}
+
+func NewUpdateMovieUseCase(
+	movieRepository repository.MovieRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UpdateMovieUseCase {
+	return &UpdateMovieUseCase{movieRepository, validate, logger}
+}
+
+type UpdateMovieInput struct {
+	MovieID           uint64 `validate:"required,number"`
+	Title             string `validate:"required"`
+	Description       string `validate:"required"`
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+	ExternalRating    *float64
+}
+
+func (uc *UpdateMovieUseCase) Execute(ctx context.Context, input UpdateMovieInput) (MovieOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UpdateMovieUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return MovieOutput{}, err
+	}
+
+	movieModel, err := uc.movieRepository.FindByID(ctx, input.MovieID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrMovieNotFound) {
+			message := "error finding movie by id"
+			uc.logger.Error(message, "error", err, "movieID", input.MovieID)
+		}
+		return MovieOutput{}, err
+	}
+
+	err = movieModel.Update(
+		input.Title,
+		input.Description,
+		input.AgeRecommendation,
+		input.ReleaseDate,
+		input.ExternalRating,
+	)
+	if err != nil {
+		return MovieOutput{}, err
+	}
+
+	err = uc.movieRepository.Update(ctx, movieModel)
+	if err != nil {
+		message := "error updating movie"
+		uc.logger.Error(message, "error", err, "movieID", input.MovieID)
+		return MovieOutput{}, err
+	}
+
+	return newMovieOutput(movieModel), nil
+}
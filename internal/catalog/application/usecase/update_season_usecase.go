@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UpdateSeasonUseCase struct {
+	seasonRepository repository.SeasonRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewUpdateSeasonUseCase(
+	seasonRepository repository.SeasonRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UpdateSeasonUseCase {
+	return &UpdateSeasonUseCase{seasonRepository, validate, logger}
+}
+
+type UpdateSeasonInput struct {
+	SeasonID     uint64 `validate:"required,number"`
+	SeasonNumber uint   `validate:"required,number"`
+	Title        string
+}
+
+func (uc *UpdateSeasonUseCase) Execute(ctx context.Context, input UpdateSeasonInput) (SeasonOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UpdateSeasonUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return SeasonOutput{}, err
+	}
+
+	seasonModel, err := uc.seasonRepository.FindByID(ctx, input.SeasonID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrSeasonNotFound) {
+			message := "error finding season by id"
+			uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		}
+		return SeasonOutput{}, err
+	}
+
+	if seasonModel.SeasonNumber() != input.SeasonNumber {
+		_, err = uc.seasonRepository.FindByTvShowIDAndNumber(ctx, seasonModel.TvShowID(), input.SeasonNumber)
+		if err == nil {
+			return SeasonOutput{}, errs.ErrSeasonAlreadyExists
+		}
+		if !errors.Is(err, errs.ErrSeasonNotFound) {
+			message := "error finding season by number"
+			uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+			return SeasonOutput{}, err
+		}
+	}
+
+	err = seasonModel.Update(input.SeasonNumber, input.Title)
+	if err != nil {
+		return SeasonOutput{}, err
+	}
+
+	err = uc.seasonRepository.Update(ctx, seasonModel)
+	if err != nil {
+		message := "error updating season"
+		uc.logger.Error(message, "error", err, "seasonID", input.SeasonID)
+		return SeasonOutput{}, err
+	}
+
+	return newSeasonOutput(seasonModel), nil
+}
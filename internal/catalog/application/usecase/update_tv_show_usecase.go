@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UpdateTvShowUseCase struct {
+	tvShowRepository repository.TvShowRepository
+	validate         validator.Validate
+	logger           logger.Logger
+}
+
+func NewUpdateTvShowUseCase(
+	tvShowRepository repository.TvShowRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UpdateTvShowUseCase {
+	return &UpdateTvShowUseCase{tvShowRepository, validate, logger}
+}
+
+type UpdateTvShowInput struct {
+	TvShowID          uint64 `validate:"required,number"`
+	Title             string `validate:"required"`
+	Description       string `validate:"required"`
+	AgeRecommendation *uint
+	ReleaseDate       *time.Time
+}
+
+func (uc *UpdateTvShowUseCase) Execute(ctx context.Context, input UpdateTvShowInput) (TvShowOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UpdateTvShowUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return TvShowOutput{}, err
+	}
+
+	tvShowModel, err := uc.tvShowRepository.FindByID(ctx, input.TvShowID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrTvShowNotFound) {
+			message := "error finding tv show by id"
+			uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		}
+		return TvShowOutput{}, err
+	}
+
+	err = tvShowModel.Update(
+		input.Title,
+		input.Description,
+		input.AgeRecommendation,
+		input.ReleaseDate,
+	)
+	if err != nil {
+		return TvShowOutput{}, err
+	}
+
+	err = uc.tvShowRepository.Update(ctx, tvShowModel)
+	if err != nil {
+		message := "error updating tv show"
+		uc.logger.Error(message, "error", err, "tvShowID", input.TvShowID)
+		return TvShowOutput{}, err
+	}
+
+	return newTvShowOutput(tvShowModel), nil
+}
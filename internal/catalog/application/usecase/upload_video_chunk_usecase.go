@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+// UploadVideoChunkUseCase appends the next chunk of a pending upload. Once the last chunk is
+// received the checksum of the whole file is verified and the video of the movie or episode
+// is created, or replaced when it already had one, with its size filled from the upload. The
+// file is then queued to be packaged for adaptive streaming.
+type UploadVideoChunkUseCase struct {
+	videoUploadRepository    repository.VideoUploadRepository
+	videoRepository          repository.VideoRepository
+	transcodingJobRepository repository.TranscodingJobRepository
+	videoStorage             service.VideoStorage
+	transcodingQueue         service.TranscodingQueue
+	validate                 validator.Validate
+	logger                   logger.Logger
+}
+
+func NewUploadVideoChunkUseCase(
+	videoUploadRepository repository.VideoUploadRepository,
+	videoRepository repository.VideoRepository,
+	transcodingJobRepository repository.TranscodingJobRepository,
+	videoStorage service.VideoStorage,
+	transcodingQueue service.TranscodingQueue,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UploadVideoChunkUseCase {
+	return &UploadVideoChunkUseCase{
+		videoUploadRepository,
+		videoRepository,
+		transcodingJobRepository,
+		videoStorage,
+		transcodingQueue,
+		validate,
+		logger,
+	}
+}
+
+type UploadVideoChunkInput struct {
+	UploadID string `validate:"required,uuid"`
+	// Offset is the position of the chunk in the file, it must match the bytes received so far.
+	Offset      uint64
+	SizeInBytes uint64    `validate:"required,number"`
+	Chunk       io.Reader `validate:"required"`
+}
+
+func (uc *UploadVideoChunkUseCase) Execute(
+	ctx context.Context,
+	input UploadVideoChunkInput,
+) (VideoUploadOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UploadVideoChunkUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	uploadModel, err := uc.videoUploadRepository.FindByID(ctx, input.UploadID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoUploadNotFound) {
+			message := "error finding video upload by id"
+			uc.logger.Error(message, "error", err, "uploadID", input.UploadID)
+		}
+		return VideoUploadOutput{}, err
+	}
+
+	// Rejected before any byte is read, so a client out of sync does not send a whole chunk for nothing
+	err = uploadModel.ValidateChunk(input.Offset, input.SizeInBytes)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	checksum, err := restoreUploadHash(uploadModel.HashState())
+	if err != nil {
+		message := "error restoring video upload hash state"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		return VideoUploadOutput{}, err
+	}
+
+	part, err := uc.videoStorage.UploadPart(
+		ctx,
+		uploadModel.ObjectKey(),
+		uploadModel.StorageUploadID(),
+		uploadModel.NextPartNumber(),
+		io.TeeReader(input.Chunk, checksum),
+		input.SizeInBytes,
+	)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoUploadChunkIncomplete) {
+			message := "error uploading video chunk"
+			uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		}
+		return VideoUploadOutput{}, err
+	}
+
+	hashState, err := marshalUploadHash(checksum)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	previousReceivedBytes := uploadModel.ReceivedBytes()
+	err = uploadModel.AddPart(part, hashState)
+	if err != nil {
+		return VideoUploadOutput{}, err
+	}
+
+	if uploadModel.IsFullyReceived() {
+		err = uc.complete(ctx, &uploadModel, hex.EncodeToString(checksum.Sum(nil)), previousReceivedBytes)
+		if err != nil {
+			return VideoUploadOutput{}, err
+		}
+		return newVideoUploadOutput(uploadModel), nil
+	}
+
+	err = uc.videoUploadRepository.Update(ctx, uploadModel, previousReceivedBytes)
+	if err != nil {
+		if !errors.Is(err, errs.ErrVideoUploadOffsetMismatch) && !errors.Is(err, errs.ErrVideoUploadNotPending) {
+			message := "error updating video upload"
+			uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		}
+		return VideoUploadOutput{}, err
+	}
+
+	return newVideoUploadOutput(uploadModel), nil
+}
+
+// complete verifies the checksum of the whole file and turns the upload into the video of its
+// movie or episode. A file that does not match the declared checksum is discarded.
+func (uc *UploadVideoChunkUseCase) complete(
+	ctx context.Context,
+	uploadModel *model.VideoUploadModel,
+	checksum string,
+	previousReceivedBytes uint64,
+) error {
+	if checksum != uploadModel.ChecksumSHA256() {
+		return uc.abortMismatch(ctx, uploadModel, previousReceivedBytes)
+	}
+
+	err := uc.videoStorage.CompleteUpload(
+		ctx,
+		uploadModel.ObjectKey(),
+		uploadModel.StorageUploadID(),
+		uploadModel.Parts(),
+	)
+	if err != nil {
+		message := "error completing video storage upload"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		return err
+	}
+
+	videoModel, previousVideo, err := uc.saveVideo(ctx, *uploadModel)
+	if err != nil {
+		message := "error saving uploaded video"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		return err
+	}
+
+	err = uploadModel.Complete(videoModel.ID())
+	if err != nil {
+		return err
+	}
+
+	err = uc.videoUploadRepository.Update(ctx, *uploadModel, previousReceivedBytes)
+	if err != nil {
+		message := "error completing video upload"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+		return err
+	}
+
+	uc.queueTranscoding(ctx, videoModel)
+
+	// Only files uploaded here have a checksum, older videos may point to files stored elsewhere
+	if previousVideo != nil && previousVideo.ChecksumSHA256() != "" && previousVideo.URL() != videoModel.URL() {
+		if errDelete := uc.videoStorage.Delete(ctx, previousVideo.URL()); errDelete != nil {
+			message := "error deleting replaced video file"
+			uc.logger.Error(message, "error", errDelete, "objectKey", previousVideo.URL())
+		}
+	}
+
+	return nil
+}
+
+// queueTranscoding does not fail the upload, the video can still be streamed from its file
+// and an admin can queue the job again. A job whose message is lost is queued again once stale.
+func (uc *UploadVideoChunkUseCase) queueTranscoding(ctx context.Context, videoModel model.VideoModel) {
+	jobModel, err := model.CreateTranscodingJobModel(videoModel.ID(), videoModel.URL())
+	if err == nil {
+		jobModel, err = uc.transcodingJobRepository.Create(ctx, jobModel)
+	}
+	if err == nil {
+		err = uc.transcodingQueue.EnqueueTranscoding(ctx, jobModel.ID())
+	}
+
+	if err != nil {
+		message := "error queuing transcoding of uploaded video"
+		uc.logger.Error(message, "error", err, "videoID", videoModel.ID())
+	}
+}
+
+func (uc *UploadVideoChunkUseCase) abortMismatch(
+	ctx context.Context,
+	uploadModel *model.VideoUploadModel,
+	previousReceivedBytes uint64,
+) error {
+	err := uploadModel.Abort()
+	if err != nil {
+		return err
+	}
+
+	err = uc.videoUploadRepository.Update(ctx, *uploadModel, previousReceivedBytes)
+	if err != nil {
+		return err
+	}
+
+	err = uc.videoStorage.AbortUpload(ctx, uploadModel.ObjectKey(), uploadModel.StorageUploadID())
+	if err != nil {
+		message := "error aborting video storage upload"
+		uc.logger.Error(message, "error", err, "uploadID", uploadModel.ID())
+	}
+
+	return errs.ErrVideoUploadChecksumMismatch
+}
+
+// saveVideo points the video of the movie or episode to the uploaded file and returns the
+// video it replaced, if any.
+func (uc *UploadVideoChunkUseCase) saveVideo(
+	ctx context.Context,
+	uploadModel model.VideoUploadModel,
+) (model.VideoModel, *model.VideoModel, error) {
+	var existingVideo model.VideoModel
+	var err error
+	if uploadModel.MovieID() != nil {
+		existingVideo, err = uc.videoRepository.FindByMovieID(ctx, *uploadModel.MovieID())
+	} else {
+		existingVideo, err = uc.videoRepository.FindByEpisodeID(ctx, *uploadModel.EpisodeID())
+	}
+
+	if errors.Is(err, errs.ErrVideoNotFound) {
+		videoModel, errCreate := model.CreateVideoModel(
+			uploadModel.MovieID(),
+			uploadModel.EpisodeID(),
+			uploadModel.ObjectKey(),
+			uploadModel.TotalSizeInBytes(),
+			uploadModel.ContentType(),
+			uploadModel.ChecksumSHA256(),
+		)
+		if errCreate != nil {
+			return model.VideoModel{}, nil, errCreate
+		}
+
+		videoModel, errCreate = uc.videoRepository.Create(ctx, videoModel)
+		return videoModel, nil, errCreate
+	}
+	if err != nil {
+		return model.VideoModel{}, nil, err
+	}
+
+	previousVideo := existingVideo
+	err = existingVideo.ReplaceFile(
+		uploadModel.ObjectKey(),
+		uploadModel.TotalSizeInBytes(),
+		uploadModel.ContentType(),
+		uploadModel.ChecksumSHA256(),
+	)
+	if err != nil {
+		return model.VideoModel{}, nil, err
+	}
+
+	err = uc.videoRepository.Update(ctx, existingVideo)
+	if err != nil {
+		return model.VideoModel{}, nil, err
+	}
+
+	return existingVideo, &previousVideo, nil
+}
+
+// restoreUploadHash resumes the SHA-256 of the bytes received so far, so the checksum of the
+// whole file is known when the last chunk arrives without reading the file back.
+func restoreUploadHash(state []byte) (hash.Hash, error) {
+	checksum := sha256.New()
+	if len(state) == 0 {
+		return checksum, nil
+	}
+
+	unmarshaler, ok := checksum.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return nil, errors.New("sha256 hash state cannot be restored")
+	}
+
+	if err := unmarshaler.UnmarshalBinary(state); err != nil {
+		return nil, fmt.Errorf("restoring sha256 hash state: %w", err)
+	}
+
+	return checksum, nil
+}
+
+func marshalUploadHash(checksum hash.Hash) ([]byte, error) {
+	marshaler, ok := checksum.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, errors.New("sha256 hash state cannot be saved")
+	}
+
+	return marshaler.MarshalBinary()
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type VideoUploadOutput struct {
+	UploadID      string
+	MovieID       *uint64
+	EpisodeID     *uint64
+	ContentType   string
+	SizeInBytes   uint64
+	ReceivedBytes uint64
+	Status        string
+	VideoID       *uint64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func newVideoUploadOutput(upload model.VideoUploadModel) VideoUploadOutput {
+	status := upload.Status()
+	return VideoUploadOutput{
+		UploadID:      upload.ID(),
+		MovieID:       upload.MovieID(),
+		EpisodeID:     upload.EpisodeID(),
+		ContentType:   upload.ContentType(),
+		SizeInBytes:   upload.TotalSizeInBytes(),
+		ReceivedBytes: upload.ReceivedBytes(),
+		Status:        status.String(),
+		VideoID:       upload.VideoID(),
+		CreatedAt:     upload.CreatedAt(),
+		UpdatedAt:     upload.UpdatedAt(),
+	}
+}
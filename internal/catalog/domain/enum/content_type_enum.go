@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	EnumContentTypeMovie  string = "MOVIE"
+	EnumContentTypeTvShow string = "TV_SHOW"
+)
+
+type ContentTypeEnum struct {
+	value string
+}
+
+func NewContentTypeEnum(value string) (ContentTypeEnum, error) {
+	if err := validateContentTypeEnum(value); err != nil {
+		return ContentTypeEnum{}, err
+	}
+
+	return ContentTypeEnum{value: value}, nil
+}
+
+func (e *ContentTypeEnum) String() string {
+	return e.value
+}
+
+func validateContentTypeEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumContentTypeMovie:  {},
+		EnumContentTypeTvShow: {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidContentType, value)
+	}
+
+	return nil
+}
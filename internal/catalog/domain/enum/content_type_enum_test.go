@@ -0,0 +1,60 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+func TestNewContentTypeEnum(t *testing.T) {
+	t.Run("valid movie type returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumContentTypeMovie
+
+		// Act
+		result, err := enum.NewContentTypeEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid tv show type returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumContentTypeTvShow
+
+		// Act
+		result, err := enum.NewContentTypeEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("lowercase value returns error", func(t *testing.T) {
+		// Arrange
+		value := "movie"
+
+		// Act
+		result, err := enum.NewContentTypeEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidContentType)
+		require.Equal(t, enum.ContentTypeEnum{}, result)
+	})
+
+	t.Run("empty value returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		result, err := enum.NewContentTypeEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidContentType)
+		require.Equal(t, enum.ContentTypeEnum{}, result)
+	})
+}
@@ -0,0 +1,45 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	EnumTranscodingJobStatusPending   string = "Pending"
+	EnumTranscodingJobStatusRunning   string = "Running"
+	EnumTranscodingJobStatusCompleted string = "Completed"
+	EnumTranscodingJobStatusFailed    string = "Failed"
+)
+
+type TranscodingJobStatusEnum struct {
+	value string
+}
+
+func NewTranscodingJobStatusEnum(value string) (TranscodingJobStatusEnum, error) {
+	if err := validateTranscodingJobStatusEnum(value); err != nil {
+		return TranscodingJobStatusEnum{}, err
+	}
+
+	return TranscodingJobStatusEnum{value: value}, nil
+}
+
+func (e *TranscodingJobStatusEnum) String() string {
+	return e.value
+}
+
+func validateTranscodingJobStatusEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumTranscodingJobStatusPending:   {},
+		EnumTranscodingJobStatusRunning:   {},
+		EnumTranscodingJobStatusCompleted: {},
+		EnumTranscodingJobStatusFailed:    {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidTranscodingJobStatus, value)
+	}
+
+	return nil
+}
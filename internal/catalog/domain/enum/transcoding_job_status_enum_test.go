@@ -0,0 +1,72 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+func TestNewTranscodingJobStatusEnum(t *testing.T) {
+	t.Run("pending status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumTranscodingJobStatusPending
+
+		// Act
+		result, err := enum.NewTranscodingJobStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("running status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumTranscodingJobStatusRunning
+
+		// Act
+		result, err := enum.NewTranscodingJobStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("completed status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumTranscodingJobStatusCompleted
+
+		// Act
+		result, err := enum.NewTranscodingJobStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("failed status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumTranscodingJobStatusFailed
+
+		// Act
+		result, err := enum.NewTranscodingJobStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("unknown status returns error", func(t *testing.T) {
+		// Arrange
+		value := "Transcoding"
+
+		// Act
+		result, err := enum.NewTranscodingJobStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidTranscodingJobStatus)
+		require.Equal(t, enum.TranscodingJobStatusEnum{}, result)
+	})
+}
@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	EnumVideoUploadStatusPending   string = "Pending"
+	EnumVideoUploadStatusCompleted string = "Completed"
+	EnumVideoUploadStatusAborted   string = "Aborted"
+)
+
+type VideoUploadStatusEnum struct {
+	value string
+}
+
+func NewVideoUploadStatusEnum(value string) (VideoUploadStatusEnum, error) {
+	if err := validateVideoUploadStatusEnum(value); err != nil {
+		return VideoUploadStatusEnum{}, err
+	}
+
+	return VideoUploadStatusEnum{value: value}, nil
+}
+
+func (e *VideoUploadStatusEnum) String() string {
+	return e.value
+}
+
+func validateVideoUploadStatusEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumVideoUploadStatusPending:   {},
+		EnumVideoUploadStatusCompleted: {},
+		EnumVideoUploadStatusAborted:   {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidVideoUploadStatus, value)
+	}
+
+	return nil
+}
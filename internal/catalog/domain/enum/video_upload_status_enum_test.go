@@ -0,0 +1,60 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+func TestNewVideoUploadStatusEnum(t *testing.T) {
+	t.Run("pending status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumVideoUploadStatusPending
+
+		// Act
+		result, err := enum.NewVideoUploadStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("completed status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumVideoUploadStatusCompleted
+
+		// Act
+		result, err := enum.NewVideoUploadStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("aborted status returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumVideoUploadStatusAborted
+
+		// Act
+		result, err := enum.NewVideoUploadStatusEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("unknown status returns error", func(t *testing.T) {
+		// Arrange
+		value := "Uploading"
+
+		// Act
+		result, err := enum.NewVideoUploadStatusEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoUploadStatus)
+		require.Equal(t, enum.VideoUploadStatusEnum{}, result)
+	})
+}
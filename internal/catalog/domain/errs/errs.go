@@ -0,0 +1,85 @@
+package errs
+
+import "errors"
+
+var (
+	ErrMovieNotFound   = errors.New("movie not found")
+	ErrTvShowNotFound  = errors.New("tv show not found")
+	ErrSeasonNotFound  = errors.New("season not found")
+	ErrEpisodeNotFound = errors.New("episode not found")
+
+	ErrInvalidContentType = errors.New("invalid content type")
+
+	ErrTitleRequired          = errors.New("title is required")
+	ErrTitleTooLong           = errors.New("title cannot exceed 255 characters")
+	ErrTitleInvalidCharacters = errors.New("title contains invalid characters (only printable characters are allowed)")
+
+	ErrDescriptionRequired          = errors.New("description is required")
+	ErrDescriptionTooLong           = errors.New("description cannot exceed 5000 characters")
+	ErrDescriptionInvalidCharacters = errors.New(
+		"description contains invalid characters (only printable characters are allowed)",
+	)
+
+	ErrAgeRecommendationTooHigh = errors.New("age recommendation cannot exceed 21 years")
+	ErrExternalRatingOutOfRange = errors.New("external rating must be between 0 and 10")
+
+	ErrTvShowIDRequired      = errors.New("tv show ID is required")
+	ErrSeasonIDRequired      = errors.New("season ID is required")
+	ErrSeasonNumberRequired  = errors.New("season number must be at least 1")
+	ErrSeasonNumberTooHigh   = errors.New("season number cannot exceed 1000")
+	ErrEpisodeNumberRequired = errors.New("episode number must be at least 1")
+	ErrEpisodeNumberTooHigh  = errors.New("episode number cannot exceed 10000")
+	ErrSeasonAlreadyExists   = errors.New("a season with this number already exists for the tv show")
+	ErrEpisodeAlreadyExists  = errors.New("an episode with this number already exists for the season")
+
+	ErrPlaybackSessionNotFound      = errors.New("playback session not found")
+	ErrConcurrentStreamLimitReached = errors.New("concurrent stream limit reached for the current plan")
+	ErrUserIDRequired               = errors.New("user ID is required")
+	ErrVideoIDRequired              = errors.New("video ID is required")
+	ErrDeviceNameTooLong            = errors.New("device name cannot exceed 100 characters")
+	ErrPlaybackSessionIDRequired    = errors.New("playback session ID is required")
+	ErrPlaybackSessionVideoMismatch = errors.New("playback session is for another video")
+
+	ErrVideoNotFound                = errors.New("video not found")
+	ErrVideoFileNotFound            = errors.New("video file not found")
+	ErrVideoUploadNotFound          = errors.New("video upload not found")
+	ErrInvalidVideoUploadStatus     = errors.New("invalid video upload status")
+	ErrVideoMustBelongToOneContent  = errors.New("video must belong to either a movie or an episode")
+	ErrVideoObjectKeyRequired       = errors.New("video object key is required")
+	ErrInvalidVideoContentType      = errors.New("content type must be a video media type, e.g. video/mp4")
+	ErrInvalidVideoChecksum         = errors.New("checksum must be a hex encoded SHA-256 digest")
+	ErrVideoSizeOutOfRange          = errors.New("video size must be between 1 byte and 50 GiB")
+	ErrVideoUploadNotPending        = errors.New("video upload is already completed or aborted")
+	ErrVideoUploadOffsetMismatch    = errors.New("upload offset does not match the bytes received so far")
+	ErrVideoUploadChunkEmpty        = errors.New("upload chunk cannot be empty")
+	ErrVideoUploadChunkTooSmall     = errors.New("upload chunks must be at least 5 MiB, except for the last one")
+	ErrVideoUploadChunkTooLarge     = errors.New("upload chunks cannot exceed 64 MiB")
+	ErrVideoUploadChunkExceedsSize  = errors.New("upload chunk goes past the declared video size")
+	ErrVideoUploadChunkIncomplete   = errors.New("upload chunk is shorter than its Content-Length")
+	ErrVideoUploadTooManyChunks     = errors.New("video upload cannot have more than 10000 chunks")
+	ErrVideoUploadChecksumMismatch  = errors.New("uploaded video does not match the declared checksum, the upload was aborted")
+	ErrVideoUploadStorageIDRequired = errors.New("video upload storage ID is required")
+
+	ErrInvalidPlaybackSignature    = errors.New("invalid playback url signature")
+	ErrPlaybackURLExpired          = errors.New("playback url has expired")
+	ErrPlaybackURLLifetimeRequired = errors.New("playback url lifetime must be positive")
+	ErrInvalidClientIP             = errors.New("invalid client IP address")
+	ErrPlaybackSessionEnded        = errors.New("playback session has expired or was ended")
+
+	ErrTranscodingJobNotFound         = errors.New("transcoding job not found")
+	ErrInvalidTranscodingJobStatus    = errors.New("invalid transcoding job status")
+	ErrTranscodingJobNotPending       = errors.New("transcoding job is not pending")
+	ErrTranscodingJobNotRunning       = errors.New("transcoding job is not running")
+	ErrTranscodingJobClaimed          = errors.New("transcoding job was claimed by another worker")
+	ErrTranscodingJobAlreadyQueued    = errors.New("the video already has a pending or running transcoding job")
+	ErrVideoRenditionNotFound         = errors.New("video rendition not found")
+	ErrVideoSegmentNotFound           = errors.New("video segment not found")
+	ErrInvalidRenditionName           = errors.New("rendition name must be lowercase letters and digits, e.g. 720p")
+	ErrInvalidRenditionResolution     = errors.New("rendition width and height must be positive")
+	ErrRenditionBandwidthRequired     = errors.New("rendition bandwidth must be positive")
+	ErrRenditionCodecsRequired        = errors.New("rendition codecs are required")
+	ErrRenditionSegmentsRequired      = errors.New("rendition must have at least one segment")
+	ErrRenditionSegmentsOutOfSequence = errors.New("rendition segments must be numbered from 0 without gaps")
+	ErrInvalidSegmentDuration         = errors.New("segment duration must be positive")
+	ErrSegmentSizeRequired            = errors.New("segment size must be positive")
+)
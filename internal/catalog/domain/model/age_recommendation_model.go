@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	maxAgeRecommendation = 21
+)
+
+type AgeRecommendationModel struct {
+	value uint
+}
+
+func CreateAgeRecommendationModel(value uint) (AgeRecommendationModel, error) {
+	if err := validateAgeRecommendation(value); err != nil {
+		return AgeRecommendationModel{}, err
+	}
+	return AgeRecommendationModel{value: value}, nil
+}
+
+func (a *AgeRecommendationModel) Years() uint {
+	return a.value
+}
+
+func validateAgeRecommendation(value uint) error {
+	if value > maxAgeRecommendation {
+		return errs.ErrAgeRecommendationTooHigh
+	}
+
+	return nil
+}
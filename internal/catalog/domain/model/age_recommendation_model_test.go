@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateAgeRecommendationModel(t *testing.T) {
+	t.Run("zero years is valid", func(t *testing.T) {
+		// Act
+		result, err := model.CreateAgeRecommendationModel(0)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(0), result.Years())
+	})
+
+	t.Run("max years is valid", func(t *testing.T) {
+		// Act
+		result, err := model.CreateAgeRecommendationModel(21)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(21), result.Years())
+	})
+
+	t.Run("years above max returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateAgeRecommendationModel(22)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrAgeRecommendationTooHigh)
+	})
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+)
+
+// ContentModel holds the information shared by every kind of catalog entry.
+type ContentModel struct {
+	id                uint64
+	contentType       enum.ContentTypeEnum
+	title             TitleModel
+	description       DescriptionModel
+	ageRecommendation *AgeRecommendationModel
+	releaseDate       *time.Time
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+func CreateContentModel(
+	contentType, title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+) (ContentModel, error) {
+	contentTypeEnum, err := enum.NewContentTypeEnum(contentType)
+	if err != nil {
+		return ContentModel{}, err
+	}
+
+	details, err := newContentDetails(title, description, ageRecommendation)
+	if err != nil {
+		return ContentModel{}, err
+	}
+
+	return ContentModel{
+		contentType:       contentTypeEnum,
+		title:             details.title,
+		description:       details.description,
+		ageRecommendation: details.ageRecommendation,
+		releaseDate:       normalizeReleaseDate(releaseDate),
+		createdAt:         time.Now().UTC(),
+		updatedAt:         time.Now().UTC(),
+	}, nil
+}
+
+func RestoreContentModel(
+	id uint64,
+	contentType, title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+	createdAt, updatedAt time.Time,
+) (ContentModel, error) {
+	contentTypeEnum, err := enum.NewContentTypeEnum(contentType)
+	if err != nil {
+		return ContentModel{}, err
+	}
+
+	details, err := newContentDetails(title, description, ageRecommendation)
+	if err != nil {
+		return ContentModel{}, err
+	}
+
+	return ContentModel{
+		id:                id,
+		contentType:       contentTypeEnum,
+		title:             details.title,
+		description:       details.description,
+		ageRecommendation: details.ageRecommendation,
+		releaseDate:       normalizeReleaseDate(releaseDate),
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}, nil
+}
+
+func (c *ContentModel) ID() uint64 {
+	return c.id
+}
+
+func (c *ContentModel) Type() enum.ContentTypeEnum {
+	return c.contentType
+}
+
+func (c *ContentModel) Title() TitleModel {
+	return c.title
+}
+
+func (c *ContentModel) Description() DescriptionModel {
+	return c.description
+}
+
+func (c *ContentModel) AgeRecommendation() *AgeRecommendationModel {
+	return c.ageRecommendation
+}
+
+func (c *ContentModel) ReleaseDate() *time.Time {
+	return c.releaseDate
+}
+
+func (c *ContentModel) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *ContentModel) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *ContentModel) Update(
+	title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+) error {
+	details, err := newContentDetails(title, description, ageRecommendation)
+	if err != nil {
+		return err
+	}
+
+	c.title = details.title
+	c.description = details.description
+	c.ageRecommendation = details.ageRecommendation
+	c.releaseDate = normalizeReleaseDate(releaseDate)
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+type contentDetails struct {
+	title             TitleModel
+	description       DescriptionModel
+	ageRecommendation *AgeRecommendationModel
+}
+
+func newContentDetails(title, description string, ageRecommendation *uint) (contentDetails, error) {
+	titleModel, err := CreateTitleModel(title)
+	if err != nil {
+		return contentDetails{}, err
+	}
+
+	descriptionModel, err := CreateDescriptionModel(description)
+	if err != nil {
+		return contentDetails{}, err
+	}
+
+	var ageRecommendationModel *AgeRecommendationModel
+	if ageRecommendation != nil {
+		age, errAge := CreateAgeRecommendationModel(*ageRecommendation)
+		if errAge != nil {
+			return contentDetails{}, errAge
+		}
+		ageRecommendationModel = &age
+	}
+
+	return contentDetails{
+		title:             titleModel,
+		description:       descriptionModel,
+		ageRecommendation: ageRecommendationModel,
+	}, nil
+}
+
+// normalizeReleaseDate drops the time of day because release dates are stored as DATE.
+func normalizeReleaseDate(releaseDate *time.Time) *time.Time {
+	if releaseDate == nil || releaseDate.IsZero() {
+		return nil
+	}
+
+	utc := releaseDate.UTC()
+	date := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	maxDescriptionLength = 5000
+)
+
+type DescriptionModel struct {
+	value string
+}
+
+func CreateDescriptionModel(value string) (DescriptionModel, error) {
+	value = strings.TrimSpace(value)
+	if err := validateDescription(value); err != nil {
+		return DescriptionModel{}, err
+	}
+	return DescriptionModel{value: value}, nil
+}
+
+func (d *DescriptionModel) String() string {
+	return d.value
+}
+
+func validateDescription(value string) error {
+	charCount := utf8.RuneCountInString(value)
+
+	if charCount == 0 {
+		return errs.ErrDescriptionRequired
+	}
+
+	if charCount > maxDescriptionLength {
+		return errs.ErrDescriptionTooLong
+	}
+
+	if !lo.EveryBy([]rune(value), isValidDescriptionChar) {
+		return errs.ErrDescriptionInvalidCharacters
+	}
+
+	return nil
+}
+
+func isValidDescriptionChar(r rune) bool {
+	// Allow all printable characters, newlines, and tabs
+	return unicode.IsPrint(r) || r == '\n' || r == '\t'
+}
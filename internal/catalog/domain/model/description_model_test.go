@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateDescriptionModel(t *testing.T) {
+	t.Run("valid description returns model", func(t *testing.T) {
+		// Arrange
+		value := "A computer hacker learns about the true nature of reality."
+
+		// Act
+		result, err := model.CreateDescriptionModel(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("description with newlines and tabs is valid", func(t *testing.T) {
+		// Arrange
+		value := "First paragraph.\n\n\tSecond paragraph."
+
+		// Act
+		result, err := model.CreateDescriptionModel(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("empty description returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		_, err := model.CreateDescriptionModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDescriptionRequired)
+	})
+
+	t.Run("description exceeding max length returns error", func(t *testing.T) {
+		// Arrange
+		value := strings.Repeat("a", 5001)
+
+		// Act
+		_, err := model.CreateDescriptionModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDescriptionTooLong)
+	})
+
+	t.Run("description with control characters returns error", func(t *testing.T) {
+		// Arrange
+		value := "Invalid\x00description"
+
+		// Act
+		_, err := model.CreateDescriptionModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDescriptionInvalidCharacters)
+	})
+}
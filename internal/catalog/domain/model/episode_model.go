@@ -0,0 +1,134 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	minEpisodeNumber = 1
+	maxEpisodeNumber = 10000
+)
+
+type EpisodeModel struct {
+	id            uint64
+	seasonID      uint64
+	episodeNumber uint
+	title         TitleModel
+	description   DescriptionModel
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func CreateEpisodeModel(
+	seasonID uint64,
+	episodeNumber uint,
+	title, description string,
+) (EpisodeModel, error) {
+	if seasonID == 0 {
+		return EpisodeModel{}, errs.ErrSeasonIDRequired
+	}
+
+	if err := validateEpisodeNumber(episodeNumber); err != nil {
+		return EpisodeModel{}, err
+	}
+
+	titleModel, err := CreateTitleModel(title)
+	if err != nil {
+		return EpisodeModel{}, err
+	}
+
+	descriptionModel, err := CreateDescriptionModel(description)
+	if err != nil {
+		return EpisodeModel{}, err
+	}
+
+	return EpisodeModel{
+		seasonID:      seasonID,
+		episodeNumber: episodeNumber,
+		title:         titleModel,
+		description:   descriptionModel,
+		createdAt:     time.Now().UTC(),
+		updatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+func RestoreEpisodeModel(
+	id, seasonID uint64,
+	episodeNumber uint,
+	title, description string,
+	createdAt, updatedAt time.Time,
+) (EpisodeModel, error) {
+	episodeModel, err := CreateEpisodeModel(seasonID, episodeNumber, title, description)
+	if err != nil {
+		return EpisodeModel{}, err
+	}
+
+	episodeModel.id = id
+	episodeModel.createdAt = createdAt
+	episodeModel.updatedAt = updatedAt
+	return episodeModel, nil
+}
+
+func (e *EpisodeModel) ID() uint64 {
+	return e.id
+}
+
+func (e *EpisodeModel) SeasonID() uint64 {
+	return e.seasonID
+}
+
+func (e *EpisodeModel) EpisodeNumber() uint {
+	return e.episodeNumber
+}
+
+func (e *EpisodeModel) Title() TitleModel {
+	return e.title
+}
+
+func (e *EpisodeModel) Description() DescriptionModel {
+	return e.description
+}
+
+func (e *EpisodeModel) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e *EpisodeModel) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+func (e *EpisodeModel) Update(episodeNumber uint, title, description string) error {
+	if err := validateEpisodeNumber(episodeNumber); err != nil {
+		return err
+	}
+
+	titleModel, err := CreateTitleModel(title)
+	if err != nil {
+		return err
+	}
+
+	descriptionModel, err := CreateDescriptionModel(description)
+	if err != nil {
+		return err
+	}
+
+	e.episodeNumber = episodeNumber
+	e.title = titleModel
+	e.description = descriptionModel
+	e.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func validateEpisodeNumber(episodeNumber uint) error {
+	if episodeNumber < minEpisodeNumber {
+		return errs.ErrEpisodeNumberRequired
+	}
+
+	if episodeNumber > maxEpisodeNumber {
+		return errs.ErrEpisodeNumberTooHigh
+	}
+
+	return nil
+}
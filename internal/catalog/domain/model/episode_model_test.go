@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateEpisodeModel(t *testing.T) {
+	t.Run("valid episode returns model", func(t *testing.T) {
+		// Act
+		result, err := model.CreateEpisodeModel(4, 1, "Pilot", "Walter receives a diagnosis.")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), result.ID())
+		require.Equal(t, uint64(4), result.SeasonID())
+		require.Equal(t, uint(1), result.EpisodeNumber())
+		title := result.Title()
+		require.Equal(t, "Pilot", title.String())
+		description := result.Description()
+		require.Equal(t, "Walter receives a diagnosis.", description.String())
+	})
+
+	t.Run("missing season id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEpisodeModel(0, 1, "Pilot", "Walter receives a diagnosis.")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSeasonIDRequired)
+	})
+
+	t.Run("episode number zero returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEpisodeModel(4, 0, "Pilot", "Walter receives a diagnosis.")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrEpisodeNumberRequired)
+	})
+
+	t.Run("episode number above max returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEpisodeModel(4, 10001, "Pilot", "Walter receives a diagnosis.")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrEpisodeNumberTooHigh)
+	})
+
+	t.Run("empty title returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateEpisodeModel(4, 1, "", "Walter receives a diagnosis.")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleRequired)
+	})
+}
+
+func TestRestoreEpisodeModel(t *testing.T) {
+	t.Run("valid data returns model", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+
+		// Act
+		result, err := model.RestoreEpisodeModel(9, 4, 2, "Cat's in the Bag", "Aftermath.", now, now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(9), result.ID())
+		require.Equal(t, now, result.CreatedAt())
+		require.Equal(t, now, result.UpdatedAt())
+	})
+}
+
+func TestEpisodeModel_Update(t *testing.T) {
+	t.Run("valid data updates model", func(t *testing.T) {
+		// Arrange
+		episode, err := model.CreateEpisodeModel(4, 1, "Pilot", "Walter receives a diagnosis.")
+		require.NoError(t, err)
+
+		// Act
+		err = episode.Update(2, "Cat's in the Bag", "Aftermath.")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(2), episode.EpisodeNumber())
+		title := episode.Title()
+		require.Equal(t, "Cat's in the Bag", title.String())
+	})
+
+	t.Run("invalid description returns error", func(t *testing.T) {
+		// Arrange
+		episode, err := model.CreateEpisodeModel(4, 1, "Pilot", "Walter receives a diagnosis.")
+		require.NoError(t, err)
+
+		// Act
+		err = episode.Update(2, "Cat's in the Bag", "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDescriptionRequired)
+		require.Equal(t, uint(1), episode.EpisodeNumber())
+	})
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"math"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	minExternalRating = 0
+	maxExternalRating = 10
+)
+
+type ExternalRatingModel struct {
+	value float64
+}
+
+func CreateExternalRatingModel(value float64) (ExternalRatingModel, error) {
+	if err := validateExternalRating(value); err != nil {
+		return ExternalRatingModel{}, err
+	}
+	return ExternalRatingModel{value: value}, nil
+}
+
+func (e *ExternalRatingModel) Value() float64 {
+	return e.value
+}
+
+func validateExternalRating(value float64) error {
+	if math.IsNaN(value) || value < minExternalRating || value > maxExternalRating {
+		return errs.ErrExternalRatingOutOfRange
+	}
+
+	return nil
+}
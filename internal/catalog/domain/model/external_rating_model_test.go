@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateExternalRatingModel(t *testing.T) {
+	t.Run("rating within range returns model", func(t *testing.T) {
+		// Act
+		result, err := model.CreateExternalRatingModel(8.7)
+
+		// Assert
+		require.NoError(t, err)
+		require.InDelta(t, 8.7, result.Value(), 0.0001)
+	})
+
+	t.Run("range boundaries are valid", func(t *testing.T) {
+		// Act
+		minResult, minErr := model.CreateExternalRatingModel(0)
+		maxResult, maxErr := model.CreateExternalRatingModel(10)
+
+		// Assert
+		require.NoError(t, minErr)
+		require.NoError(t, maxErr)
+		require.InDelta(t, 0.0, minResult.Value(), 0.0001)
+		require.InDelta(t, 10.0, maxResult.Value(), 0.0001)
+	})
+
+	t.Run("negative rating returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateExternalRatingModel(-0.1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrExternalRatingOutOfRange)
+	})
+
+	t.Run("rating above max returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateExternalRatingModel(10.1)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrExternalRatingOutOfRange)
+	})
+
+	t.Run("NaN rating returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateExternalRatingModel(math.NaN())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrExternalRatingOutOfRange)
+	})
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+type MovieModel struct {
+	id             uint64
+	content        ContentModel
+	externalRating *ExternalRatingModel
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func CreateMovieModel(
+	title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+	externalRating *float64,
+) (MovieModel, error) {
+	contentModel, err := CreateContentModel(
+		enum.EnumContentTypeMovie,
+		title,
+		description,
+		ageRecommendation,
+		releaseDate,
+	)
+	if err != nil {
+		return MovieModel{}, err
+	}
+
+	externalRatingModel, err := newExternalRating(externalRating)
+	if err != nil {
+		return MovieModel{}, err
+	}
+
+	return MovieModel{
+		content:        contentModel,
+		externalRating: externalRatingModel,
+		createdAt:      time.Now().UTC(),
+		updatedAt:      time.Now().UTC(),
+	}, nil
+}
+
+func RestoreMovieModel(
+	id uint64,
+	content ContentModel,
+	externalRating *float64,
+	createdAt, updatedAt time.Time,
+) (MovieModel, error) {
+	contentType := content.Type()
+	if contentType.String() != enum.EnumContentTypeMovie {
+		return MovieModel{}, fmt.Errorf("%w: %s", errs.ErrInvalidContentType, contentType.String())
+	}
+
+	externalRatingModel, err := newExternalRating(externalRating)
+	if err != nil {
+		return MovieModel{}, err
+	}
+
+	return MovieModel{
+		id:             id,
+		content:        content,
+		externalRating: externalRatingModel,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
+
+func (m *MovieModel) ID() uint64 {
+	return m.id
+}
+
+func (m *MovieModel) Content() ContentModel {
+	return m.content
+}
+
+func (m *MovieModel) ExternalRating() *ExternalRatingModel {
+	return m.externalRating
+}
+
+func (m *MovieModel) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *MovieModel) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func (m *MovieModel) Update(
+	title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+	externalRating *float64,
+) error {
+	externalRatingModel, err := newExternalRating(externalRating)
+	if err != nil {
+		return err
+	}
+
+	err = m.content.Update(title, description, ageRecommendation, releaseDate)
+	if err != nil {
+		return err
+	}
+
+	m.externalRating = externalRatingModel
+	m.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func newExternalRating(externalRating *float64) (*ExternalRatingModel, error) {
+	if externalRating == nil {
+		return nil, nil //nolint:nilnil // a missing rating is a valid state
+	}
+
+	rating, err := CreateExternalRatingModel(*externalRating)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rating, nil
+}
@@ -0,0 +1,158 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateMovieModel(t *testing.T) {
+	t.Run("valid movie with all fields returns model", func(t *testing.T) {
+		// Arrange
+		ageRecommendation := uint(16)
+		releaseDate := time.Date(1999, time.March, 31, 18, 30, 0, 0, time.UTC)
+		externalRating := 8.7
+
+		// Act
+		result, err := model.CreateMovieModel(
+			"The Matrix",
+			"A computer hacker learns about the true nature of reality.",
+			&ageRecommendation,
+			&releaseDate,
+			&externalRating,
+		)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), result.ID())
+		content := result.Content()
+		contentType := content.Type()
+		require.Equal(t, enum.EnumContentTypeMovie, contentType.String())
+		title := content.Title()
+		require.Equal(t, "The Matrix", title.String())
+		require.NotNil(t, content.AgeRecommendation())
+		require.Equal(t, ageRecommendation, content.AgeRecommendation().Years())
+		require.NotNil(t, content.ReleaseDate())
+		require.Equal(t, time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC), *content.ReleaseDate())
+		require.NotNil(t, result.ExternalRating())
+		require.InDelta(t, externalRating, result.ExternalRating().Value(), 0.0001)
+		require.True(t, result.CreatedAt().After(time.Time{}))
+		require.True(t, result.UpdatedAt().After(time.Time{}))
+	})
+
+	t.Run("valid movie without optional fields returns model", func(t *testing.T) {
+		// Act
+		result, err := model.CreateMovieModel("Inception", "A thief steals secrets through dreams.", nil, nil, nil)
+
+		// Assert
+		require.NoError(t, err)
+		content := result.Content()
+		require.Nil(t, content.AgeRecommendation())
+		require.Nil(t, content.ReleaseDate())
+		require.Nil(t, result.ExternalRating())
+	})
+
+	t.Run("invalid external rating returns error", func(t *testing.T) {
+		// Arrange
+		externalRating := 11.0
+
+		// Act
+		_, err := model.CreateMovieModel("Inception", "A thief steals secrets.", nil, nil, &externalRating)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrExternalRatingOutOfRange)
+	})
+
+	t.Run("empty title returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateMovieModel("", "A thief steals secrets.", nil, nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleRequired)
+	})
+
+	t.Run("invalid age recommendation returns error", func(t *testing.T) {
+		// Arrange
+		ageRecommendation := uint(30)
+
+		// Act
+		_, err := model.CreateMovieModel("Inception", "A thief steals secrets.", &ageRecommendation, nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrAgeRecommendationTooHigh)
+	})
+}
+
+func TestRestoreMovieModel(t *testing.T) {
+	t.Run("content of type movie returns model", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+		content, err := model.RestoreContentModel(
+			10, enum.EnumContentTypeMovie, "The Matrix", "Neo wakes up.", nil, nil, now, now,
+		)
+		require.NoError(t, err)
+
+		// Act
+		result, err := model.RestoreMovieModel(5, content, nil, now, now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), result.ID())
+		restoredContent := result.Content()
+		require.Equal(t, uint64(10), restoredContent.ID())
+	})
+
+	t.Run("content of another type returns error", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+		content, err := model.RestoreContentModel(
+			10, enum.EnumContentTypeTvShow, "Breaking Bad", "A teacher turns to crime.", nil, nil, now, now,
+		)
+		require.NoError(t, err)
+
+		// Act
+		_, err = model.RestoreMovieModel(5, content, nil, now, now)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidContentType)
+	})
+}
+
+func TestMovieModel_Update(t *testing.T) {
+	t.Run("valid data updates model", func(t *testing.T) {
+		// Arrange
+		movie, err := model.CreateMovieModel("Old Title", "Old description.", nil, nil, nil)
+		require.NoError(t, err)
+		externalRating := 7.5
+
+		// Act
+		err = movie.Update("New Title", "New description.", nil, nil, &externalRating)
+
+		// Assert
+		require.NoError(t, err)
+		content := movie.Content()
+		title := content.Title()
+		require.Equal(t, "New Title", title.String())
+		require.InDelta(t, externalRating, movie.ExternalRating().Value(), 0.0001)
+	})
+
+	t.Run("invalid data keeps previous values", func(t *testing.T) {
+		// Arrange
+		movie, err := model.CreateMovieModel("Old Title", "Old description.", nil, nil, nil)
+		require.NoError(t, err)
+
+		// Act
+		err = movie.Update("", "New description.", nil, nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleRequired)
+		content := movie.Content()
+		description := content.Description()
+		require.Equal(t, "Old description.", description.String())
+	})
+}
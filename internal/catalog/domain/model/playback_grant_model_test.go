@@ -0,0 +1,162 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+const sessionID = "0b7c4a2e-5f0d-4d8e-9a3b-2c1f6e8d9a10"
+
+func TestCreatePlaybackGrantModel(t *testing.T) {
+	t.Run("valid grant expires after the lifetime", func(t *testing.T) {
+		// Arrange
+		before := time.Now().UTC()
+
+		// Act
+		grant, err := model.CreatePlaybackGrantModel(1, 2, sessionID, time.Hour, "")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), grant.UserID())
+		require.Equal(t, uint64(2), grant.VideoID())
+		require.Equal(t, sessionID, grant.SessionID())
+		require.False(t, grant.IsBoundToClientIP())
+		require.WithinDuration(t, before.Add(time.Hour), grant.ExpiresAt(), time.Second)
+		require.Zero(t, grant.ExpiresAt().Nanosecond())
+	})
+
+	t.Run("grant with client IP is bound to it", func(t *testing.T) {
+		// Act
+		grant, err := model.CreatePlaybackGrantModel(1, 2, sessionID, time.Hour, "203.0.113.7")
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, grant.IsBoundToClientIP())
+		require.Equal(t, "203.0.113.7", grant.ClientIP())
+	})
+
+	t.Run("non positive lifetime returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreatePlaybackGrantModel(1, 2, sessionID, 0, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrPlaybackURLLifetimeRequired)
+	})
+}
+
+func TestRestorePlaybackGrantModel(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("valid grant returns model", func(t *testing.T) {
+		// Act
+		grant, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, expiresAt, grant.ExpiresAt())
+	})
+
+	t.Run("missing user returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestorePlaybackGrantModel(0, 2, sessionID, expiresAt, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrUserIDRequired)
+	})
+
+	t.Run("missing video returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestorePlaybackGrantModel(1, 0, sessionID, expiresAt, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoIDRequired)
+	})
+
+	t.Run("missing session returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestorePlaybackGrantModel(1, 2, "", expiresAt, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrPlaybackSessionIDRequired)
+	})
+
+	t.Run("invalid client IP returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "not-an-ip")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidClientIP)
+	})
+
+	t.Run("IPv4-mapped IPv6 client IP is reduced to IPv4", func(t *testing.T) {
+		// Act
+		grant, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "::ffff:203.0.113.7")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "203.0.113.7", grant.ClientIP())
+	})
+}
+
+func TestPlaybackGrantModel_IsExpired(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	grant, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "")
+	require.NoError(t, err)
+
+	t.Run("before the expiry is not expired", func(t *testing.T) {
+		// Act
+		expired := grant.IsExpired(expiresAt.Add(-time.Second))
+
+		// Assert
+		require.False(t, expired)
+	})
+
+	t.Run("at the expiry is expired", func(t *testing.T) {
+		// Act
+		expired := grant.IsExpired(expiresAt)
+
+		// Assert
+		require.True(t, expired)
+	})
+}
+
+func TestPlaybackGrantModel_Payload(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("payload covers every field", func(t *testing.T) {
+		// Arrange
+		grant, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "203.0.113.7")
+		require.NoError(t, err)
+
+		// Act
+		payload := grant.Payload()
+
+		// Assert
+		require.Equal(t, "goflix-playback-v2\n1\n2\n"+sessionID+"\n1748772000\n203.0.113.7", string(payload))
+	})
+
+	t.Run("different grants have different payloads", func(t *testing.T) {
+		// Arrange
+		unbound, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt, "")
+		require.NoError(t, err)
+		otherVideo, err := model.RestorePlaybackGrantModel(1, 3, sessionID, expiresAt, "")
+		require.NoError(t, err)
+		later, err := model.RestorePlaybackGrantModel(1, 2, sessionID, expiresAt.Add(time.Second), "")
+		require.NoError(t, err)
+		otherSession, err := model.RestorePlaybackGrantModel(1, 2, "5e2d8f1a-7c3b-4a9e-8d6f-1b0c2a3e4f5d", expiresAt, "")
+		require.NoError(t, err)
+
+		// Act
+		payload := unbound.Payload()
+
+		// Assert
+		require.NotEqual(t, payload, otherVideo.Payload())
+		require.NotEqual(t, payload, later.Payload())
+		require.NotEqual(t, payload, otherSession.Payload())
+	})
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const maxDeviceNameLength = 100
+
+// PlaybackSessionModel is one stream being watched on one device. It stays alive as long
+// as the player keeps sending heartbeats.
+type PlaybackSessionModel struct {
+	id              string
+	userID          uint64
+	videoID         uint64
+	deviceName      string
+	startedAt       time.Time
+	lastHeartbeatAt time.Time
+}
+
+func CreatePlaybackSessionModel(userID, videoID uint64, deviceName string) (PlaybackSessionModel, error) {
+	deviceName = strings.TrimSpace(deviceName)
+	if err := validatePlaybackSession(userID, videoID, deviceName); err != nil {
+		return PlaybackSessionModel{}, err
+	}
+
+	now := time.Now().UTC()
+	return PlaybackSessionModel{
+		id:              uuid.NewString(),
+		userID:          userID,
+		videoID:         videoID,
+		deviceName:      deviceName,
+		startedAt:       now,
+		lastHeartbeatAt: now,
+	}, nil
+}
+
+func RestorePlaybackSessionModel(
+	id string,
+	userID, videoID uint64,
+	deviceName string,
+	startedAt, lastHeartbeatAt time.Time,
+) (PlaybackSessionModel, error) {
+	if err := validatePlaybackSession(userID, videoID, deviceName); err != nil {
+		return PlaybackSessionModel{}, err
+	}
+
+	return PlaybackSessionModel{
+		id:              id,
+		userID:          userID,
+		videoID:         videoID,
+		deviceName:      deviceName,
+		startedAt:       startedAt,
+		lastHeartbeatAt: lastHeartbeatAt,
+	}, nil
+}
+
+func (p *PlaybackSessionModel) ID() string {
+	return p.id
+}
+
+func (p *PlaybackSessionModel) UserID() uint64 {
+	return p.userID
+}
+
+func (p *PlaybackSessionModel) VideoID() uint64 {
+	return p.videoID
+}
+
+func (p *PlaybackSessionModel) DeviceName() string {
+	return p.deviceName
+}
+
+func (p *PlaybackSessionModel) StartedAt() time.Time {
+	return p.startedAt
+}
+
+func (p *PlaybackSessionModel) LastHeartbeatAt() time.Time {
+	return p.lastHeartbeatAt
+}
+
+// Heartbeat records that the player is still streaming.
+func (p *PlaybackSessionModel) Heartbeat(now time.Time) {
+	p.lastHeartbeatAt = now
+}
+
+func validatePlaybackSession(userID, videoID uint64, deviceName string) error {
+	if userID == 0 {
+		return errs.ErrUserIDRequired
+	}
+
+	if videoID == 0 {
+		return errs.ErrVideoIDRequired
+	}
+
+	if utf8.RuneCountInString(deviceName) > maxDeviceNameLength {
+		return errs.ErrDeviceNameTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreatePlaybackSessionModel(t *testing.T) {
+	t.Run("valid session returns model", func(t *testing.T) {
+		// Act
+		session, err := model.CreatePlaybackSessionModel(1, 2, "  Living room TV  ")
+
+		// Assert
+		require.NoError(t, err)
+		require.NotEmpty(t, session.ID())
+		require.Equal(t, uint64(1), session.UserID())
+		require.Equal(t, uint64(2), session.VideoID())
+		require.Equal(t, "Living room TV", session.DeviceName())
+		require.False(t, session.StartedAt().IsZero())
+		require.Equal(t, session.StartedAt(), session.LastHeartbeatAt())
+	})
+
+	t.Run("every session gets its own id", func(t *testing.T) {
+		// Act
+		first, err := model.CreatePlaybackSessionModel(1, 2, "")
+		require.NoError(t, err)
+		second, err := model.CreatePlaybackSessionModel(1, 2, "")
+		require.NoError(t, err)
+
+		// Assert
+		require.NotEqual(t, first.ID(), second.ID())
+	})
+
+	t.Run("missing user returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreatePlaybackSessionModel(0, 2, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrUserIDRequired)
+	})
+
+	t.Run("missing video returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreatePlaybackSessionModel(1, 0, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoIDRequired)
+	})
+
+	t.Run("too long device name returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreatePlaybackSessionModel(1, 2, strings.Repeat("a", 101))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDeviceNameTooLong)
+	})
+}
+
+func TestRestorePlaybackSessionModel(t *testing.T) {
+	t.Run("valid session returns model", func(t *testing.T) {
+		// Arrange
+		startedAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+		lastHeartbeatAt := startedAt.Add(time.Minute)
+
+		// Act
+		session, err := model.RestorePlaybackSessionModel("session-id", 1, 2, "Phone", startedAt, lastHeartbeatAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "session-id", session.ID())
+		require.Equal(t, "Phone", session.DeviceName())
+		require.Equal(t, startedAt, session.StartedAt())
+		require.Equal(t, lastHeartbeatAt, session.LastHeartbeatAt())
+	})
+}
+
+func TestPlaybackSessionModel_Heartbeat(t *testing.T) {
+	t.Run("records the heartbeat time", func(t *testing.T) {
+		// Arrange
+		session, err := model.CreatePlaybackSessionModel(1, 2, "")
+		require.NoError(t, err)
+		now := session.StartedAt().Add(30 * time.Second)
+
+		// Act
+		session.Heartbeat(now)
+
+		// Assert
+		require.Equal(t, now, session.LastHeartbeatAt())
+		require.NotEqual(t, now, session.StartedAt())
+	})
+}
@@ -0,0 +1,52 @@
+package model
+
+// renditionCodecs is H.264 High profile level 4.0 with AAC-LC audio, what the transcoder
+// encodes every rendition to. Level 4.0 covers up to 1080p at 30 frames per second.
+const renditionCodecs = "avc1.640028,mp4a.40.2"
+
+// RenditionProfileModel describes a rendition to encode: its resolution and the bit rates
+// the encoder aims for.
+type RenditionProfileModel struct {
+	name         string
+	width        uint
+	height       uint
+	videoBitrate uint
+	audioBitrate uint
+}
+
+// DefaultRenditionProfiles is the bit rate ladder videos are packaged with, from the highest
+// quality to the lowest.
+func DefaultRenditionProfiles() []RenditionProfileModel {
+	return []RenditionProfileModel{
+		{name: "1080p", width: 1920, height: 1080, videoBitrate: 5_000_000, audioBitrate: 192_000},
+		{name: "720p", width: 1280, height: 720, videoBitrate: 2_800_000, audioBitrate: 128_000},
+		{name: "480p", width: 854, height: 480, videoBitrate: 1_400_000, audioBitrate: 128_000},
+		{name: "360p", width: 640, height: 360, videoBitrate: 800_000, audioBitrate: 96_000},
+	}
+}
+
+func (p *RenditionProfileModel) Name() string {
+	return p.name
+}
+
+func (p *RenditionProfileModel) Width() uint {
+	return p.width
+}
+
+func (p *RenditionProfileModel) Height() uint {
+	return p.height
+}
+
+// VideoBitrate is the target bit rate of the video stream, in bits per second.
+func (p *RenditionProfileModel) VideoBitrate() uint {
+	return p.videoBitrate
+}
+
+// AudioBitrate is the target bit rate of the audio stream, in bits per second.
+func (p *RenditionProfileModel) AudioBitrate() uint {
+	return p.audioBitrate
+}
+
+func (p *RenditionProfileModel) Codecs() string {
+	return renditionCodecs
+}
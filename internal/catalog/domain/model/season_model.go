@@ -0,0 +1,144 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	minSeasonNumber = 1
+	maxSeasonNumber = 1000
+)
+
+type SeasonModel struct {
+	id           uint64
+	tvShowID     uint64
+	seasonNumber uint
+	title        *TitleModel
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func CreateSeasonModel(tvShowID uint64, seasonNumber uint, title string) (SeasonModel, error) {
+	if tvShowID == 0 {
+		return SeasonModel{}, errs.ErrTvShowIDRequired
+	}
+
+	if err := validateSeasonNumber(seasonNumber); err != nil {
+		return SeasonModel{}, err
+	}
+
+	titleModel, err := newOptionalTitle(title)
+	if err != nil {
+		return SeasonModel{}, err
+	}
+
+	return SeasonModel{
+		tvShowID:     tvShowID,
+		seasonNumber: seasonNumber,
+		title:        titleModel,
+		createdAt:    time.Now().UTC(),
+		updatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func RestoreSeasonModel(
+	id, tvShowID uint64,
+	seasonNumber uint,
+	title *string,
+	createdAt, updatedAt time.Time,
+) (SeasonModel, error) {
+	if tvShowID == 0 {
+		return SeasonModel{}, errs.ErrTvShowIDRequired
+	}
+
+	if err := validateSeasonNumber(seasonNumber); err != nil {
+		return SeasonModel{}, err
+	}
+
+	var titleValue string
+	if title != nil {
+		titleValue = *title
+	}
+
+	titleModel, err := newOptionalTitle(titleValue)
+	if err != nil {
+		return SeasonModel{}, err
+	}
+
+	return SeasonModel{
+		id:           id,
+		tvShowID:     tvShowID,
+		seasonNumber: seasonNumber,
+		title:        titleModel,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}, nil
+}
+
+func (s *SeasonModel) ID() uint64 {
+	return s.id
+}
+
+func (s *SeasonModel) TvShowID() uint64 {
+	return s.tvShowID
+}
+
+func (s *SeasonModel) SeasonNumber() uint {
+	return s.seasonNumber
+}
+
+func (s *SeasonModel) Title() *TitleModel {
+	return s.title
+}
+
+func (s *SeasonModel) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *SeasonModel) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+func (s *SeasonModel) Update(seasonNumber uint, title string) error {
+	if err := validateSeasonNumber(seasonNumber); err != nil {
+		return err
+	}
+
+	titleModel, err := newOptionalTitle(title)
+	if err != nil {
+		return err
+	}
+
+	s.seasonNumber = seasonNumber
+	s.title = titleModel
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func validateSeasonNumber(seasonNumber uint) error {
+	if seasonNumber < minSeasonNumber {
+		return errs.ErrSeasonNumberRequired
+	}
+
+	if seasonNumber > maxSeasonNumber {
+		return errs.ErrSeasonNumberTooHigh
+	}
+
+	return nil
+}
+
+func newOptionalTitle(title string) (*TitleModel, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, nil //nolint:nilnil // a missing title is a valid state
+	}
+
+	titleModel, err := CreateTitleModel(title)
+	if err != nil {
+		return nil, err
+	}
+
+	return &titleModel, nil
+}
@@ -0,0 +1,104 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateSeasonModel(t *testing.T) {
+	t.Run("valid season with title returns model", func(t *testing.T) {
+		// Act
+		result, err := model.CreateSeasonModel(1, 2, "The Second Season")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), result.TvShowID())
+		require.Equal(t, uint(2), result.SeasonNumber())
+		require.NotNil(t, result.Title())
+		require.Equal(t, "The Second Season", result.Title().String())
+	})
+
+	t.Run("blank title is stored as nil", func(t *testing.T) {
+		// Act
+		result, err := model.CreateSeasonModel(1, 1, "   ")
+
+		// Assert
+		require.NoError(t, err)
+		require.Nil(t, result.Title())
+	})
+
+	t.Run("missing tv show id returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateSeasonModel(0, 1, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTvShowIDRequired)
+	})
+
+	t.Run("season number zero returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateSeasonModel(1, 0, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSeasonNumberRequired)
+	})
+
+	t.Run("season number above max returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateSeasonModel(1, 1001, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSeasonNumberTooHigh)
+	})
+}
+
+func TestRestoreSeasonModel(t *testing.T) {
+	t.Run("valid data returns model", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+		title := "Pilot Season"
+
+		// Act
+		result, err := model.RestoreSeasonModel(7, 1, 1, &title, now, now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), result.ID())
+		require.Equal(t, title, result.Title().String())
+		require.Equal(t, now, result.CreatedAt())
+	})
+}
+
+func TestSeasonModel_Update(t *testing.T) {
+	t.Run("valid data updates model", func(t *testing.T) {
+		// Arrange
+		season, err := model.CreateSeasonModel(1, 1, "")
+		require.NoError(t, err)
+
+		// Act
+		err = season.Update(3, "Finale")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(3), season.SeasonNumber())
+		require.Equal(t, "Finale", season.Title().String())
+	})
+
+	t.Run("invalid season number returns error", func(t *testing.T) {
+		// Arrange
+		season, err := model.CreateSeasonModel(1, 1, "")
+		require.NoError(t, err)
+
+		// Act
+		err = season.Update(0, "Finale")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSeasonNumberRequired)
+		require.Equal(t, uint(1), season.SeasonNumber())
+	})
+}
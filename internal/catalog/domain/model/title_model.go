@@ -0,0 +1,50 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	maxTitleLength = 255
+)
+
+type TitleModel struct {
+	value string
+}
+
+func CreateTitleModel(value string) (TitleModel, error) {
+	value = strings.TrimSpace(value)
+	if err := validateTitle(value); err != nil {
+		return TitleModel{}, err
+	}
+	return TitleModel{value: value}, nil
+}
+
+func (t *TitleModel) String() string {
+	return t.value
+}
+
+func validateTitle(value string) error {
+	charCount := utf8.RuneCountInString(value)
+
+	if charCount == 0 {
+		return errs.ErrTitleRequired
+	}
+
+	if charCount > maxTitleLength {
+		return errs.ErrTitleTooLong
+	}
+
+	// Titles are single line, so only printable characters are accepted
+	if !lo.EveryBy([]rune(value), unicode.IsPrint) {
+		return errs.ErrTitleInvalidCharacters
+	}
+
+	return nil
+}
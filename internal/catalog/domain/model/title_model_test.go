@@ -0,0 +1,83 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateTitleModel(t *testing.T) {
+	t.Run("valid title returns model", func(t *testing.T) {
+		// Arrange
+		value := "The Matrix"
+
+		// Act
+		result, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("title with leading and trailing spaces gets trimmed", func(t *testing.T) {
+		// Arrange
+		value := "  Breaking Bad  "
+		expected := "Breaking Bad"
+
+		// Act
+		result, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, expected, result.String())
+	})
+
+	t.Run("title with unicode characters is valid", func(t *testing.T) {
+		// Arrange
+		value := "Amélie: Le Fabuleux Destin"
+
+		// Act
+		result, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("empty title returns error", func(t *testing.T) {
+		// Arrange
+		value := "   "
+
+		// Act
+		_, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleRequired)
+	})
+
+	t.Run("title exceeding max length returns error", func(t *testing.T) {
+		// Arrange
+		value := strings.Repeat("a", 256)
+
+		// Act
+		_, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleTooLong)
+	})
+
+	t.Run("title with newline returns error", func(t *testing.T) {
+		// Arrange
+		value := "The\nMatrix"
+
+		// Act
+		_, err := model.CreateTitleModel(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTitleInvalidCharacters)
+	})
+}
@@ -0,0 +1,220 @@
+package model
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const maxTranscodingJobErrorLength = 1000
+
+// TranscodingJobModel packages the file of a video into HLS renditions. A failed attempt
+// puts the job back in the queue until it runs out of attempts. Attempts are counted when
+// a worker claims the job, so they also tell apart two workers that claimed the same job.
+type TranscodingJobModel struct {
+	id         uint64
+	videoID    uint64
+	status     enum.TranscodingJobStatusEnum
+	sourceKey  string
+	attempts   uint
+	lastError  string
+	startedAt  *time.Time
+	finishedAt *time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// CreateTranscodingJobModel queues the packaging of the file stored under sourceKey.
+func CreateTranscodingJobModel(videoID uint64, sourceKey string) (TranscodingJobModel, error) {
+	if err := validateTranscodingJob(videoID, sourceKey); err != nil {
+		return TranscodingJobModel{}, err
+	}
+
+	statusEnum, err := enum.NewTranscodingJobStatusEnum(enum.EnumTranscodingJobStatusPending)
+	if err != nil {
+		return TranscodingJobModel{}, err
+	}
+
+	return TranscodingJobModel{
+		videoID:   videoID,
+		status:    statusEnum,
+		sourceKey: sourceKey,
+		createdAt: time.Now().UTC(),
+		updatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func RestoreTranscodingJobModel(
+	id, videoID uint64,
+	status, sourceKey string,
+	attempts uint,
+	lastError string,
+	startedAt, finishedAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (TranscodingJobModel, error) {
+	if err := validateTranscodingJob(videoID, sourceKey); err != nil {
+		return TranscodingJobModel{}, err
+	}
+
+	statusEnum, err := enum.NewTranscodingJobStatusEnum(status)
+	if err != nil {
+		return TranscodingJobModel{}, err
+	}
+
+	return TranscodingJobModel{
+		id:         id,
+		videoID:    videoID,
+		status:     statusEnum,
+		sourceKey:  sourceKey,
+		attempts:   attempts,
+		lastError:  lastError,
+		startedAt:  startedAt,
+		finishedAt: finishedAt,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+func (j *TranscodingJobModel) ID() uint64 {
+	return j.id
+}
+
+func (j *TranscodingJobModel) VideoID() uint64 {
+	return j.videoID
+}
+
+func (j *TranscodingJobModel) Status() enum.TranscodingJobStatusEnum {
+	return j.status
+}
+
+// SourceKey is the object key of the file that gets packaged.
+func (j *TranscodingJobModel) SourceKey() string {
+	return j.sourceKey
+}
+
+func (j *TranscodingJobModel) Attempts() uint {
+	return j.attempts
+}
+
+// LastError is why the last attempt failed, it is cleared when the job completes.
+func (j *TranscodingJobModel) LastError() string {
+	return j.lastError
+}
+
+func (j *TranscodingJobModel) StartedAt() *time.Time {
+	return j.startedAt
+}
+
+func (j *TranscodingJobModel) FinishedAt() *time.Time {
+	return j.finishedAt
+}
+
+func (j *TranscodingJobModel) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *TranscodingJobModel) UpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+// IsActive reports whether the job is still waiting for a worker or being worked on.
+func (j *TranscodingJobModel) IsActive() bool {
+	status := j.status.String()
+	return status == enum.EnumTranscodingJobStatusPending || status == enum.EnumTranscodingJobStatusRunning
+}
+
+// IsRunning reports whether a worker claimed the job. A running job whose worker died stays
+// running until it is claimed again.
+func (j *TranscodingJobModel) IsRunning() bool {
+	return j.status.String() == enum.EnumTranscodingJobStatusRunning
+}
+
+// OutputPrefix is where the current attempt stores its segments. Each attempt gets its own,
+// so the leftovers of an interrupted attempt never mix with the segments of the next one.
+func (j *TranscodingJobModel) OutputPrefix() string {
+	return fmt.Sprintf("videos/hls/%d/%d-%d", j.videoID, j.id, j.attempts)
+}
+
+// Claim hands the job to a worker. A running job can be claimed again when its worker is
+// presumed dead, the previous worker then finds out through the attempts when it saves.
+func (j *TranscodingJobModel) Claim(now time.Time) error {
+	if !j.IsActive() {
+		return errs.ErrTranscodingJobNotPending
+	}
+
+	statusEnum, err := enum.NewTranscodingJobStatusEnum(enum.EnumTranscodingJobStatusRunning)
+	if err != nil {
+		return err
+	}
+
+	j.status = statusEnum
+	j.attempts++
+	j.startedAt = &now
+	j.finishedAt = nil
+	j.updatedAt = now
+	return nil
+}
+
+func (j *TranscodingJobModel) Complete(now time.Time) error {
+	if !j.IsRunning() {
+		return errs.ErrTranscodingJobNotRunning
+	}
+
+	statusEnum, err := enum.NewTranscodingJobStatusEnum(enum.EnumTranscodingJobStatusCompleted)
+	if err != nil {
+		return err
+	}
+
+	j.status = statusEnum
+	j.lastError = ""
+	j.finishedAt = &now
+	j.updatedAt = now
+	return nil
+}
+
+// Fail records why the attempt failed and queues the job again, unless it already had
+// maxAttempts attempts, in which case it stays failed.
+func (j *TranscodingJobModel) Fail(reason string, now time.Time, maxAttempts uint) error {
+	if !j.IsRunning() {
+		return errs.ErrTranscodingJobNotRunning
+	}
+
+	status := enum.EnumTranscodingJobStatusPending
+	if j.attempts >= maxAttempts {
+		status = enum.EnumTranscodingJobStatusFailed
+		j.finishedAt = &now
+	}
+
+	statusEnum, err := enum.NewTranscodingJobStatusEnum(status)
+	if err != nil {
+		return err
+	}
+
+	j.status = statusEnum
+	j.lastError = truncateTranscodingJobError(reason)
+	j.updatedAt = now
+	return nil
+}
+
+func validateTranscodingJob(videoID uint64, sourceKey string) error {
+	if videoID == 0 {
+		return errs.ErrVideoIDRequired
+	}
+
+	if sourceKey == "" {
+		return errs.ErrVideoObjectKeyRequired
+	}
+
+	return nil
+}
+
+// truncateTranscodingJobError keeps the start of the error, ffmpeg failures can be very long.
+func truncateTranscodingJobError(reason string) string {
+	if utf8.RuneCountInString(reason) <= maxTranscodingJobErrorLength {
+		return reason
+	}
+	return string([]rune(reason)[:maxTranscodingJobErrorLength])
+}
@@ -0,0 +1,213 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateTranscodingJobModel(t *testing.T) {
+	t.Run("valid job is pending", func(t *testing.T) {
+		// Act
+		job, err := model.CreateTranscodingJobModel(1, "videos/movies/1/source")
+
+		// Assert
+		require.NoError(t, err)
+		status := job.Status()
+		require.Equal(t, enum.EnumTranscodingJobStatusPending, status.String())
+		require.Equal(t, uint64(1), job.VideoID())
+		require.Equal(t, "videos/movies/1/source", job.SourceKey())
+		require.Zero(t, job.Attempts())
+		require.True(t, job.IsActive())
+	})
+
+	t.Run("missing video returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateTranscodingJobModel(0, "videos/movies/1/source")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoIDRequired)
+	})
+
+	t.Run("missing source key returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateTranscodingJobModel(1, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoObjectKeyRequired)
+	})
+}
+
+func TestRestoreTranscodingJobModel(t *testing.T) {
+	t.Run("invalid status returns error", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+
+		// Act
+		_, err := model.RestoreTranscodingJobModel(1, 2, "Unknown", "key", 0, "", nil, nil, now, now)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidTranscodingJobStatus)
+	})
+}
+
+func TestTranscodingJobModel_Claim(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("pending job becomes running", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusPending, 0)
+
+		// Act
+		err := job.Claim(now)
+
+		// Assert
+		require.NoError(t, err)
+		status := job.Status()
+		require.Equal(t, enum.EnumTranscodingJobStatusRunning, status.String())
+		require.Equal(t, uint(1), job.Attempts())
+		require.Equal(t, now, *job.StartedAt())
+		require.True(t, job.IsRunning())
+	})
+
+	t.Run("running job can be claimed again", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusRunning, 1)
+
+		// Act
+		err := job.Claim(now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(2), job.Attempts())
+	})
+
+	t.Run("completed job returns error", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusCompleted, 1)
+
+		// Act
+		err := job.Claim(now)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTranscodingJobNotPending)
+	})
+
+	t.Run("every attempt gets its own output prefix", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusPending, 0)
+		require.NoError(t, job.Claim(now))
+		firstPrefix := job.OutputPrefix()
+
+		// Act
+		err := job.Claim(now)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "videos/hls/2/1-1", firstPrefix)
+		require.Equal(t, "videos/hls/2/1-2", job.OutputPrefix())
+	})
+}
+
+func TestTranscodingJobModel_Complete(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("running job completes and clears the last error", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusRunning, 2)
+
+		// Act
+		err := job.Complete(now)
+
+		// Assert
+		require.NoError(t, err)
+		status := job.Status()
+		require.Equal(t, enum.EnumTranscodingJobStatusCompleted, status.String())
+		require.Empty(t, job.LastError())
+		require.Equal(t, now, *job.FinishedAt())
+		require.False(t, job.IsActive())
+		require.False(t, job.IsRunning())
+	})
+
+	t.Run("pending job returns error", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusPending, 0)
+
+		// Act
+		err := job.Complete(now)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTranscodingJobNotRunning)
+	})
+}
+
+func TestTranscodingJobModel_Fail(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("job with attempts left is queued again", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusRunning, 1)
+
+		// Act
+		err := job.Fail("ffmpeg exited with status 1", now, 3)
+
+		// Assert
+		require.NoError(t, err)
+		status := job.Status()
+		require.Equal(t, enum.EnumTranscodingJobStatusPending, status.String())
+		require.Equal(t, "ffmpeg exited with status 1", job.LastError())
+		require.Nil(t, job.FinishedAt())
+	})
+
+	t.Run("job out of attempts fails", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusRunning, 3)
+
+		// Act
+		err := job.Fail("ffmpeg exited with status 1", now, 3)
+
+		// Assert
+		require.NoError(t, err)
+		status := job.Status()
+		require.Equal(t, enum.EnumTranscodingJobStatusFailed, status.String())
+		require.Equal(t, now, *job.FinishedAt())
+	})
+
+	t.Run("long error is truncated", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusRunning, 1)
+
+		// Act
+		err := job.Fail(strings.Repeat("a", 5000), now, 3)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, job.LastError(), 1000)
+	})
+
+	t.Run("pending job returns error", func(t *testing.T) {
+		// Arrange
+		job := newTranscodingJob(t, enum.EnumTranscodingJobStatusPending, 0)
+
+		// Act
+		err := job.Fail("error", now, 3)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrTranscodingJobNotRunning)
+	})
+}
+
+func newTranscodingJob(t *testing.T, status string, attempts uint) model.TranscodingJobModel {
+	t.Helper()
+
+	now := time.Now().UTC()
+	job, err := model.RestoreTranscodingJobModel(1, 2, status, "videos/movies/1/source", attempts, "", nil, nil, now, now)
+	require.NoError(t, err)
+	return job
+}
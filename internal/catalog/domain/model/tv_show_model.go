@@ -0,0 +1,87 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+type TvShowModel struct {
+	id        uint64
+	content   ContentModel
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func CreateTvShowModel(
+	title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+) (TvShowModel, error) {
+	contentModel, err := CreateContentModel(
+		enum.EnumContentTypeTvShow,
+		title,
+		description,
+		ageRecommendation,
+		releaseDate,
+	)
+	if err != nil {
+		return TvShowModel{}, err
+	}
+
+	return TvShowModel{
+		content:   contentModel,
+		createdAt: time.Now().UTC(),
+		updatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func RestoreTvShowModel(
+	id uint64,
+	content ContentModel,
+	createdAt, updatedAt time.Time,
+) (TvShowModel, error) {
+	contentType := content.Type()
+	if contentType.String() != enum.EnumContentTypeTvShow {
+		return TvShowModel{}, fmt.Errorf("%w: %s", errs.ErrInvalidContentType, contentType.String())
+	}
+
+	return TvShowModel{
+		id:        id,
+		content:   content,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+func (t *TvShowModel) ID() uint64 {
+	return t.id
+}
+
+func (t *TvShowModel) Content() ContentModel {
+	return t.content
+}
+
+func (t *TvShowModel) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *TvShowModel) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+func (t *TvShowModel) Update(
+	title, description string,
+	ageRecommendation *uint,
+	releaseDate *time.Time,
+) error {
+	err := t.content.Update(title, description, ageRecommendation, releaseDate)
+	if err != nil {
+		return err
+	}
+
+	t.updatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,56 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateTvShowModel(t *testing.T) {
+	t.Run("valid tv show returns model", func(t *testing.T) {
+		// Arrange
+		ageRecommendation := uint(18)
+
+		// Act
+		result, err := model.CreateTvShowModel("Breaking Bad", "A teacher turns to crime.", &ageRecommendation, nil)
+
+		// Assert
+		require.NoError(t, err)
+		content := result.Content()
+		contentType := content.Type()
+		require.Equal(t, enum.EnumContentTypeTvShow, contentType.String())
+		title := content.Title()
+		require.Equal(t, "Breaking Bad", title.String())
+		require.Equal(t, ageRecommendation, content.AgeRecommendation().Years())
+	})
+
+	t.Run("empty description returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateTvShowModel("Breaking Bad", "", nil, nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrDescriptionRequired)
+	})
+}
+
+func TestRestoreTvShowModel(t *testing.T) {
+	t.Run("content of another type returns error", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+		content, err := model.RestoreContentModel(
+			3, enum.EnumContentTypeMovie, "The Matrix", "Neo wakes up.", nil, nil, now, now,
+		)
+		require.NoError(t, err)
+
+		// Act
+		_, err = model.RestoreTvShowModel(1, content, now, now)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidContentType)
+	})
+}
@@ -0,0 +1,198 @@
+package model
+
+import (
+	"encoding/hex"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	bytesPerKB         = 1024
+	sha256ChecksumSize = 64
+)
+
+// VideoModel is the playable file of a movie or of an episode, never both. The url is
+// the key of the file in the video storage.
+type VideoModel struct {
+	id             uint64
+	movieID        *uint64
+	episodeID      *uint64
+	url            string
+	sizeInKB       uint64
+	duration       *uint
+	contentType    string
+	checksumSHA256 string
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func CreateVideoModel(
+	movieID, episodeID *uint64,
+	url string,
+	sizeInBytes uint64,
+	contentType, checksumSHA256 string,
+) (VideoModel, error) {
+	if err := validateVideoContent(movieID, episodeID); err != nil {
+		return VideoModel{}, err
+	}
+
+	videoModel := VideoModel{
+		movieID:   movieID,
+		episodeID: episodeID,
+		createdAt: time.Now().UTC(),
+	}
+
+	if err := videoModel.ReplaceFile(url, sizeInBytes, contentType, checksumSHA256); err != nil {
+		return VideoModel{}, err
+	}
+
+	return videoModel, nil
+}
+
+// RestoreVideoModel does not validate the content type and checksum, videos created
+// before uploads existed have neither.
+func RestoreVideoModel(
+	id uint64,
+	movieID, episodeID *uint64,
+	url string,
+	sizeInKB uint64,
+	duration *uint,
+	contentType, checksumSHA256 string,
+	createdAt, updatedAt time.Time,
+) (VideoModel, error) {
+	if err := validateVideoContent(movieID, episodeID); err != nil {
+		return VideoModel{}, err
+	}
+
+	if url == "" {
+		return VideoModel{}, errs.ErrVideoObjectKeyRequired
+	}
+
+	return VideoModel{
+		id:             id,
+		movieID:        movieID,
+		episodeID:      episodeID,
+		url:            url,
+		sizeInKB:       sizeInKB,
+		duration:       duration,
+		contentType:    contentType,
+		checksumSHA256: checksumSHA256,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
+
+func (v *VideoModel) ID() uint64 {
+	return v.id
+}
+
+func (v *VideoModel) MovieID() *uint64 {
+	return v.movieID
+}
+
+func (v *VideoModel) EpisodeID() *uint64 {
+	return v.episodeID
+}
+
+func (v *VideoModel) URL() string {
+	return v.url
+}
+
+func (v *VideoModel) SizeInKB() uint64 {
+	return v.sizeInKB
+}
+
+func (v *VideoModel) Duration() *uint {
+	return v.duration
+}
+
+func (v *VideoModel) ContentType() string {
+	return v.contentType
+}
+
+func (v *VideoModel) ChecksumSHA256() string {
+	return v.checksumSHA256
+}
+
+func (v *VideoModel) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+func (v *VideoModel) UpdatedAt() time.Time {
+	return v.updatedAt
+}
+
+// ReplaceFile points the video to a newly uploaded file. The size is rounded up to the next KB.
+func (v *VideoModel) ReplaceFile(url string, sizeInBytes uint64, contentType, checksumSHA256 string) error {
+	if url == "" {
+		return errs.ErrVideoObjectKeyRequired
+	}
+
+	if err := validateVideoSize(sizeInBytes); err != nil {
+		return err
+	}
+
+	contentType, err := normalizeVideoContentType(contentType)
+	if err != nil {
+		return err
+	}
+
+	checksumSHA256, err = normalizeVideoChecksum(checksumSHA256)
+	if err != nil {
+		return err
+	}
+
+	v.url = url
+	v.sizeInKB = (sizeInBytes + bytesPerKB - 1) / bytesPerKB
+	v.contentType = contentType
+	v.checksumSHA256 = checksumSHA256
+	v.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func validateVideoContent(movieID, episodeID *uint64) error {
+	hasMovie := movieID != nil
+	hasEpisode := episodeID != nil
+	if hasMovie == hasEpisode {
+		return errs.ErrVideoMustBelongToOneContent
+	}
+
+	if (hasMovie && *movieID == 0) || (hasEpisode && *episodeID == 0) {
+		return errs.ErrVideoMustBelongToOneContent
+	}
+
+	return nil
+}
+
+func validateVideoSize(sizeInBytes uint64) error {
+	if sizeInBytes == 0 || sizeInBytes > maxVideoSizeInBytes {
+		return errs.ErrVideoSizeOutOfRange
+	}
+
+	return nil
+}
+
+func normalizeVideoContentType(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "video/") {
+		return "", errs.ErrInvalidVideoContentType
+	}
+
+	return mediaType, nil
+}
+
+func normalizeVideoChecksum(checksumSHA256 string) (string, error) {
+	checksumSHA256 = strings.ToLower(strings.TrimSpace(checksumSHA256))
+	if len(checksumSHA256) != sha256ChecksumSize {
+		return "", errs.ErrInvalidVideoChecksum
+	}
+
+	if _, err := hex.DecodeString(checksumSHA256); err != nil {
+		return "", errs.ErrInvalidVideoChecksum
+	}
+
+	return checksumSHA256, nil
+}
@@ -0,0 +1,180 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+const validChecksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestCreateVideoModel(t *testing.T) {
+	t.Run("movie video returns model with size rounded up to the next KB", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		video, err := model.CreateVideoModel(&movieID, nil, "videos/movies/7/a", 2049, "video/mp4", validChecksum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, &movieID, video.MovieID())
+		require.Nil(t, video.EpisodeID())
+		require.Equal(t, "videos/movies/7/a", video.URL())
+		require.Equal(t, uint64(3), video.SizeInKB())
+		require.Equal(t, "video/mp4", video.ContentType())
+		require.Equal(t, validChecksum, video.ChecksumSHA256())
+	})
+
+	t.Run("exact KB size is not rounded up", func(t *testing.T) {
+		// Arrange
+		episodeID := uint64(3)
+
+		// Act
+		video, err := model.CreateVideoModel(nil, &episodeID, "videos/episodes/3/a", 2048, "video/mp4", validChecksum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), video.SizeInKB())
+	})
+
+	t.Run("content type parameters and checksum case are normalized", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		video, err := model.CreateVideoModel(
+			&movieID,
+			nil,
+			"videos/movies/7/a",
+			1,
+			"Video/MP4; codecs=avc1",
+			strings.ToUpper(validChecksum),
+		)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "video/mp4", video.ContentType())
+		require.Equal(t, validChecksum, video.ChecksumSHA256())
+	})
+
+	t.Run("movie and episode together return error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		episodeID := uint64(3)
+
+		// Act
+		_, err := model.CreateVideoModel(&movieID, &episodeID, "videos/a", 1, "video/mp4", validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoMustBelongToOneContent)
+	})
+
+	t.Run("neither movie nor episode returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoModel(nil, nil, "videos/a", 1, "video/mp4", validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoMustBelongToOneContent)
+	})
+
+	t.Run("non video content type returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.CreateVideoModel(&movieID, nil, "videos/a", 1, "image/png", validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoContentType)
+	})
+
+	t.Run("invalid checksum returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.CreateVideoModel(&movieID, nil, "videos/a", 1, "video/mp4", strings.Repeat("z", 64))
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoChecksum)
+	})
+
+	t.Run("empty video returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.CreateVideoModel(&movieID, nil, "videos/a", 0, "video/mp4", validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoSizeOutOfRange)
+	})
+}
+
+func TestRestoreVideoModel(t *testing.T) {
+	t.Run("video without content type and checksum returns model", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+		// Act
+		video, err := model.RestoreVideoModel(1, &movieID, nil, "https://cdn/a.mp4", 10, nil, "", "", createdAt, createdAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), video.ID())
+		require.Equal(t, uint64(10), video.SizeInKB())
+		require.Equal(t, createdAt, video.CreatedAt())
+	})
+
+	t.Run("missing url returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.RestoreVideoModel(1, &movieID, nil, "", 10, nil, "", "", time.Now(), time.Now())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoObjectKeyRequired)
+	})
+}
+
+func TestVideoModel_ReplaceFile(t *testing.T) {
+	t.Run("replacing the file updates url, size and checksum", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		video, err := model.CreateVideoModel(&movieID, nil, "videos/movies/7/a", 1, "video/mp4", validChecksum)
+		require.NoError(t, err)
+		newChecksum := strings.Repeat("a", 64)
+
+		// Act
+		err = video.ReplaceFile("videos/movies/7/b", 1024*1024, "video/webm", newChecksum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "videos/movies/7/b", video.URL())
+		require.Equal(t, uint64(1024), video.SizeInKB())
+		require.Equal(t, "video/webm", video.ContentType())
+		require.Equal(t, newChecksum, video.ChecksumSHA256())
+	})
+
+	t.Run("missing url returns error and keeps the current file", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		video, err := model.CreateVideoModel(&movieID, nil, "videos/movies/7/a", 1, "video/mp4", validChecksum)
+		require.NoError(t, err)
+
+		// Act
+		err = video.ReplaceFile("", 1, "video/mp4", validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoObjectKeyRequired)
+		require.Equal(t, "videos/movies/7/a", video.URL())
+	})
+}
@@ -0,0 +1,196 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const maxRenditionNameLength = 16
+
+// VideoRenditionModel is one quality of a video packaged for HLS: a resolution with its own
+// media playlist and segments. The bandwidths are measured on the segments, as HLS expects,
+// rather than taken from the encoder settings.
+type VideoRenditionModel struct {
+	id               uint64
+	videoID          uint64
+	name             string
+	width            uint
+	height           uint
+	codecs           string
+	bandwidth        uint64
+	averageBandwidth uint64
+	segments         []VideoSegmentModel
+	createdAt        time.Time
+}
+
+func CreateVideoRenditionModel(
+	videoID uint64,
+	name string,
+	width, height uint,
+	codecs string,
+	segments []VideoSegmentModel,
+) (VideoRenditionModel, error) {
+	if err := validateVideoRendition(videoID, name, width, height, codecs); err != nil {
+		return VideoRenditionModel{}, err
+	}
+
+	if len(segments) == 0 {
+		return VideoRenditionModel{}, errs.ErrRenditionSegmentsRequired
+	}
+
+	for i, segment := range segments {
+		if segment.Sequence() != uint(i) {
+			return VideoRenditionModel{}, errs.ErrRenditionSegmentsOutOfSequence
+		}
+	}
+
+	bandwidth, averageBandwidth := measureBandwidth(segments)
+	return VideoRenditionModel{
+		videoID:          videoID,
+		name:             name,
+		width:            width,
+		height:           height,
+		codecs:           codecs,
+		bandwidth:        bandwidth,
+		averageBandwidth: averageBandwidth,
+		segments:         segments,
+		createdAt:        time.Now().UTC(),
+	}, nil
+}
+
+// RestoreVideoRenditionModel restores a rendition with or without its segments, the master
+// playlist only needs the rendition itself.
+func RestoreVideoRenditionModel(
+	id, videoID uint64,
+	name string,
+	width, height uint,
+	codecs string,
+	bandwidth, averageBandwidth uint64,
+	segments []VideoSegmentModel,
+	createdAt time.Time,
+) (VideoRenditionModel, error) {
+	if err := validateVideoRendition(videoID, name, width, height, codecs); err != nil {
+		return VideoRenditionModel{}, err
+	}
+
+	if bandwidth == 0 || averageBandwidth == 0 {
+		return VideoRenditionModel{}, errs.ErrRenditionBandwidthRequired
+	}
+
+	return VideoRenditionModel{
+		id:               id,
+		videoID:          videoID,
+		name:             name,
+		width:            width,
+		height:           height,
+		codecs:           codecs,
+		bandwidth:        bandwidth,
+		averageBandwidth: averageBandwidth,
+		segments:         segments,
+		createdAt:        createdAt,
+	}, nil
+}
+
+func (r *VideoRenditionModel) ID() uint64 {
+	return r.id
+}
+
+func (r *VideoRenditionModel) VideoID() uint64 {
+	return r.videoID
+}
+
+// Name identifies the rendition within its video, e.g. 720p.
+func (r *VideoRenditionModel) Name() string {
+	return r.name
+}
+
+func (r *VideoRenditionModel) Width() uint {
+	return r.width
+}
+
+func (r *VideoRenditionModel) Height() uint {
+	return r.height
+}
+
+// Codecs is the RFC 6381 codecs string of the rendition, e.g. avc1.640028,mp4a.40.2.
+func (r *VideoRenditionModel) Codecs() string {
+	return r.codecs
+}
+
+// Bandwidth is the peak bit rate of the rendition, in bits per second.
+func (r *VideoRenditionModel) Bandwidth() uint64 {
+	return r.bandwidth
+}
+
+// AverageBandwidth is the bit rate over the whole rendition, in bits per second.
+func (r *VideoRenditionModel) AverageBandwidth() uint64 {
+	return r.averageBandwidth
+}
+
+func (r *VideoRenditionModel) Segments() []VideoSegmentModel {
+	return r.segments
+}
+
+func (r *VideoRenditionModel) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// TargetDuration is the duration of the longest segment.
+func (r *VideoRenditionModel) TargetDuration() time.Duration {
+	var targetDuration time.Duration
+	for _, segment := range r.segments {
+		targetDuration = max(targetDuration, segment.Duration())
+	}
+	return targetDuration
+}
+
+func validateVideoRendition(videoID uint64, name string, width, height uint, codecs string) error {
+	if videoID == 0 {
+		return errs.ErrVideoIDRequired
+	}
+
+	if !isValidRenditionName(name) {
+		return errs.ErrInvalidRenditionName
+	}
+
+	if width == 0 || height == 0 {
+		return errs.ErrInvalidRenditionResolution
+	}
+
+	if codecs == "" {
+		return errs.ErrRenditionCodecsRequired
+	}
+
+	return nil
+}
+
+// isValidRenditionName only accepts names that can go in a URL path and an object key as is.
+func isValidRenditionName(name string) bool {
+	if name == "" || len(name) > maxRenditionNameLength {
+		return false
+	}
+
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// measureBandwidth returns the peak and the average bit rates of the segments, rounded up.
+func measureBandwidth(segments []VideoSegmentModel) (uint64, uint64) {
+	var peak, totalBits, totalSeconds float64
+	for _, segment := range segments {
+		bits := float64(segment.SizeInBytes()) * 8
+		seconds := segment.Duration().Seconds()
+		peak = max(peak, bits/seconds)
+		totalBits += bits
+		totalSeconds += seconds
+	}
+
+	return uint64(math.Ceil(peak)), uint64(math.Ceil(totalBits / totalSeconds))
+}
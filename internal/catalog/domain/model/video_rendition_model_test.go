@@ -0,0 +1,175 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+func TestCreateVideoSegmentModel(t *testing.T) {
+	t.Run("valid segment returns model", func(t *testing.T) {
+		// Act
+		segment, err := model.CreateVideoSegmentModel(0, 6*time.Second, "videos/hls/1/1-1/720p/0.ts", 1024)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint(0), segment.Sequence())
+		require.Equal(t, 6*time.Second, segment.Duration())
+		require.Equal(t, uint64(1024), segment.SizeInBytes())
+	})
+
+	t.Run("zero duration returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoSegmentModel(0, 0, "key", 1024)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidSegmentDuration)
+	})
+
+	t.Run("missing object key returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoSegmentModel(0, time.Second, "", 1024)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoObjectKeyRequired)
+	})
+
+	t.Run("empty segment returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoSegmentModel(0, time.Second, "key", 0)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrSegmentSizeRequired)
+	})
+}
+
+func TestCreateVideoRenditionModel(t *testing.T) {
+	t.Run("valid rendition measures its bandwidth", func(t *testing.T) {
+		// Arrange
+		segments := []model.VideoSegmentModel{
+			newVideoSegment(t, 0, 2*time.Second, 500_000),
+			newVideoSegment(t, 1, 2*time.Second, 250_000),
+		}
+
+		// Act
+		rendition, err := model.CreateVideoRenditionModel(1, "720p", 1280, 720, "avc1.640028,mp4a.40.2", segments)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "720p", rendition.Name())
+		require.Equal(t, uint64(2_000_000), rendition.Bandwidth())
+		require.Equal(t, uint64(1_500_000), rendition.AverageBandwidth())
+		require.Equal(t, 2*time.Second, rendition.TargetDuration())
+		require.Len(t, rendition.Segments(), 2)
+	})
+
+	t.Run("target duration is the longest segment", func(t *testing.T) {
+		// Arrange
+		segments := []model.VideoSegmentModel{
+			newVideoSegment(t, 0, 6*time.Second, 1024),
+			newVideoSegment(t, 1, 6500*time.Millisecond, 1024),
+			newVideoSegment(t, 2, time.Second, 1024),
+		}
+
+		// Act
+		rendition, err := model.CreateVideoRenditionModel(1, "720p", 1280, 720, "avc1.640028", segments)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 6500*time.Millisecond, rendition.TargetDuration())
+	})
+
+	t.Run("missing video returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoRenditionModel(0, "720p", 1280, 720, "avc1.640028", nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoIDRequired)
+	})
+
+	t.Run("name that cannot go in a path returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoRenditionModel(1, "../720p", 1280, 720, "avc1.640028", nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRenditionName)
+	})
+
+	t.Run("missing resolution returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoRenditionModel(1, "720p", 0, 720, "avc1.640028", nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRenditionResolution)
+	})
+
+	t.Run("missing codecs returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoRenditionModel(1, "720p", 1280, 720, "", nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrRenditionCodecsRequired)
+	})
+
+	t.Run("no segments returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateVideoRenditionModel(1, "720p", 1280, 720, "avc1.640028", nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrRenditionSegmentsRequired)
+	})
+
+	t.Run("gap in the segments returns error", func(t *testing.T) {
+		// Arrange
+		segments := []model.VideoSegmentModel{
+			newVideoSegment(t, 0, time.Second, 1024),
+			newVideoSegment(t, 2, time.Second, 1024),
+		}
+
+		// Act
+		_, err := model.CreateVideoRenditionModel(1, "720p", 1280, 720, "avc1.640028", segments)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrRenditionSegmentsOutOfSequence)
+	})
+}
+
+func TestRestoreVideoRenditionModel(t *testing.T) {
+	t.Run("rendition without segments returns model", func(t *testing.T) {
+		// Arrange
+		createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+		// Act
+		rendition, err := model.RestoreVideoRenditionModel(
+			3, 1, "720p", 1280, 720, "avc1.640028", 2_000_000, 1_500_000, nil, createdAt,
+		)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), rendition.ID())
+		require.Equal(t, uint64(2_000_000), rendition.Bandwidth())
+		require.Equal(t, uint64(1_500_000), rendition.AverageBandwidth())
+		require.Empty(t, rendition.Segments())
+		require.Equal(t, createdAt, rendition.CreatedAt())
+	})
+
+	t.Run("missing bandwidth returns error", func(t *testing.T) {
+		// Act
+		_, err := model.RestoreVideoRenditionModel(3, 1, "720p", 1280, 720, "avc1.640028", 0, 0, nil, time.Now())
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrRenditionBandwidthRequired)
+	})
+}
+
+func newVideoSegment(t *testing.T, sequence uint, duration time.Duration, sizeInBytes uint64) model.VideoSegmentModel {
+	t.Helper()
+
+	segment, err := model.CreateVideoSegmentModel(sequence, duration, "videos/hls/1/1-1/720p/segment.ts", sizeInBytes)
+	require.NoError(t, err)
+	return segment
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+// VideoSegmentModel is one HLS media segment of a rendition. Segments are numbered from 0
+// in playback order.
+type VideoSegmentModel struct {
+	sequence    uint
+	duration    time.Duration
+	objectKey   string
+	sizeInBytes uint64
+}
+
+func CreateVideoSegmentModel(
+	sequence uint,
+	duration time.Duration,
+	objectKey string,
+	sizeInBytes uint64,
+) (VideoSegmentModel, error) {
+	if duration <= 0 {
+		return VideoSegmentModel{}, errs.ErrInvalidSegmentDuration
+	}
+
+	if objectKey == "" {
+		return VideoSegmentModel{}, errs.ErrVideoObjectKeyRequired
+	}
+
+	if sizeInBytes == 0 {
+		return VideoSegmentModel{}, errs.ErrSegmentSizeRequired
+	}
+
+	return VideoSegmentModel{
+		sequence:    sequence,
+		duration:    duration,
+		objectKey:   objectKey,
+		sizeInBytes: sizeInBytes,
+	}, nil
+}
+
+func RestoreVideoSegmentModel(
+	sequence uint,
+	duration time.Duration,
+	objectKey string,
+	sizeInBytes uint64,
+) (VideoSegmentModel, error) {
+	return CreateVideoSegmentModel(sequence, duration, objectKey, sizeInBytes)
+}
+
+func (s *VideoSegmentModel) Sequence() uint {
+	return s.sequence
+}
+
+func (s *VideoSegmentModel) Duration() time.Duration {
+	return s.duration
+}
+
+func (s *VideoSegmentModel) ObjectKey() string {
+	return s.objectKey
+}
+
+func (s *VideoSegmentModel) SizeInBytes() uint64 {
+	return s.sizeInBytes
+}
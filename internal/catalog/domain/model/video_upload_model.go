@@ -0,0 +1,312 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+)
+
+const (
+	// Chunks become parts of an S3 multipart upload, so they follow its limits
+	minVideoUploadChunkSize = 5 * 1024 * 1024
+	maxVideoUploadChunkSize = 64 * 1024 * 1024
+	maxVideoUploadChunks    = 10000
+	maxVideoSizeInBytes     = 50 * 1024 * 1024 * 1024
+)
+
+// VideoUploadModel tracks a resumable upload of the video of a movie or of an episode.
+// Chunks are sent in order and the upload can be resumed from ReceivedBytes after a failure.
+// The hash state is the SHA-256 of the bytes received so far, so the checksum of the whole
+// file can be verified without reading it back from the storage.
+type VideoUploadModel struct {
+	id               string
+	movieID          *uint64
+	episodeID        *uint64
+	objectKey        string
+	storageUploadID  string
+	contentType      string
+	totalSizeInBytes uint64
+	receivedBytes    uint64
+	parts            []VideoUploadPartModel
+	checksumSHA256   string
+	hashState        []byte
+	status           enum.VideoUploadStatusEnum
+	videoID          *uint64
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+// CreateVideoUploadModel assigns the upload id and the object key, the storage upload has to be
+// started with them before the upload is saved.
+func CreateVideoUploadModel(
+	movieID, episodeID *uint64,
+	contentType string,
+	totalSizeInBytes uint64,
+	checksumSHA256 string,
+) (VideoUploadModel, error) {
+	if err := validateVideoContent(movieID, episodeID); err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	if err := validateVideoSize(totalSizeInBytes); err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	contentType, err := normalizeVideoContentType(contentType)
+	if err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	checksumSHA256, err = normalizeVideoChecksum(checksumSHA256)
+	if err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	statusEnum, err := enum.NewVideoUploadStatusEnum(enum.EnumVideoUploadStatusPending)
+	if err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	id := uuid.NewString()
+	var objectKey string
+	if movieID != nil {
+		objectKey = "videos/movies/" + strconv.FormatUint(*movieID, 10) + "/" + id
+	} else {
+		objectKey = "videos/episodes/" + strconv.FormatUint(*episodeID, 10) + "/" + id
+	}
+
+	return VideoUploadModel{
+		id:               id,
+		movieID:          movieID,
+		episodeID:        episodeID,
+		objectKey:        objectKey,
+		contentType:      contentType,
+		totalSizeInBytes: totalSizeInBytes,
+		parts:            []VideoUploadPartModel{},
+		checksumSHA256:   checksumSHA256,
+		status:           statusEnum,
+		createdAt:        time.Now().UTC(),
+		updatedAt:        time.Now().UTC(),
+	}, nil
+}
+
+func RestoreVideoUploadModel(
+	id string,
+	movieID, episodeID *uint64,
+	objectKey, storageUploadID, contentType string,
+	totalSizeInBytes, receivedBytes uint64,
+	parts []VideoUploadPartModel,
+	checksumSHA256 string,
+	hashState []byte,
+	status string,
+	videoID *uint64,
+	createdAt, updatedAt time.Time,
+) (VideoUploadModel, error) {
+	if err := validateVideoContent(movieID, episodeID); err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	if objectKey == "" {
+		return VideoUploadModel{}, errs.ErrVideoObjectKeyRequired
+	}
+
+	statusEnum, err := enum.NewVideoUploadStatusEnum(status)
+	if err != nil {
+		return VideoUploadModel{}, err
+	}
+
+	return VideoUploadModel{
+		id:               id,
+		movieID:          movieID,
+		episodeID:        episodeID,
+		objectKey:        objectKey,
+		storageUploadID:  storageUploadID,
+		contentType:      contentType,
+		totalSizeInBytes: totalSizeInBytes,
+		receivedBytes:    receivedBytes,
+		parts:            parts,
+		checksumSHA256:   checksumSHA256,
+		hashState:        hashState,
+		status:           statusEnum,
+		videoID:          videoID,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+func (u *VideoUploadModel) ID() string {
+	return u.id
+}
+
+func (u *VideoUploadModel) MovieID() *uint64 {
+	return u.movieID
+}
+
+func (u *VideoUploadModel) EpisodeID() *uint64 {
+	return u.episodeID
+}
+
+func (u *VideoUploadModel) ObjectKey() string {
+	return u.objectKey
+}
+
+// StorageUploadID is the id of the multipart upload on the video storage side.
+func (u *VideoUploadModel) StorageUploadID() string {
+	return u.storageUploadID
+}
+
+func (u *VideoUploadModel) ContentType() string {
+	return u.contentType
+}
+
+func (u *VideoUploadModel) TotalSizeInBytes() uint64 {
+	return u.totalSizeInBytes
+}
+
+func (u *VideoUploadModel) ReceivedBytes() uint64 {
+	return u.receivedBytes
+}
+
+func (u *VideoUploadModel) Parts() []VideoUploadPartModel {
+	return u.parts
+}
+
+func (u *VideoUploadModel) ChecksumSHA256() string {
+	return u.checksumSHA256
+}
+
+func (u *VideoUploadModel) HashState() []byte {
+	return u.hashState
+}
+
+func (u *VideoUploadModel) Status() enum.VideoUploadStatusEnum {
+	return u.status
+}
+
+func (u *VideoUploadModel) VideoID() *uint64 {
+	return u.videoID
+}
+
+func (u *VideoUploadModel) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+func (u *VideoUploadModel) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+func (u *VideoUploadModel) IsPending() bool {
+	return u.status.String() == enum.EnumVideoUploadStatusPending
+}
+
+// IsFullyReceived reports whether every byte of the video has been received.
+func (u *VideoUploadModel) IsFullyReceived() bool {
+	return u.receivedBytes == u.totalSizeInBytes
+}
+
+// NextPartNumber is the number of the part the next chunk is stored as.
+func (u *VideoUploadModel) NextPartNumber() int {
+	return len(u.parts) + 1
+}
+
+func (u *VideoUploadModel) StartStorageUpload(storageUploadID string) error {
+	if storageUploadID == "" {
+		return errs.ErrVideoUploadStorageIDRequired
+	}
+
+	u.storageUploadID = storageUploadID
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// ValidateChunk checks that a chunk of sizeInBytes starting at offset can be appended, before
+// it is sent to the storage.
+func (u *VideoUploadModel) ValidateChunk(offset, sizeInBytes uint64) error {
+	if !u.IsPending() {
+		return errs.ErrVideoUploadNotPending
+	}
+
+	if offset != u.receivedBytes {
+		return errs.ErrVideoUploadOffsetMismatch
+	}
+
+	if sizeInBytes == 0 {
+		return errs.ErrVideoUploadChunkEmpty
+	}
+
+	if sizeInBytes > maxVideoUploadChunkSize {
+		return errs.ErrVideoUploadChunkTooLarge
+	}
+
+	if sizeInBytes > u.totalSizeInBytes-u.receivedBytes {
+		return errs.ErrVideoUploadChunkExceedsSize
+	}
+
+	isLastChunk := u.receivedBytes+sizeInBytes == u.totalSizeInBytes
+	if sizeInBytes < minVideoUploadChunkSize && !isLastChunk {
+		return errs.ErrVideoUploadChunkTooSmall
+	}
+
+	if len(u.parts) >= maxVideoUploadChunks {
+		return errs.ErrVideoUploadTooManyChunks
+	}
+
+	return nil
+}
+
+// AddPart records a chunk stored by the video storage together with the hash state that
+// includes its bytes.
+func (u *VideoUploadModel) AddPart(part VideoUploadPartModel, hashState []byte) error {
+	if err := u.ValidateChunk(u.receivedBytes, part.SizeInBytes()); err != nil {
+		return err
+	}
+
+	u.parts = append(u.parts, part)
+	u.receivedBytes += part.SizeInBytes()
+	u.hashState = hashState
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Complete links the upload to the video it created or replaced. The hash state is not
+// needed anymore once the checksum has been verified.
+func (u *VideoUploadModel) Complete(videoID uint64) error {
+	if !u.IsPending() {
+		return errs.ErrVideoUploadNotPending
+	}
+
+	if videoID == 0 {
+		return errs.ErrVideoIDRequired
+	}
+
+	statusEnum, err := enum.NewVideoUploadStatusEnum(enum.EnumVideoUploadStatusCompleted)
+	if err != nil {
+		return err
+	}
+
+	u.status = statusEnum
+	u.videoID = &videoID
+	u.hashState = nil
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func (u *VideoUploadModel) Abort() error {
+	if !u.IsPending() {
+		return errs.ErrVideoUploadNotPending
+	}
+
+	statusEnum, err := enum.NewVideoUploadStatusEnum(enum.EnumVideoUploadStatusAborted)
+	if err != nil {
+		return err
+	}
+
+	u.status = statusEnum
+	u.hashState = nil
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
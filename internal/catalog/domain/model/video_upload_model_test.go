@@ -0,0 +1,317 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+const mebibyte = 1024 * 1024
+
+func newVideoUpload(t *testing.T, totalSizeInBytes uint64) model.VideoUploadModel {
+	t.Helper()
+
+	movieID := uint64(7)
+	upload, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", totalSizeInBytes, validChecksum)
+	require.NoError(t, err)
+	require.NoError(t, upload.StartStorageUpload("storage-upload-id"))
+	return upload
+}
+
+func TestCreateVideoUploadModel(t *testing.T) {
+	t.Run("movie upload returns pending model", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		upload, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", 10*mebibyte, validChecksum)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotEmpty(t, upload.ID())
+		require.Equal(t, "videos/movies/7/"+upload.ID(), upload.ObjectKey())
+		require.Equal(t, uint64(10*mebibyte), upload.TotalSizeInBytes())
+		require.Equal(t, uint64(0), upload.ReceivedBytes())
+		require.Empty(t, upload.Parts())
+		require.True(t, upload.IsPending())
+		require.Equal(t, 1, upload.NextPartNumber())
+	})
+
+	t.Run("episode upload gets an episode object key", func(t *testing.T) {
+		// Arrange
+		episodeID := uint64(3)
+
+		// Act
+		upload, err := model.CreateVideoUploadModel(nil, &episodeID, "video/mp4", 1, validChecksum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "videos/episodes/3/"+upload.ID(), upload.ObjectKey())
+	})
+
+	t.Run("movie and episode together return error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		episodeID := uint64(3)
+
+		// Act
+		_, err := model.CreateVideoUploadModel(&movieID, &episodeID, "video/mp4", 1, validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoMustBelongToOneContent)
+	})
+
+	t.Run("zero movie id returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(0)
+
+		// Act
+		_, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", 1, validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoMustBelongToOneContent)
+	})
+
+	t.Run("too large video returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", 51*1024*mebibyte, validChecksum)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoSizeOutOfRange)
+	})
+
+	t.Run("missing checksum returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", 1, "")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoChecksum)
+	})
+}
+
+func TestVideoUploadModel_ValidateChunk(t *testing.T) {
+	t.Run("first full size chunk is valid", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 20*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(0, 5*mebibyte)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("small last chunk is valid", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 100)
+
+		// Act
+		err := upload.ValidateChunk(0, 100)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong offset returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 20*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(5*mebibyte, 5*mebibyte)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadOffsetMismatch)
+	})
+
+	t.Run("empty chunk returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 20*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(0, 0)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadChunkEmpty)
+	})
+
+	t.Run("small chunk that is not the last one returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 20*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(0, mebibyte)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadChunkTooSmall)
+	})
+
+	t.Run("too large chunk returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 100*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(0, 65*mebibyte)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadChunkTooLarge)
+	})
+
+	t.Run("chunk past the declared size returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 6*mebibyte)
+
+		// Act
+		err := upload.ValidateChunk(0, 7*mebibyte)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadChunkExceedsSize)
+	})
+
+	t.Run("aborted upload returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 20*mebibyte)
+		require.NoError(t, upload.Abort())
+
+		// Act
+		err := upload.ValidateChunk(0, 5*mebibyte)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadNotPending)
+	})
+}
+
+func TestVideoUploadModel_AddPart(t *testing.T) {
+	t.Run("parts advance the received bytes until the upload is fully received", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 6*mebibyte)
+
+		// Act
+		errFirst := upload.AddPart(model.CreateVideoUploadPartModel(1, "etag-1", 5*mebibyte), []byte("state-1"))
+		errSecond := upload.AddPart(model.CreateVideoUploadPartModel(2, "etag-2", mebibyte), []byte("state-2"))
+
+		// Assert
+		require.NoError(t, errFirst)
+		require.NoError(t, errSecond)
+		require.Equal(t, uint64(6*mebibyte), upload.ReceivedBytes())
+		require.Len(t, upload.Parts(), 2)
+		require.Equal(t, []byte("state-2"), upload.HashState())
+		require.True(t, upload.IsFullyReceived())
+		require.Equal(t, 3, upload.NextPartNumber())
+	})
+
+	t.Run("part past the declared size returns error", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, mebibyte)
+
+		// Act
+		err := upload.AddPart(model.CreateVideoUploadPartModel(1, "etag-1", 2*mebibyte), nil)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadChunkExceedsSize)
+		require.Equal(t, uint64(0), upload.ReceivedBytes())
+	})
+}
+
+func TestVideoUploadModel_Complete(t *testing.T) {
+	t.Run("pending upload is completed and linked to the video", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 100)
+		require.NoError(t, upload.AddPart(model.CreateVideoUploadPartModel(1, "etag-1", 100), []byte("state")))
+
+		// Act
+		err := upload.Complete(9)
+
+		// Assert
+		require.NoError(t, err)
+		status := upload.Status()
+		require.Equal(t, enum.EnumVideoUploadStatusCompleted, status.String())
+		require.Equal(t, uint64(9), *upload.VideoID())
+		require.Nil(t, upload.HashState())
+	})
+
+	t.Run("completed upload cannot be aborted", func(t *testing.T) {
+		// Arrange
+		upload := newVideoUpload(t, 100)
+		require.NoError(t, upload.Complete(9))
+
+		// Act
+		err := upload.Abort()
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrVideoUploadNotPending)
+	})
+}
+
+func TestRestoreVideoUploadModel(t *testing.T) {
+	t.Run("valid upload returns model", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+		createdAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+		parts := []model.VideoUploadPartModel{model.CreateVideoUploadPartModel(1, "etag-1", 5*mebibyte)}
+
+		// Act
+		upload, err := model.RestoreVideoUploadModel(
+			"upload-id",
+			&movieID,
+			nil,
+			"videos/movies/7/upload-id",
+			"storage-upload-id",
+			"video/mp4",
+			10*mebibyte,
+			5*mebibyte,
+			parts,
+			validChecksum,
+			[]byte("state"),
+			enum.EnumVideoUploadStatusPending,
+			nil,
+			createdAt,
+			createdAt,
+		)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "upload-id", upload.ID())
+		require.Equal(t, "storage-upload-id", upload.StorageUploadID())
+		require.Equal(t, uint64(5*mebibyte), upload.ReceivedBytes())
+		require.Equal(t, 2, upload.NextPartNumber())
+	})
+
+	t.Run("invalid status returns error", func(t *testing.T) {
+		// Arrange
+		movieID := uint64(7)
+
+		// Act
+		_, err := model.RestoreVideoUploadModel(
+			"upload-id",
+			&movieID,
+			nil,
+			"videos/movies/7/upload-id",
+			"storage-upload-id",
+			"video/mp4",
+			10,
+			0,
+			nil,
+			strings.Repeat("a", 64),
+			nil,
+			"Unknown",
+			nil,
+			time.Now(),
+			time.Now(),
+		)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidVideoUploadStatus)
+	})
+}
@@ -0,0 +1,25 @@
+package model
+
+// VideoUploadPartModel is one chunk of a video upload as stored by the video storage.
+// Parts are numbered from 1 in upload order.
+type VideoUploadPartModel struct {
+	number      int
+	etag        string
+	sizeInBytes uint64
+}
+
+func CreateVideoUploadPartModel(number int, etag string, sizeInBytes uint64) VideoUploadPartModel {
+	return VideoUploadPartModel{number: number, etag: etag, sizeInBytes: sizeInBytes}
+}
+
+func (p *VideoUploadPartModel) Number() int {
+	return p.number
+}
+
+func (p *VideoUploadPartModel) ETag() string {
+	return p.etag
+}
+
+func (p *VideoUploadPartModel) SizeInBytes() uint64 {
+	return p.sizeInBytes
+}
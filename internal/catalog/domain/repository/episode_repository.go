@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type EpisodeRepository interface {
+	Create(ctx context.Context, episode model.EpisodeModel) (model.EpisodeModel, error)
+	Update(ctx context.Context, episode model.EpisodeModel) error
+	Delete(ctx context.Context, id uint64) error
+	FindByID(ctx context.Context, id uint64) (model.EpisodeModel, error)
+	FindBySeasonIDs(ctx context.Context, seasonIDs []uint64) ([]model.EpisodeModel, error)
+	FindBySeasonIDAndNumber(ctx context.Context, seasonID uint64, episodeNumber uint) (model.EpisodeModel, error)
+}
@@ -0,0 +1,312 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockVideoRepository is an autogenerated mock type for the VideoRepository type
+type MockVideoRepository struct {
+	mock.Mock
+}
+
+type MockVideoRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVideoRepository) EXPECT() *MockVideoRepository_Expecter {
+	return &MockVideoRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, video
+func (_m *MockVideoRepository) Create(ctx context.Context, video model.VideoModel) (model.VideoModel, error) {
+	ret := _m.Called(ctx, video)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.VideoModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.VideoModel) (model.VideoModel, error)); ok {
+		return rf(ctx, video)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.VideoModel) model.VideoModel); ok {
+		r0 = rf(ctx, video)
+	} else {
+		r0 = ret.Get(0).(model.VideoModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.VideoModel) error); ok {
+		r1 = rf(ctx, video)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVideoRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVideoRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - video model.VideoModel
+func (_e *MockVideoRepository_Expecter) Create(ctx interface{}, video interface{}) *MockVideoRepository_Create_Call {
+	return &MockVideoRepository_Create_Call{Call: _e.mock.On("Create", ctx, video)}
+}
+
+func (_c *MockVideoRepository_Create_Call) Run(run func(ctx context.Context, video model.VideoModel)) *MockVideoRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.VideoModel))
+	})
+	return _c
+}
+
+func (_c *MockVideoRepository_Create_Call) Return(_a0 model.VideoModel, _a1 error) *MockVideoRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVideoRepository_Create_Call) RunAndReturn(run func(context.Context, model.VideoModel) (model.VideoModel, error)) *MockVideoRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByEpisodeID provides a mock function with given fields: ctx, episodeID
+func (_m *MockVideoRepository) FindByEpisodeID(ctx context.Context, episodeID uint64) (model.VideoModel, error) {
+	ret := _m.Called(ctx, episodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByEpisodeID")
+	}
+
+	var r0 model.VideoModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.VideoModel, error)); ok {
+		return rf(ctx, episodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.VideoModel); ok {
+		r0 = rf(ctx, episodeID)
+	} else {
+		r0 = ret.Get(0).(model.VideoModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, episodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVideoRepository_FindByEpisodeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEpisodeID'
+type MockVideoRepository_FindByEpisodeID_Call struct {
+	*mock.Call
+}
+
+// FindByEpisodeID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - episodeID uint64
+func (_e *MockVideoRepository_Expecter) FindByEpisodeID(ctx interface{}, episodeID interface{}) *MockVideoRepository_FindByEpisodeID_Call {
+	return &MockVideoRepository_FindByEpisodeID_Call{Call: _e.mock.On("FindByEpisodeID", ctx, episodeID)}
+}
+
+func (_c *MockVideoRepository_FindByEpisodeID_Call) Run(run func(ctx context.Context, episodeID uint64)) *MockVideoRepository_FindByEpisodeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByEpisodeID_Call) Return(_a0 model.VideoModel, _a1 error) *MockVideoRepository_FindByEpisodeID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByEpisodeID_Call) RunAndReturn(run func(context.Context, uint64) (model.VideoModel, error)) *MockVideoRepository_FindByEpisodeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockVideoRepository) FindByID(ctx context.Context, id uint64) (model.VideoModel, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 model.VideoModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.VideoModel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.VideoModel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.VideoModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVideoRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockVideoRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockVideoRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockVideoRepository_FindByID_Call {
+	return &MockVideoRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockVideoRepository_FindByID_Call) Run(run func(ctx context.Context, id uint64)) *MockVideoRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByID_Call) Return(_a0 model.VideoModel, _a1 error) *MockVideoRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint64) (model.VideoModel, error)) *MockVideoRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMovieID provides a mock function with given fields: ctx, movieID
+func (_m *MockVideoRepository) FindByMovieID(ctx context.Context, movieID uint64) (model.VideoModel, error) {
+	ret := _m.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMovieID")
+	}
+
+	var r0 model.VideoModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.VideoModel, error)); ok {
+		return rf(ctx, movieID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.VideoModel); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		r0 = ret.Get(0).(model.VideoModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVideoRepository_FindByMovieID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMovieID'
+type MockVideoRepository_FindByMovieID_Call struct {
+	*mock.Call
+}
+
+// FindByMovieID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movieID uint64
+func (_e *MockVideoRepository_Expecter) FindByMovieID(ctx interface{}, movieID interface{}) *MockVideoRepository_FindByMovieID_Call {
+	return &MockVideoRepository_FindByMovieID_Call{Call: _e.mock.On("FindByMovieID", ctx, movieID)}
+}
+
+func (_c *MockVideoRepository_FindByMovieID_Call) Run(run func(ctx context.Context, movieID uint64)) *MockVideoRepository_FindByMovieID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByMovieID_Call) Return(_a0 model.VideoModel, _a1 error) *MockVideoRepository_FindByMovieID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVideoRepository_FindByMovieID_Call) RunAndReturn(run func(context.Context, uint64) (model.VideoModel, error)) *MockVideoRepository_FindByMovieID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, video
+func (_m *MockVideoRepository) Update(ctx context.Context, video model.VideoModel) error {
+	ret := _m.Called(ctx, video)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.VideoModel) error); ok {
+		r0 = rf(ctx, video)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVideoRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockVideoRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - video model.VideoModel
+func (_e *MockVideoRepository_Expecter) Update(ctx interface{}, video interface{}) *MockVideoRepository_Update_Call {
+	return &MockVideoRepository_Update_Call{Call: _e.mock.On("Update", ctx, video)}
+}
+
+func (_c *MockVideoRepository_Update_Call) Run(run func(ctx context.Context, video model.VideoModel)) *MockVideoRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.VideoModel))
+	})
+	return _c
+}
+
+func (_c *MockVideoRepository_Update_Call) Return(_a0 error) *MockVideoRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVideoRepository_Update_Call) RunAndReturn(run func(context.Context, model.VideoModel) error) *MockVideoRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVideoRepository creates a new instance of MockVideoRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVideoRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVideoRepository {
+	mock := &MockVideoRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
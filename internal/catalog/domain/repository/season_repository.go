@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season model.SeasonModel) (model.SeasonModel, error)
+	Update(ctx context.Context, season model.SeasonModel) error
+	Delete(ctx context.Context, id uint64) error
+	FindByID(ctx context.Context, id uint64) (model.SeasonModel, error)
+	FindByTvShowID(ctx context.Context, tvShowID uint64) ([]model.SeasonModel, error)
+	FindByTvShowIDAndNumber(ctx context.Context, tvShowID uint64, seasonNumber uint) (model.SeasonModel, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type TranscodingJobRepository interface {
+	Create(ctx context.Context, job model.TranscodingJobModel) (model.TranscodingJobModel, error)
+	// Update saves the job only when its attempts still match expectedAttempts, i.e. when no
+	// other worker claimed it in the meantime. It fails with errs.ErrTranscodingJobClaimed otherwise.
+	Update(ctx context.Context, job model.TranscodingJobModel, expectedAttempts uint) error
+	FindByID(ctx context.Context, id uint64) (model.TranscodingJobModel, error)
+	// FindStale returns the pending and running jobs not updated since staleBefore, oldest first.
+	// Their queue message or their worker is presumed lost.
+	FindStale(ctx context.Context, staleBefore time.Time) ([]model.TranscodingJobModel, error)
+	FindLatestByVideoID(ctx context.Context, videoID uint64) (model.TranscodingJobModel, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type TvShowRepository interface {
+	Create(ctx context.Context, tvShow model.TvShowModel) (model.TvShowModel, error)
+	Update(ctx context.Context, tvShow model.TvShowModel) error
+	Delete(ctx context.Context, id uint64) error
+	FindByID(ctx context.Context, id uint64) (model.TvShowModel, error)
+	FindAll(ctx context.Context) ([]model.TvShowModel, error)
+}
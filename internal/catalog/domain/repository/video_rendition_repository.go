@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type VideoRenditionRepository interface {
+	// ReplaceByVideoID swaps the renditions of the video for the given ones at once and returns
+	// the removed ones with their segments, so that their files can be deleted.
+	ReplaceByVideoID(
+		ctx context.Context,
+		videoID uint64,
+		renditions []model.VideoRenditionModel,
+	) ([]model.VideoRenditionModel, error)
+	// FindByVideoID returns the renditions of the video without their segments, from the highest
+	// resolution to the lowest.
+	FindByVideoID(ctx context.Context, videoID uint64) ([]model.VideoRenditionModel, error)
+	// FindByVideoIDAndName returns the rendition with its segments.
+	FindByVideoIDAndName(ctx context.Context, videoID uint64, name string) (model.VideoRenditionModel, error)
+	FindSegment(ctx context.Context, videoID uint64, renditionName string, sequence uint) (model.VideoSegmentModel, error)
+}
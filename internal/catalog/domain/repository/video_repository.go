@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type VideoRepository interface {
+	Create(ctx context.Context, video model.VideoModel) (model.VideoModel, error)
+	Update(ctx context.Context, video model.VideoModel) error
+	FindByID(ctx context.Context, id uint64) (model.VideoModel, error)
+	FindByMovieID(ctx context.Context, movieID uint64) (model.VideoModel, error)
+	FindByEpisodeID(ctx context.Context, episodeID uint64) (model.VideoModel, error)
+}
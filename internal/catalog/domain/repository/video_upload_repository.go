@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type VideoUploadRepository interface {
+	Create(ctx context.Context, upload model.VideoUploadModel) error
+	// Update saves a pending upload only when no other request changed it since it was read
+	// with previousReceivedBytes. It fails with errs.ErrVideoUploadOffsetMismatch when another
+	// chunk was recorded first and errs.ErrVideoUploadNotPending when it was completed or aborted.
+	Update(ctx context.Context, upload model.VideoUploadModel, previousReceivedBytes uint64) error
+	FindByID(ctx context.Context, id string) (model.VideoUploadModel, error)
+}
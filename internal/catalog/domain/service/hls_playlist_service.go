@@ -0,0 +1,79 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+// hlsVersion 3 is the first with decimal segment durations, which is all the playlists need.
+const hlsVersion = 3
+
+// HLSPlaylistService writes the HLS playlists of a packaged video. The URIs are left to the
+// caller, so that they can carry whatever grants access to the playlists and segments.
+type HLSPlaylistService interface {
+	// MasterPlaylist lists the renditions of a video, in the given order. Players usually
+	// start with the first one.
+	MasterPlaylist(renditions []model.VideoRenditionModel, uri func(model.VideoRenditionModel) string) string
+	// MediaPlaylist lists the segments of a rendition as a complete, video on demand playlist.
+	MediaPlaylist(rendition model.VideoRenditionModel, uri func(model.VideoSegmentModel) string) string
+}
+
+type hlsPlaylistService struct {
+}
+
+func NewHLSPlaylistService() HLSPlaylistService {
+	return &hlsPlaylistService{}
+}
+
+func (s *hlsPlaylistService) MasterPlaylist(
+	renditions []model.VideoRenditionModel,
+	uri func(model.VideoRenditionModel) string,
+) string {
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n")
+	fmt.Fprintf(&playlist, "#EXT-X-VERSION:%d\n", hlsVersion)
+	playlist.WriteString("#EXT-X-INDEPENDENT-SEGMENTS\n")
+
+	for _, rendition := range renditions {
+		fmt.Fprintf(
+			&playlist,
+			"#EXT-X-STREAM-INF:BANDWIDTH=%d,AVERAGE-BANDWIDTH=%d,RESOLUTION=%dx%d,CODECS=\"%s\"\n",
+			rendition.Bandwidth(),
+			rendition.AverageBandwidth(),
+			rendition.Width(),
+			rendition.Height(),
+			rendition.Codecs(),
+		)
+		playlist.WriteString(uri(rendition))
+		playlist.WriteString("\n")
+	}
+
+	return playlist.String()
+}
+
+func (s *hlsPlaylistService) MediaPlaylist(
+	rendition model.VideoRenditionModel,
+	uri func(model.VideoSegmentModel) string,
+) string {
+	// No segment duration may exceed the target duration once rounded to the nearest integer
+	targetDuration := max(int(math.Round(rendition.TargetDuration().Seconds())), 1)
+
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n")
+	fmt.Fprintf(&playlist, "#EXT-X-VERSION:%d\n", hlsVersion)
+	fmt.Fprintf(&playlist, "#EXT-X-TARGETDURATION:%d\n", targetDuration)
+	playlist.WriteString("#EXT-X-MEDIA-SEQUENCE:0\n")
+	playlist.WriteString("#EXT-X-PLAYLIST-TYPE:VOD\n")
+
+	for _, segment := range rendition.Segments() {
+		fmt.Fprintf(&playlist, "#EXTINF:%.3f,\n", segment.Duration().Seconds())
+		playlist.WriteString(uri(segment))
+		playlist.WriteString("\n")
+	}
+
+	playlist.WriteString("#EXT-X-ENDLIST\n")
+	return playlist.String()
+}
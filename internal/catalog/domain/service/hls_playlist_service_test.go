@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+)
+
+type HLSPlaylistServiceTestSuite struct {
+	suite.Suite
+	hlsPlaylistService service.HLSPlaylistService
+}
+
+func (suite *HLSPlaylistServiceTestSuite) SetupTest() {
+	suite.hlsPlaylistService = service.NewHLSPlaylistService()
+}
+
+func TestHLSPlaylistServiceSuite(t *testing.T) {
+	suite.Run(t, new(HLSPlaylistServiceTestSuite))
+}
+
+func (suite *HLSPlaylistServiceTestSuite) segment(sequence uint, duration time.Duration) model.VideoSegmentModel {
+	segment, err := model.CreateVideoSegmentModel(sequence, duration, "videos/hls/1/1-1/720p/segment.ts", 1_000_000)
+	suite.Require().NoError(err)
+	return segment
+}
+
+func (suite *HLSPlaylistServiceTestSuite) TestMasterPlaylist_ListsRenditionsInOrder() {
+	// Arrange
+	fullHD, err := model.RestoreVideoRenditionModel(
+		1, 7, "1080p", 1920, 1080, "avc1.640028,mp4a.40.2", 5_500_000, 5_000_000, nil, time.Now(),
+	)
+	suite.Require().NoError(err)
+	sd, err := model.RestoreVideoRenditionModel(
+		2, 7, "360p", 640, 360, "avc1.640028,mp4a.40.2", 900_000, 800_000, nil, time.Now(),
+	)
+	suite.Require().NoError(err)
+
+	uri := func(rendition model.VideoRenditionModel) string {
+		return "renditions/" + rendition.Name() + "/index.m3u8?sig=abc"
+	}
+
+	// Act
+	playlist := suite.hlsPlaylistService.MasterPlaylist([]model.VideoRenditionModel{fullHD, sd}, uri)
+
+	// Assert
+	expected := "#EXTM3U\n" +
+		"#EXT-X-VERSION:3\n" +
+		"#EXT-X-INDEPENDENT-SEGMENTS\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=5500000,AVERAGE-BANDWIDTH=5000000,RESOLUTION=1920x1080," +
+		"CODECS=\"avc1.640028,mp4a.40.2\"\n" +
+		"renditions/1080p/index.m3u8?sig=abc\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=900000,AVERAGE-BANDWIDTH=800000,RESOLUTION=640x360," +
+		"CODECS=\"avc1.640028,mp4a.40.2\"\n" +
+		"renditions/360p/index.m3u8?sig=abc\n"
+	suite.Equal(expected, playlist)
+}
+
+func (suite *HLSPlaylistServiceTestSuite) TestMediaPlaylist_ListsSegmentsOfVODPlaylist() {
+	// Arrange
+	rendition, err := model.CreateVideoRenditionModel(
+		7,
+		"720p",
+		1280,
+		720,
+		"avc1.640028,mp4a.40.2",
+		[]model.VideoSegmentModel{suite.segment(0, 6006*time.Millisecond), suite.segment(1, 2500*time.Millisecond)},
+	)
+	suite.Require().NoError(err)
+
+	uri := func(segment model.VideoSegmentModel) string {
+		return "segments/" + strconv.FormatUint(uint64(segment.Sequence()), 10) + ".ts?sig=abc"
+	}
+
+	// Act
+	playlist := suite.hlsPlaylistService.MediaPlaylist(rendition, uri)
+
+	// Assert
+	expected := "#EXTM3U\n" +
+		"#EXT-X-VERSION:3\n" +
+		"#EXT-X-TARGETDURATION:6\n" +
+		"#EXT-X-MEDIA-SEQUENCE:0\n" +
+		"#EXT-X-PLAYLIST-TYPE:VOD\n" +
+		"#EXTINF:6.006,\n" +
+		"segments/0.ts?sig=abc\n" +
+		"#EXTINF:2.500,\n" +
+		"segments/1.ts?sig=abc\n" +
+		"#EXT-X-ENDLIST\n"
+	suite.Equal(expected, playlist)
+}
+
+func (suite *HLSPlaylistServiceTestSuite) TestMediaPlaylist_TargetDurationIsRoundedToNearestSecond() {
+	// Arrange
+	rendition, err := model.CreateVideoRenditionModel(
+		7,
+		"720p",
+		1280,
+		720,
+		"avc1.640028,mp4a.40.2",
+		[]model.VideoSegmentModel{suite.segment(0, 6600*time.Millisecond)},
+	)
+	suite.Require().NoError(err)
+
+	// Act
+	playlist := suite.hlsPlaylistService.MediaPlaylist(rendition, func(model.VideoSegmentModel) string {
+		return "segment.ts"
+	})
+
+	// Assert
+	suite.Contains(playlist, "#EXT-X-TARGETDURATION:7\n")
+}
+
+func (suite *HLSPlaylistServiceTestSuite) TestMediaPlaylist_ShortVideoHasTargetDurationOfOneSecond() {
+	// Arrange
+	rendition, err := model.CreateVideoRenditionModel(
+		7,
+		"720p",
+		1280,
+		720,
+		"avc1.640028,mp4a.40.2",
+		[]model.VideoSegmentModel{suite.segment(0, 200*time.Millisecond)},
+	)
+	suite.Require().NoError(err)
+
+	// Act
+	playlist := suite.hlsPlaylistService.MediaPlaylist(rendition, func(model.VideoSegmentModel) string {
+		return "segment.ts"
+	})
+
+	// Assert
+	suite.Contains(playlist, "#EXT-X-TARGETDURATION:1\n")
+}
@@ -0,0 +1,297 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPlaybackSessionStore is an autogenerated mock type for the PlaybackSessionStore type
+type MockPlaybackSessionStore struct {
+	mock.Mock
+}
+
+type MockPlaybackSessionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPlaybackSessionStore) EXPECT() *MockPlaybackSessionStore_Expecter {
+	return &MockPlaybackSessionStore_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, session, maxSessions
+func (_m *MockPlaybackSessionStore) Add(ctx context.Context, session model.PlaybackSessionModel, maxSessions uint) error {
+	ret := _m.Called(ctx, session, maxSessions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlaybackSessionModel, uint) error); ok {
+		r0 = rf(ctx, session, maxSessions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlaybackSessionStore_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockPlaybackSessionStore_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session model.PlaybackSessionModel
+//   - maxSessions uint
+func (_e *MockPlaybackSessionStore_Expecter) Add(ctx interface{}, session interface{}, maxSessions interface{}) *MockPlaybackSessionStore_Add_Call {
+	return &MockPlaybackSessionStore_Add_Call{Call: _e.mock.On("Add", ctx, session, maxSessions)}
+}
+
+func (_c *MockPlaybackSessionStore_Add_Call) Run(run func(ctx context.Context, session model.PlaybackSessionModel, maxSessions uint)) *MockPlaybackSessionStore_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PlaybackSessionModel), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Add_Call) Return(_a0 error) *MockPlaybackSessionStore_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Add_Call) RunAndReturn(run func(context.Context, model.PlaybackSessionModel, uint) error) *MockPlaybackSessionStore_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, userID, sessionID
+func (_m *MockPlaybackSessionStore) Find(ctx context.Context, userID uint64, sessionID string) (model.PlaybackSessionModel, error) {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 model.PlaybackSessionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (model.PlaybackSessionModel, error)); ok {
+		return rf(ctx, userID, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) model.PlaybackSessionModel); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Get(0).(model.PlaybackSessionModel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlaybackSessionStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockPlaybackSessionStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - sessionID string
+func (_e *MockPlaybackSessionStore_Expecter) Find(ctx interface{}, userID interface{}, sessionID interface{}) *MockPlaybackSessionStore_Find_Call {
+	return &MockPlaybackSessionStore_Find_Call{Call: _e.mock.On("Find", ctx, userID, sessionID)}
+}
+
+func (_c *MockPlaybackSessionStore_Find_Call) Run(run func(ctx context.Context, userID uint64, sessionID string)) *MockPlaybackSessionStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Find_Call) Return(_a0 model.PlaybackSessionModel, _a1 error) *MockPlaybackSessionStore_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Find_Call) RunAndReturn(run func(context.Context, uint64, string) (model.PlaybackSessionModel, error)) *MockPlaybackSessionStore_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *MockPlaybackSessionStore) List(ctx context.Context, userID uint64) ([]model.PlaybackSessionModel, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.PlaybackSessionModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.PlaybackSessionModel, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.PlaybackSessionModel); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PlaybackSessionModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlaybackSessionStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockPlaybackSessionStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockPlaybackSessionStore_Expecter) List(ctx interface{}, userID interface{}) *MockPlaybackSessionStore_List_Call {
+	return &MockPlaybackSessionStore_List_Call{Call: _e.mock.On("List", ctx, userID)}
+}
+
+func (_c *MockPlaybackSessionStore_List_Call) Run(run func(ctx context.Context, userID uint64)) *MockPlaybackSessionStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_List_Call) Return(_a0 []model.PlaybackSessionModel, _a1 error) *MockPlaybackSessionStore_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_List_Call) RunAndReturn(run func(context.Context, uint64) ([]model.PlaybackSessionModel, error)) *MockPlaybackSessionStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, session
+func (_m *MockPlaybackSessionStore) Refresh(ctx context.Context, session model.PlaybackSessionModel) error {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlaybackSessionModel) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlaybackSessionStore_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockPlaybackSessionStore_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session model.PlaybackSessionModel
+func (_e *MockPlaybackSessionStore_Expecter) Refresh(ctx interface{}, session interface{}) *MockPlaybackSessionStore_Refresh_Call {
+	return &MockPlaybackSessionStore_Refresh_Call{Call: _e.mock.On("Refresh", ctx, session)}
+}
+
+func (_c *MockPlaybackSessionStore_Refresh_Call) Run(run func(ctx context.Context, session model.PlaybackSessionModel)) *MockPlaybackSessionStore_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PlaybackSessionModel))
+	})
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Refresh_Call) Return(_a0 error) *MockPlaybackSessionStore_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Refresh_Call) RunAndReturn(run func(context.Context, model.PlaybackSessionModel) error) *MockPlaybackSessionStore_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, userID, sessionID
+func (_m *MockPlaybackSessionStore) Remove(ctx context.Context, userID uint64, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlaybackSessionStore_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockPlaybackSessionStore_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - sessionID string
+func (_e *MockPlaybackSessionStore_Expecter) Remove(ctx interface{}, userID interface{}, sessionID interface{}) *MockPlaybackSessionStore_Remove_Call {
+	return &MockPlaybackSessionStore_Remove_Call{Call: _e.mock.On("Remove", ctx, userID, sessionID)}
+}
+
+func (_c *MockPlaybackSessionStore_Remove_Call) Run(run func(ctx context.Context, userID uint64, sessionID string)) *MockPlaybackSessionStore_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Remove_Call) Return(_a0 error) *MockPlaybackSessionStore_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlaybackSessionStore_Remove_Call) RunAndReturn(run func(context.Context, uint64, string) error) *MockPlaybackSessionStore_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPlaybackSessionStore creates a new instance of MockPlaybackSessionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPlaybackSessionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPlaybackSessionStore {
+	mock := &MockPlaybackSessionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
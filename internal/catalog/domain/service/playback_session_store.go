@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+// PlaybackSessionStore keeps the live playback sessions of each user. A session expires
+// on its own when no heartbeat refreshes it in time.
+type PlaybackSessionStore interface {
+	// Add registers the session unless the user already has maxSessions live ones,
+	// in which case it fails with errs.ErrConcurrentStreamLimitReached.
+	Add(ctx context.Context, session model.PlaybackSessionModel, maxSessions uint) error
+	// Refresh stores the session again and extends its lifetime, it fails with
+	// errs.ErrPlaybackSessionNotFound when the session has already expired.
+	Refresh(ctx context.Context, session model.PlaybackSessionModel) error
+	Find(ctx context.Context, userID uint64, sessionID string) (model.PlaybackSessionModel, error)
+	List(ctx context.Context, userID uint64) ([]model.PlaybackSessionModel, error)
+	// Remove ends the session, it fails with errs.ErrPlaybackSessionNotFound when there is none.
+	Remove(ctx context.Context, userID uint64, sessionID string) error
+}
@@ -0,0 +1,20 @@
+package service
+
+import "github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+
+// PlaybackURLSigner signs playback grants, so that players and CDNs can fetch a video through
+// a plain URL instead of sending an Authorization header.
+type PlaybackURLSigner interface {
+	// Sign grants the user access to the video for the playback session and signs the grant.
+	// How long the grant lasts and whether it is bound to clientIP is up to the signer.
+	Sign(userID, videoID uint64, sessionID, clientIP string) (SignedPlaybackGrant, error)
+	// Verify fails with errs.ErrInvalidPlaybackSignature when the signature was not issued
+	// for the grant. It does not check whether the grant has expired.
+	Verify(grant model.PlaybackGrantModel, signature string) error
+}
+
+type SignedPlaybackGrant struct {
+	Grant model.PlaybackGrantModel
+	// Signature is URL safe and goes into the playback URL as is.
+	Signature string
+}
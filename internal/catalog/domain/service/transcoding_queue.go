@@ -0,0 +1,9 @@
+package service
+
+import "context"
+
+// TranscodingQueue hands transcoding jobs over to the workers. Delivery is at least once, so a
+// job may be received again after it was run.
+type TranscodingQueue interface {
+	EnqueueTranscoding(ctx context.Context, jobID uint64) error
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+// VideoStorage keeps the video files. Files are uploaded in parts and only become readable
+// once the upload is completed.
+type VideoStorage interface {
+	StartUpload(ctx context.Context, objectKey, contentType string) (string, error)
+	// UploadPart stores exactly sizeInBytes bytes from reader, it fails with
+	// errs.ErrVideoUploadChunkIncomplete when the reader ends before.
+	UploadPart(
+		ctx context.Context,
+		objectKey, storageUploadID string,
+		partNumber int,
+		reader io.Reader,
+		sizeInBytes uint64,
+	) (model.VideoUploadPartModel, error)
+	CompleteUpload(ctx context.Context, objectKey, storageUploadID string, parts []model.VideoUploadPartModel) error
+	AbortUpload(ctx context.Context, objectKey, storageUploadID string) error
+	Delete(ctx context.Context, objectKey string) error
+	// Open fails with errs.ErrVideoFileNotFound when there is no file under the key.
+	Open(ctx context.Context, objectKey string) (VideoFile, error)
+}
+
+// VideoFile is a stored video opened for reading. Bytes are fetched from the storage from the
+// current offset on, so seeking past a part of the file does not download it.
+type VideoFile interface {
+	io.ReadSeekCloser
+	Info() VideoFileInfo
+}
+
+type VideoFileInfo struct {
+	SizeInBytes  uint64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+type TranscodeInput struct {
+	VideoID uint64
+	// SourceKey is the object key of the file to package.
+	SourceKey string
+	// OutputPrefix is the object key prefix the segments are stored under.
+	OutputPrefix string
+	Profiles     []model.RenditionProfileModel
+}
+
+// VideoTranscoder packages the file of a video into HLS renditions and stores their segments
+// in the video storage. Profiles above the resolution of the source may be skipped, but at
+// least one rendition is returned.
+type VideoTranscoder interface {
+	Transcode(ctx context.Context, input TranscodeInput) ([]model.VideoRenditionModel, error)
+}
@@ -0,0 +1,78 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	shared_queue "github.com/cristiano-pacheco/goflix/internal/shared/modules/queue"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+type TranscodeVideoConsumerResult struct {
+	fx.Out
+
+	Subscription shared_queue.Subscription `group:"queue_subscriptions"`
+}
+
+// NewTranscodeVideoConsumer runs the transcoding jobs handed over by the TranscodingQueue. A job
+// set back to pending after a failed attempt fails its message, so that the queue retries it
+// with backoff.
+func NewTranscodeVideoConsumer(
+	processTranscodingJobUseCase *usecase.ProcessTranscodingJobUseCase,
+	conf config.Config,
+	logger logger.Logger,
+) TranscodeVideoConsumerResult {
+	handler := func(ctx context.Context, envelope queue.Envelope) error {
+		var message service.TranscodingMessage
+		if err := envelope.Decode(&message); err != nil {
+			return err
+		}
+
+		output, err := processTranscodingJobUseCase.Execute(ctx, usecase.ProcessTranscodingJobInput{
+			JobID:       message.JobID,
+			JobTimeout:  conf.Transcoder.GetJobTimeout(),
+			MaxAttempts: conf.Transcoder.GetMaxAttempts(),
+		})
+		if errors.Is(err, errs.ErrTranscodingJobNotFound) {
+			return queue.Permanent(err)
+		}
+		if err != nil {
+			return err
+		}
+
+		if !output.Processed {
+			return nil
+		}
+
+		logger.Info(
+			"transcoding job processed",
+			"jobID", output.Job.JobID,
+			"videoID", output.Job.VideoID,
+			"status", output.Job.Status,
+			"attempts", output.Job.Attempts,
+		)
+
+		if output.Job.Status == enum.EnumTranscodingJobStatusPending {
+			return fmt.Errorf(
+				"transcoding job %d failed on attempt %d: %s",
+				output.Job.JobID,
+				output.Job.Attempts,
+				output.Job.LastError,
+			)
+		}
+		return nil
+	}
+
+	return TranscodeVideoConsumerResult{
+		Subscription: shared_queue.Subscription{Topic: service.TranscodingTopic, Handler: handler},
+	}
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type CreateEpisodeRequest struct {
+	EpisodeNumber uint   `json:"episode_number"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+type UpdateEpisodeRequest struct {
+	EpisodeNumber uint   `json:"episode_number"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+type EpisodeResponse struct {
+	EpisodeID     uint64    `json:"episode_id"`
+	SeasonID      uint64    `json:"season_id"`
+	EpisodeNumber uint      `json:"episode_number"`
+	Title         string    `json:"title"`
+	Description   string    `json:"description"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+type CreateMovieRequest struct {
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+	ExternalRating    *float64   `json:"external_rating"`
+}
+
+type UpdateMovieRequest struct {
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+	ExternalRating    *float64   `json:"external_rating"`
+}
+
+type MovieResponse struct {
+	MovieID           uint64     `json:"movie_id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+	ExternalRating    *float64   `json:"external_rating"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type ListMoviesResponse struct {
+	Movies []MovieResponse `json:"movies"`
+}
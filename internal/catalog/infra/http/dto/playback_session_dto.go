@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type StartPlaybackSessionRequest struct {
+	VideoID    uint64 `json:"video_id"`
+	DeviceName string `json:"device_name"`
+}
+
+type PlaybackSessionResponse struct {
+	SessionID       string    `json:"session_id"`
+	VideoID         uint64    `json:"video_id"`
+	DeviceName      string    `json:"device_name"`
+	StartedAt       time.Time `json:"started_at"`
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at"`
+}
+
+type ListPlaybackSessionsResponse struct {
+	Sessions []PlaybackSessionResponse `json:"sessions"`
+}
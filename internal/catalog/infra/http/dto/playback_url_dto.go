@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// Query parameters of a signed playback URL. They are kept short because players append them
+// to every request, the video comes from the path.
+const (
+	PlaybackURLUserIDParam    = "uid"
+	PlaybackURLSessionIDParam = "sid"
+	PlaybackURLExpiresParam   = "exp"
+	PlaybackURLClientIPParam  = "ip"
+	PlaybackURLSignatureParam = "sig"
+)
+
+// PlaybackURLClientIPBound is the value of the ip parameter of URLs bound to the client IP.
+// The address itself is not in the URL, it is taken from the request being verified.
+const PlaybackURLClientIPBound = "1"
+
+// CreatePlaybackURLRequest names the playback session the URL is for. The URL stops working
+// once the session expires or is ended.
+type CreatePlaybackURLRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// PlaybackURLResponse has the same grant signed for the file of the video and for its HLS
+// master playlist. HLSURL only works once the video has been packaged.
+type PlaybackURLResponse struct {
+	URL       string    `json:"url"`
+	HLSURL    string    `json:"hls_url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
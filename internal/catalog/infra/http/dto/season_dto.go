@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type CreateSeasonRequest struct {
+	SeasonNumber uint   `json:"season_number"`
+	Title        string `json:"title"`
+}
+
+type UpdateSeasonRequest struct {
+	SeasonNumber uint   `json:"season_number"`
+	Title        string `json:"title"`
+}
+
+type SeasonResponse struct {
+	SeasonID     uint64            `json:"season_id"`
+	TvShowID     uint64            `json:"tv_show_id"`
+	SeasonNumber uint              `json:"season_number"`
+	Title        *string           `json:"title"`
+	Episodes     []EpisodeResponse `json:"episodes,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type TranscodingJobResponse struct {
+	JobID      uint64     `json:"job_id"`
+	VideoID    uint64     `json:"video_id"`
+	Status     string     `json:"status"`
+	Attempts   uint       `json:"attempts"`
+	LastError  string     `json:"last_error"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
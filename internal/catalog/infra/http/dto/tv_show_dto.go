@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type CreateTvShowRequest struct {
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+}
+
+type UpdateTvShowRequest struct {
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+}
+
+type TvShowResponse struct {
+	TvShowID          uint64     `json:"tv_show_id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	AgeRecommendation *uint      `json:"age_recommendation"`
+	ReleaseDate       *time.Time `json:"release_date"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type TvShowDetailsResponse struct {
+	TvShowResponse
+	Seasons []SeasonResponse `json:"seasons"`
+}
+
+type ListTvShowsResponse struct {
+	TvShows []TvShowResponse `json:"tv_shows"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// CreateVideoUploadRequest targets either a movie or an episode, never both.
+type CreateVideoUploadRequest struct {
+	MovieID        *uint64 `json:"movie_id"`
+	EpisodeID      *uint64 `json:"episode_id"`
+	ContentType    string  `json:"content_type"`
+	SizeInBytes    uint64  `json:"size_in_bytes"`
+	ChecksumSHA256 string  `json:"checksum_sha256"`
+}
+
+type VideoUploadResponse struct {
+	UploadID      string    `json:"upload_id"`
+	MovieID       *uint64   `json:"movie_id"`
+	EpisodeID     *uint64   `json:"episode_id"`
+	ContentType   string    `json:"content_type"`
+	SizeInBytes   uint64    `json:"size_in_bytes"`
+	ReceivedBytes uint64    `json:"received_bytes"`
+	Status        string    `json:"status"`
+	VideoID       *uint64   `json:"video_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type EpisodeHandler struct {
+	errorMapper          shared_errs.ErrorMapper
+	createEpisodeUseCase *usecase.CreateEpisodeUseCase
+	updateEpisodeUseCase *usecase.UpdateEpisodeUseCase
+	findEpisodeUseCase   *usecase.FindEpisodeUseCase
+	deleteEpisodeUseCase *usecase.DeleteEpisodeUseCase
+}
+
+func NewEpisodeHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createEpisodeUseCase *usecase.CreateEpisodeUseCase,
+	updateEpisodeUseCase *usecase.UpdateEpisodeUseCase,
+	findEpisodeUseCase *usecase.FindEpisodeUseCase,
+	deleteEpisodeUseCase *usecase.DeleteEpisodeUseCase,
+) *EpisodeHandler {
+	return &EpisodeHandler{
+		errorMapper,
+		createEpisodeUseCase,
+		updateEpisodeUseCase,
+		findEpisodeUseCase,
+		deleteEpisodeUseCase,
+	}
+}
+
+// @Summary		Create episode
+// @Description	Adds a new episode to a season
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Season ID"
+// @Param		request	body	dto.CreateEpisodeRequest	true	"Episode data"
+// @Success		201	{object}	response.Envelope[dto.EpisodeResponse]	"Successfully created episode"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Season not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/seasons/{id}/episodes [post]
+func (h *EpisodeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "EpisodeHandler.Create")
+	defer span.End()
+
+	seasonID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var createEpisodeRequest dto.CreateEpisodeRequest
+	if err = request.ReadJSON(w, r, &createEpisodeRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreateEpisodeInput{
+		SeasonID:      seasonID,
+		EpisodeNumber: createEpisodeRequest.EpisodeNumber,
+		Title:         createEpisodeRequest.Title,
+		Description:   createEpisodeRequest.Description,
+	}
+
+	output, err := h.createEpisodeUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toEpisodeResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		Find episode
+// @Description	Retrieves an episode by its ID
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Episode ID"
+// @Success		200	{object}	response.Envelope[dto.EpisodeResponse]	"Successfully retrieved episode"
+// @Failure		400	{object}	errs.Error	"Invalid episode ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Episode not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/episodes/{id} [get]
+func (h *EpisodeHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "EpisodeHandler.Find")
+	defer span.End()
+
+	episodeID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.findEpisodeUseCase.Execute(ctx, usecase.FindEpisodeInput{EpisodeID: episodeID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toEpisodeResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Update episode
+// @Description	Updates an existing episode
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Episode ID"
+// @Param		request	body	dto.UpdateEpisodeRequest	true	"Episode data"
+// @Success		200	{object}	response.Envelope[dto.EpisodeResponse]	"Successfully updated episode"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Episode not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/episodes/{id} [put]
+func (h *EpisodeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "EpisodeHandler.Update")
+	defer span.End()
+
+	episodeID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var updateEpisodeRequest dto.UpdateEpisodeRequest
+	if err = request.ReadJSON(w, r, &updateEpisodeRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UpdateEpisodeInput{
+		EpisodeID:     episodeID,
+		EpisodeNumber: updateEpisodeRequest.EpisodeNumber,
+		Title:         updateEpisodeRequest.Title,
+		Description:   updateEpisodeRequest.Description,
+	}
+
+	output, err := h.updateEpisodeUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toEpisodeResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Delete episode
+// @Description	Deletes an episode from a season
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Episode ID"
+// @Success		204	"Successfully deleted episode"
+// @Failure		400	{object}	errs.Error	"Invalid episode ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Episode not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/episodes/{id} [delete]
+func (h *EpisodeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "EpisodeHandler.Delete")
+	defer span.End()
+
+	episodeID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	err = h.deleteEpisodeUseCase.Execute(ctx, usecase.DeleteEpisodeInput{EpisodeID: episodeID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toEpisodeResponse(output usecase.EpisodeOutput) dto.EpisodeResponse {
+	return dto.EpisodeResponse{
+		EpisodeID:     output.EpisodeID,
+		SeasonID:      output.SeasonID,
+		EpisodeNumber: output.EpisodeNumber,
+		Title:         output.Title,
+		Description:   output.Description,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+	}
+}
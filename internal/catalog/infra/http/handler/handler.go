@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+)
+
+var notFoundErrors = []error{
+	errs.ErrMovieNotFound,
+	errs.ErrTvShowNotFound,
+	errs.ErrSeasonNotFound,
+	errs.ErrEpisodeNotFound,
+	errs.ErrPlaybackSessionNotFound,
+	errs.ErrVideoNotFound,
+	errs.ErrVideoFileNotFound,
+	errs.ErrVideoUploadNotFound,
+	errs.ErrVideoRenditionNotFound,
+	errs.ErrVideoSegmentNotFound,
+	errs.ErrTranscodingJobNotFound,
+}
+
+var conflictErrors = []error{
+	errs.ErrConcurrentStreamLimitReached,
+	errs.ErrVideoUploadOffsetMismatch,
+	errs.ErrVideoUploadNotPending,
+	errs.ErrTranscodingJobAlreadyQueued,
+}
+
+var badRequestErrors = []error{
+	errs.ErrTitleRequired,
+	errs.ErrTitleTooLong,
+	errs.ErrTitleInvalidCharacters,
+	errs.ErrDescriptionRequired,
+	errs.ErrDescriptionTooLong,
+	errs.ErrDescriptionInvalidCharacters,
+	errs.ErrAgeRecommendationTooHigh,
+	errs.ErrExternalRatingOutOfRange,
+	errs.ErrSeasonNumberRequired,
+	errs.ErrSeasonNumberTooHigh,
+	errs.ErrEpisodeNumberRequired,
+	errs.ErrEpisodeNumberTooHigh,
+	errs.ErrSeasonAlreadyExists,
+	errs.ErrEpisodeAlreadyExists,
+	errs.ErrVideoIDRequired,
+	errs.ErrDeviceNameTooLong,
+	errs.ErrPlaybackSessionIDRequired,
+	errs.ErrPlaybackSessionVideoMismatch,
+	errs.ErrVideoMustBelongToOneContent,
+	errs.ErrInvalidVideoContentType,
+	errs.ErrInvalidVideoChecksum,
+	errs.ErrVideoSizeOutOfRange,
+	errs.ErrVideoUploadChunkEmpty,
+	errs.ErrVideoUploadChunkTooSmall,
+	errs.ErrVideoUploadChunkTooLarge,
+	errs.ErrVideoUploadChunkExceedsSize,
+	errs.ErrVideoUploadChunkIncomplete,
+	errs.ErrVideoUploadTooManyChunks,
+	errs.ErrVideoUploadChecksumMismatch,
+}
+
+// mapError translates catalog domain errors into HTTP errors, falling back to the shared mapper.
+func mapError(errorMapper shared_errs.ErrorMapper, err error) error {
+	for _, notFoundErr := range notFoundErrors {
+		if errors.Is(err, notFoundErr) {
+			return errorMapper.MapCustomError(http.StatusNotFound, err.Error())
+		}
+	}
+	for _, conflictErr := range conflictErrors {
+		if errors.Is(err, conflictErr) {
+			return errorMapper.MapCustomError(http.StatusConflict, err.Error())
+		}
+	}
+	for _, badRequestErr := range badRequestErrors {
+		if errors.Is(err, badRequestErr) {
+			return errorMapper.MapCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	return errorMapper.Map(err)
+}
+
+func parseIDParam(r *http.Request, name string) (uint64, error) {
+	id, err := strconv.ParseUint(request.Param(r, name), 10, 64)
+	if err != nil || id == 0 {
+		return 0, shared_errs.NewBadRequestError("invalid " + name)
+	}
+	return id, nil
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type MovieHandler struct {
+	errorMapper        shared_errs.ErrorMapper
+	createMovieUseCase *usecase.CreateMovieUseCase
+	updateMovieUseCase *usecase.UpdateMovieUseCase
+	findMovieUseCase   *usecase.FindMovieUseCase
+	listMoviesUseCase  *usecase.ListMoviesUseCase
+	deleteMovieUseCase *usecase.DeleteMovieUseCase
+}
+
+func NewMovieHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createMovieUseCase *usecase.CreateMovieUseCase,
+	updateMovieUseCase *usecase.UpdateMovieUseCase,
+	findMovieUseCase *usecase.FindMovieUseCase,
+	listMoviesUseCase *usecase.ListMoviesUseCase,
+	deleteMovieUseCase *usecase.DeleteMovieUseCase,
+) *MovieHandler {
+	return &MovieHandler{
+		errorMapper,
+		createMovieUseCase,
+		updateMovieUseCase,
+		findMovieUseCase,
+		listMoviesUseCase,
+		deleteMovieUseCase,
+	}
+}
+
+// @Summary		Create movie
+// @Description	Creates a new movie in the catalog
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreateMovieRequest	true	"Movie data"
+// @Success		201	{object}	response.Envelope[dto.MovieResponse]	"Successfully created movie"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/movies [post]
+func (h *MovieHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "MovieHandler.Create")
+	defer span.End()
+
+	var createMovieRequest dto.CreateMovieRequest
+	if err := request.ReadJSON(w, r, &createMovieRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreateMovieInput{
+		Title:             createMovieRequest.Title,
+		Description:       createMovieRequest.Description,
+		AgeRecommendation: createMovieRequest.AgeRecommendation,
+		ReleaseDate:       createMovieRequest.ReleaseDate,
+		ExternalRating:    createMovieRequest.ExternalRating,
+	}
+
+	output, err := h.createMovieUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toMovieResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		List movies
+// @Description	Retrieves all movies in the catalog
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[dto.ListMoviesResponse]	"Successfully retrieved movies"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/movies [get]
+func (h *MovieHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "MovieHandler.List")
+	defer span.End()
+
+	output, err := h.listMoviesUseCase.Execute(ctx)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	movies := make([]dto.MovieResponse, 0, len(output))
+	for _, movie := range output {
+		movies = append(movies, toMovieResponse(movie))
+	}
+
+	envelope := response.NewEnvelope(dto.ListMoviesResponse{Movies: movies})
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Find movie
+// @Description	Retrieves a movie by its ID
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Movie ID"
+// @Success		200	{object}	response.Envelope[dto.MovieResponse]	"Successfully retrieved movie"
+// @Failure		400	{object}	errs.Error	"Invalid movie ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Movie not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/movies/{id} [get]
+func (h *MovieHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "MovieHandler.Find")
+	defer span.End()
+
+	movieID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.findMovieUseCase.Execute(ctx, usecase.FindMovieInput{MovieID: movieID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toMovieResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Update movie
+// @Description	Updates an existing movie
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Movie ID"
+// @Param		request	body	dto.UpdateMovieRequest	true	"Movie data"
+// @Success		200	{object}	response.Envelope[dto.MovieResponse]	"Successfully updated movie"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Movie not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/movies/{id} [put]
+func (h *MovieHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "MovieHandler.Update")
+	defer span.End()
+
+	movieID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var updateMovieRequest dto.UpdateMovieRequest
+	if err = request.ReadJSON(w, r, &updateMovieRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UpdateMovieInput{
+		MovieID:           movieID,
+		Title:             updateMovieRequest.Title,
+		Description:       updateMovieRequest.Description,
+		AgeRecommendation: updateMovieRequest.AgeRecommendation,
+		ReleaseDate:       updateMovieRequest.ReleaseDate,
+		ExternalRating:    updateMovieRequest.ExternalRating,
+	}
+
+	output, err := h.updateMovieUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toMovieResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Delete movie
+// @Description	Deletes a movie from the catalog
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Movie ID"
+// @Success		204	"Successfully deleted movie"
+// @Failure		400	{object}	errs.Error	"Invalid movie ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Movie not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/movies/{id} [delete]
+func (h *MovieHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "MovieHandler.Delete")
+	defer span.End()
+
+	movieID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	err = h.deleteMovieUseCase.Execute(ctx, usecase.DeleteMovieInput{MovieID: movieID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toMovieResponse(output usecase.MovieOutput) dto.MovieResponse {
+	return dto.MovieResponse{
+		MovieID:           output.MovieID,
+		Title:             output.Title,
+		Description:       output.Description,
+		AgeRecommendation: output.AgeRecommendation,
+		ReleaseDate:       output.ReleaseDate,
+		ExternalRating:    output.ExternalRating,
+		CreatedAt:         output.CreatedAt,
+		UpdatedAt:         output.UpdatedAt,
+	}
+}
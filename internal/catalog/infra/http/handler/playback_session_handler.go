@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type PlaybackSessionHandler struct {
+	errorMapper                     shared_errs.ErrorMapper
+	startPlaybackSessionUseCase     *usecase.StartPlaybackSessionUseCase
+	heartbeatPlaybackSessionUseCase *usecase.HeartbeatPlaybackSessionUseCase
+	listPlaybackSessionsUseCase     *usecase.ListPlaybackSessionsUseCase
+	endPlaybackSessionUseCase       *usecase.EndPlaybackSessionUseCase
+}
+
+func NewPlaybackSessionHandler(
+	errorMapper shared_errs.ErrorMapper,
+	startPlaybackSessionUseCase *usecase.StartPlaybackSessionUseCase,
+	heartbeatPlaybackSessionUseCase *usecase.HeartbeatPlaybackSessionUseCase,
+	listPlaybackSessionsUseCase *usecase.ListPlaybackSessionsUseCase,
+	endPlaybackSessionUseCase *usecase.EndPlaybackSessionUseCase,
+) *PlaybackSessionHandler {
+	return &PlaybackSessionHandler{
+		errorMapper,
+		startPlaybackSessionUseCase,
+		heartbeatPlaybackSessionUseCase,
+		listPlaybackSessionsUseCase,
+		endPlaybackSessionUseCase,
+	}
+}
+
+// @Summary		Start playback session
+// @Description	Registers a new stream for the authenticated user, up to the concurrent streams of the plan
+// @Tags		Playback
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.StartPlaybackSessionRequest	true	"Playback data"
+// @Success		201	{object}	response.Envelope[dto.PlaybackSessionResponse]	"Successfully started playback session"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Active subscription required"
+// @Failure		404	{object}	errs.Error	"Video not found"
+// @Failure		409	{object}	errs.Error	"Concurrent stream limit reached"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/playback/sessions [post]
+func (h *PlaybackSessionHandler) Start(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlaybackSessionHandler.Start")
+	defer span.End()
+
+	var startPlaybackSessionRequest dto.StartPlaybackSessionRequest
+	if err := request.ReadJSON(w, r, &startPlaybackSessionRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.StartPlaybackSessionInput{
+		UserID:     request.GetUserID(r),
+		VideoID:    startPlaybackSessionRequest.VideoID,
+		DeviceName: startPlaybackSessionRequest.DeviceName,
+	}
+
+	output, err := h.startPlaybackSessionUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toPlaybackSessionResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		Send playback heartbeat
+// @Description	Keeps a playback session alive, sessions without heartbeats expire and free their stream
+// @Tags		Playback
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	string	true	"Session ID"
+// @Success		200	{object}	response.Envelope[dto.PlaybackSessionResponse]	"Successfully refreshed playback session"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Active subscription required"
+// @Failure		404	{object}	errs.Error	"Playback session not found or expired"
+// @Failure		422	{object}	errs.Error	"Invalid session ID"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/playback/sessions/{id}/heartbeat [post]
+func (h *PlaybackSessionHandler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlaybackSessionHandler.Heartbeat")
+	defer span.End()
+
+	input := usecase.HeartbeatPlaybackSessionInput{
+		UserID:    request.GetUserID(r),
+		SessionID: request.Param(r, "id"),
+	}
+
+	output, err := h.heartbeatPlaybackSessionUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toPlaybackSessionResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		List playback sessions
+// @Description	Lists the live streams of the authenticated user
+// @Tags		Playback
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[dto.ListPlaybackSessionsResponse]	"Successfully retrieved playback sessions"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/playback/sessions [get]
+func (h *PlaybackSessionHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlaybackSessionHandler.List")
+	defer span.End()
+
+	input := usecase.ListPlaybackSessionsInput{UserID: request.GetUserID(r)}
+
+	output, err := h.listPlaybackSessionsUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	sessions := make([]dto.PlaybackSessionResponse, 0, len(output))
+	for _, session := range output {
+		sessions = append(sessions, toPlaybackSessionResponse(session))
+	}
+
+	envelope := response.NewEnvelope(dto.ListPlaybackSessionsResponse{Sessions: sessions})
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		End playback session
+// @Description	Ends one of the streams of the authenticated user, e.g. to free a stream used on another device
+// @Tags		Playback
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	string	true	"Session ID"
+// @Success		204	"Successfully ended playback session"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"Playback session not found"
+// @Failure		422	{object}	errs.Error	"Invalid session ID"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/playback/sessions/{id} [delete]
+func (h *PlaybackSessionHandler) End(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "PlaybackSessionHandler.End")
+	defer span.End()
+
+	input := usecase.EndPlaybackSessionInput{
+		UserID:    request.GetUserID(r),
+		SessionID: request.Param(r, "id"),
+	}
+
+	err := h.endPlaybackSessionUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toPlaybackSessionResponse(output usecase.PlaybackSessionOutput) dto.PlaybackSessionResponse {
+	return dto.PlaybackSessionResponse{
+		SessionID:       output.SessionID,
+		VideoID:         output.VideoID,
+		DeviceName:      output.DeviceName,
+		StartedAt:       output.StartedAt,
+		LastHeartbeatAt: output.LastHeartbeatAt,
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type SeasonHandler struct {
+	errorMapper         shared_errs.ErrorMapper
+	createSeasonUseCase *usecase.CreateSeasonUseCase
+	updateSeasonUseCase *usecase.UpdateSeasonUseCase
+	deleteSeasonUseCase *usecase.DeleteSeasonUseCase
+}
+
+func NewSeasonHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createSeasonUseCase *usecase.CreateSeasonUseCase,
+	updateSeasonUseCase *usecase.UpdateSeasonUseCase,
+	deleteSeasonUseCase *usecase.DeleteSeasonUseCase,
+) *SeasonHandler {
+	return &SeasonHandler{
+		errorMapper,
+		createSeasonUseCase,
+		updateSeasonUseCase,
+		deleteSeasonUseCase,
+	}
+}
+
+// @Summary		Create season
+// @Description	Adds a new season to a TV show
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"TV show ID"
+// @Param		request	body	dto.CreateSeasonRequest	true	"Season data"
+// @Success		201	{object}	response.Envelope[dto.SeasonResponse]	"Successfully created season"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"TV show not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows/{id}/seasons [post]
+func (h *SeasonHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SeasonHandler.Create")
+	defer span.End()
+
+	tvShowID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var createSeasonRequest dto.CreateSeasonRequest
+	if err = request.ReadJSON(w, r, &createSeasonRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreateSeasonInput{
+		TvShowID:     tvShowID,
+		SeasonNumber: createSeasonRequest.SeasonNumber,
+		Title:        createSeasonRequest.Title,
+	}
+
+	output, err := h.createSeasonUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toSeasonResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		Update season
+// @Description	Updates an existing season
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Season ID"
+// @Param		request	body	dto.UpdateSeasonRequest	true	"Season data"
+// @Success		200	{object}	response.Envelope[dto.SeasonResponse]	"Successfully updated season"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Season not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/seasons/{id} [put]
+func (h *SeasonHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SeasonHandler.Update")
+	defer span.End()
+
+	seasonID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var updateSeasonRequest dto.UpdateSeasonRequest
+	if err = request.ReadJSON(w, r, &updateSeasonRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UpdateSeasonInput{
+		SeasonID:     seasonID,
+		SeasonNumber: updateSeasonRequest.SeasonNumber,
+		Title:        updateSeasonRequest.Title,
+	}
+
+	output, err := h.updateSeasonUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toSeasonResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Delete season
+// @Description	Deletes a season together with its episodes
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Season ID"
+// @Success		204	"Successfully deleted season"
+// @Failure		400	{object}	errs.Error	"Invalid season ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Season not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/seasons/{id} [delete]
+func (h *SeasonHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "SeasonHandler.Delete")
+	defer span.End()
+
+	seasonID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	err = h.deleteSeasonUseCase.Execute(ctx, usecase.DeleteSeasonInput{SeasonID: seasonID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toSeasonResponse(output usecase.SeasonOutput) dto.SeasonResponse {
+	episodes := make([]dto.EpisodeResponse, 0, len(output.Episodes))
+	for _, episode := range output.Episodes {
+		episodes = append(episodes, toEpisodeResponse(episode))
+	}
+
+	return dto.SeasonResponse{
+		SeasonID:     output.SeasonID,
+		TvShowID:     output.TvShowID,
+		SeasonNumber: output.SeasonNumber,
+		Title:        output.Title,
+		Episodes:     episodes,
+		CreatedAt:    output.CreatedAt,
+		UpdatedAt:    output.UpdatedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type TranscodingJobHandler struct {
+	errorMapper                 shared_errs.ErrorMapper
+	createTranscodingJobUseCase *usecase.CreateTranscodingJobUseCase
+	findTranscodingJobUseCase   *usecase.FindTranscodingJobUseCase
+}
+
+func NewTranscodingJobHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createTranscodingJobUseCase *usecase.CreateTranscodingJobUseCase,
+	findTranscodingJobUseCase *usecase.FindTranscodingJobUseCase,
+) *TranscodingJobHandler {
+	return &TranscodingJobHandler{errorMapper, createTranscodingJobUseCase, findTranscodingJobUseCase}
+}
+
+// @Summary		Create transcoding job
+// @Description	Queues the packaging of the video into HLS renditions again, e.g. after the last job failed.
+// @Description	Completed uploads queue a job on their own.
+// @Tags		Catalog
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Video ID"
+// @Success		201	{object}	response.Envelope[dto.TranscodingJobResponse]	"Successfully queued transcoding job"
+// @Failure		400	{object}	errs.Error	"Invalid video ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Video not found"
+// @Failure		409	{object}	errs.Error	"Video already has a pending or running job"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/videos/{id}/transcoding [post]
+func (h *TranscodingJobHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TranscodingJobHandler.Create")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.createTranscodingJobUseCase.Execute(ctx, usecase.CreateTranscodingJobInput{VideoID: videoID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toTranscodingJobResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		Find transcoding job
+// @Description	Retrieves the latest transcoding job of the video
+// @Tags		Catalog
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Video ID"
+// @Success		200	{object}	response.Envelope[dto.TranscodingJobResponse]	"Successfully retrieved transcoding job"
+// @Failure		400	{object}	errs.Error	"Invalid video ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Transcoding job not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/videos/{id}/transcoding [get]
+func (h *TranscodingJobHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TranscodingJobHandler.Find")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.findTranscodingJobUseCase.Execute(ctx, usecase.FindTranscodingJobInput{VideoID: videoID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toTranscodingJobResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+func toTranscodingJobResponse(output usecase.TranscodingJobOutput) dto.TranscodingJobResponse {
+	return dto.TranscodingJobResponse{
+		JobID:      output.JobID,
+		VideoID:    output.VideoID,
+		Status:     output.Status,
+		Attempts:   output.Attempts,
+		LastError:  output.LastError,
+		StartedAt:  output.StartedAt,
+		FinishedAt: output.FinishedAt,
+		CreatedAt:  output.CreatedAt,
+		UpdatedAt:  output.UpdatedAt,
+	}
+}
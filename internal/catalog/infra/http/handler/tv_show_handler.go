@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type TvShowHandler struct {
+	errorMapper         shared_errs.ErrorMapper
+	createTvShowUseCase *usecase.CreateTvShowUseCase
+	updateTvShowUseCase *usecase.UpdateTvShowUseCase
+	findTvShowUseCase   *usecase.FindTvShowUseCase
+	listTvShowsUseCase  *usecase.ListTvShowsUseCase
+	deleteTvShowUseCase *usecase.DeleteTvShowUseCase
+}
+
+func NewTvShowHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createTvShowUseCase *usecase.CreateTvShowUseCase,
+	updateTvShowUseCase *usecase.UpdateTvShowUseCase,
+	findTvShowUseCase *usecase.FindTvShowUseCase,
+	listTvShowsUseCase *usecase.ListTvShowsUseCase,
+	deleteTvShowUseCase *usecase.DeleteTvShowUseCase,
+) *TvShowHandler {
+	return &TvShowHandler{
+		errorMapper,
+		createTvShowUseCase,
+		updateTvShowUseCase,
+		findTvShowUseCase,
+		listTvShowsUseCase,
+		deleteTvShowUseCase,
+	}
+}
+
+// @Summary		Create TV show
+// @Description	Creates a new TV show in the catalog
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreateTvShowRequest	true	"TV show data"
+// @Success		201	{object}	response.Envelope[dto.TvShowResponse]	"Successfully created TV show"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows [post]
+func (h *TvShowHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TvShowHandler.Create")
+	defer span.End()
+
+	var createTvShowRequest dto.CreateTvShowRequest
+	if err := request.ReadJSON(w, r, &createTvShowRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreateTvShowInput{
+		Title:             createTvShowRequest.Title,
+		Description:       createTvShowRequest.Description,
+		AgeRecommendation: createTvShowRequest.AgeRecommendation,
+		ReleaseDate:       createTvShowRequest.ReleaseDate,
+	}
+
+	output, err := h.createTvShowUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toTvShowResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		List TV shows
+// @Description	Retrieves all TV shows in the catalog
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[dto.ListTvShowsResponse]	"Successfully retrieved TV shows"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows [get]
+func (h *TvShowHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TvShowHandler.List")
+	defer span.End()
+
+	output, err := h.listTvShowsUseCase.Execute(ctx)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	tvShows := make([]dto.TvShowResponse, 0, len(output))
+	for _, tvShow := range output {
+		tvShows = append(tvShows, toTvShowResponse(tvShow))
+	}
+
+	envelope := response.NewEnvelope(dto.ListTvShowsResponse{TvShows: tvShows})
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Find TV show
+// @Description	Retrieves a TV show by its ID including its seasons and episodes
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"TV show ID"
+// @Success		200	{object}	response.Envelope[dto.TvShowDetailsResponse]	"Successfully retrieved TV show"
+// @Failure		400	{object}	errs.Error	"Invalid TV show ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		404	{object}	errs.Error	"TV show not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows/{id} [get]
+func (h *TvShowHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TvShowHandler.Find")
+	defer span.End()
+
+	tvShowID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.findTvShowUseCase.Execute(ctx, usecase.FindTvShowInput{TvShowID: tvShowID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	seasons := make([]dto.SeasonResponse, 0, len(output.Seasons))
+	for _, season := range output.Seasons {
+		seasons = append(seasons, toSeasonResponse(season))
+	}
+
+	resData := dto.TvShowDetailsResponse{
+		TvShowResponse: toTvShowResponse(output.TvShowOutput),
+		Seasons:        seasons,
+	}
+
+	envelope := response.NewEnvelope(resData)
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Update TV show
+// @Description	Updates an existing TV show
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"TV show ID"
+// @Param		request	body	dto.UpdateTvShowRequest	true	"TV show data"
+// @Success		200	{object}	response.Envelope[dto.TvShowResponse]	"Successfully updated TV show"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"TV show not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows/{id} [put]
+func (h *TvShowHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TvShowHandler.Update")
+	defer span.End()
+
+	tvShowID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var updateTvShowRequest dto.UpdateTvShowRequest
+	if err = request.ReadJSON(w, r, &updateTvShowRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UpdateTvShowInput{
+		TvShowID:          tvShowID,
+		Title:             updateTvShowRequest.Title,
+		Description:       updateTvShowRequest.Description,
+		AgeRecommendation: updateTvShowRequest.AgeRecommendation,
+		ReleaseDate:       updateTvShowRequest.ReleaseDate,
+	}
+
+	output, err := h.updateTvShowUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toTvShowResponse(output))
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Delete TV show
+// @Description	Deletes a TV show together with its seasons and episodes
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"TV show ID"
+// @Success		204	"Successfully deleted TV show"
+// @Failure		400	{object}	errs.Error	"Invalid TV show ID"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"TV show not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/tv-shows/{id} [delete]
+func (h *TvShowHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "TvShowHandler.Delete")
+	defer span.End()
+
+	tvShowID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	err = h.deleteTvShowUseCase.Execute(ctx, usecase.DeleteTvShowInput{TvShowID: tvShowID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toTvShowResponse(output usecase.TvShowOutput) dto.TvShowResponse {
+	return dto.TvShowResponse{
+		TvShowID:          output.TvShowID,
+		Title:             output.Title,
+		Description:       output.Description,
+		AgeRecommendation: output.AgeRecommendation,
+		ReleaseDate:       output.ReleaseDate,
+		CreatedAt:         output.CreatedAt,
+		UpdatedAt:         output.UpdatedAt,
+	}
+}
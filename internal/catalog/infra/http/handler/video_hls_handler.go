@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+	"github.com/cristiano-pacheco/goflix/pkg/httpserver"
+)
+
+const (
+	hlsPlaylistContentType = "application/vnd.apple.mpegurl"
+	hlsSegmentExtension    = ".ts"
+)
+
+type VideoHLSHandler struct {
+	errorMapper                  shared_errs.ErrorMapper
+	findHLSMasterPlaylistUseCase *usecase.FindHLSMasterPlaylistUseCase
+	findHLSMediaPlaylistUseCase  *usecase.FindHLSMediaPlaylistUseCase
+	streamHLSSegmentUseCase      *usecase.StreamHLSSegmentUseCase
+}
+
+func NewVideoHLSHandler(
+	errorMapper shared_errs.ErrorMapper,
+	findHLSMasterPlaylistUseCase *usecase.FindHLSMasterPlaylistUseCase,
+	findHLSMediaPlaylistUseCase *usecase.FindHLSMediaPlaylistUseCase,
+	streamHLSSegmentUseCase *usecase.StreamHLSSegmentUseCase,
+) *VideoHLSHandler {
+	return &VideoHLSHandler{
+		errorMapper,
+		findHLSMasterPlaylistUseCase,
+		findHLSMediaPlaylistUseCase,
+		streamHLSSegmentUseCase,
+	}
+}
+
+// @Summary		Find HLS master playlist
+// @Description	Lists the renditions of a video packaged for adaptive streaming. The URIs in the playlist
+// @Description	carry the query of the playback URL, so players stay authorized without a token.
+// @Tags		Catalog
+// @Produce		application/vnd.apple.mpegurl
+// @Param		id		path	int		true	"Video ID"
+// @Param		uid		query	int		true	"User ID of the playback URL"
+// @Param		sid		query	string	true	"Playback session of the playback URL"
+// @Param		exp		query	int		true	"Expiry of the playback URL, in Unix seconds"
+// @Param		ip		query	string	false	"Set to 1 when the playback URL is bound to the client IP"
+// @Param		sig		query	string	true	"Signature of the playback URL"
+// @Success		200	{string}	string		"Master playlist"
+// @Failure		403	{object}	errs.Error	"Invalid or expired playback URL, or active subscription required"
+// @Failure		404	{object}	errs.Error	"Video not found or not packaged yet"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/videos/{id}/hls/master.m3u8 [get]
+func (h *VideoHLSHandler) MasterPlaylist(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoHLSHandler.MasterPlaylist")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.FindHLSMasterPlaylistInput{VideoID: videoID, AccessQuery: playbackURLQuery(r)}
+	output, err := h.findHLSMasterPlaylistUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	writePlaylist(w, output.Playlist)
+}
+
+// @Summary		Find HLS media playlist
+// @Description	Lists the segments of one rendition of a video
+// @Tags		Catalog
+// @Produce		application/vnd.apple.mpegurl
+// @Param		id			path	int		true	"Video ID"
+// @Param		rendition	path	string	true	"Rendition name, e.g. 720p"
+// @Param		uid			query	int		true	"User ID of the playback URL"
+// @Param		sid			query	string	true	"Playback session of the playback URL"
+// @Param		exp			query	int		true	"Expiry of the playback URL, in Unix seconds"
+// @Param		ip			query	string	false	"Set to 1 when the playback URL is bound to the client IP"
+// @Param		sig			query	string	true	"Signature of the playback URL"
+// @Success		200	{string}	string		"Media playlist"
+// @Failure		403	{object}	errs.Error	"Invalid or expired playback URL, or active subscription required"
+// @Failure		404	{object}	errs.Error	"Rendition not found"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/videos/{id}/hls/renditions/{rendition}/index.m3u8 [get]
+func (h *VideoHLSHandler) MediaPlaylist(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoHLSHandler.MediaPlaylist")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.FindHLSMediaPlaylistInput{
+		VideoID:       videoID,
+		RenditionName: request.Param(r, "rendition"),
+		AccessQuery:   playbackURLQuery(r),
+	}
+
+	output, err := h.findHLSMediaPlaylistUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	writePlaylist(w, output.Playlist)
+}
+
+// @Summary		Stream HLS segment
+// @Description	Serves a segment of a rendition. Supports Range and conditional requests.
+// @Tags		Catalog
+// @Produce		video/mp2t
+// @Param		id			path	int		true	"Video ID"
+// @Param		rendition	path	string	true	"Rendition name, e.g. 720p"
+// @Param		segment		path	string	true	"Segment file, e.g. 0.ts"
+// @Param		uid			query	int		true	"User ID of the playback URL"
+// @Param		sid			query	string	true	"Playback session of the playback URL"
+// @Param		exp			query	int		true	"Expiry of the playback URL, in Unix seconds"
+// @Param		ip			query	string	false	"Set to 1 when the playback URL is bound to the client IP"
+// @Param		sig			query	string	true	"Signature of the playback URL"
+// @Success		200	{file}		file		"Whole segment"
+// @Success		206	{file}		file		"Requested ranges"
+// @Success		304	"Not modified"
+// @Failure		403	{object}	errs.Error	"Invalid or expired playback URL, or active subscription required"
+// @Failure		404	{object}	errs.Error	"Segment not found"
+// @Failure		416	"Range not satisfiable"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/videos/{id}/hls/renditions/{rendition}/segments/{segment} [get]
+func (h *VideoHLSHandler) Segment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoHLSHandler.Segment")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	sequence, ok := strings.CutSuffix(request.Param(r, "segment"), hlsSegmentExtension)
+	segmentNumber, err := strconv.ParseUint(sequence, 10, 32)
+	if !ok || err != nil {
+		response.Error(w, mapError(h.errorMapper, errs.ErrVideoSegmentNotFound))
+		return
+	}
+
+	input := usecase.StreamHLSSegmentInput{
+		VideoID:       videoID,
+		RenditionName: request.Param(r, "rendition"),
+		Sequence:      uint(segmentNumber),
+	}
+
+	output, err := h.streamHLSSegmentUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+	defer output.File.Close()
+
+	header := w.Header()
+	header.Set("Content-Type", output.ContentType)
+	header.Set("Cache-Control", "private, no-transform")
+	if output.ETag != "" {
+		header.Set("ETag", `"`+output.ETag+`"`)
+	}
+
+	http.ServeContent(
+		httpserver.NewStreamingResponseWriter(w, streamIdleTimeout),
+		r.WithContext(ctx),
+		"",
+		output.LastModified,
+		output.File,
+	)
+}
+
+// playbackURLQuery keeps the parameters of the signed playback URL from the query of the
+// request, the URIs of the playlists are signed by the same grant.
+func playbackURLQuery(r *http.Request) string {
+	query := r.URL.Query()
+	accessQuery := url.Values{}
+	for _, param := range []string{
+		dto.PlaybackURLUserIDParam,
+		dto.PlaybackURLSessionIDParam,
+		dto.PlaybackURLExpiresParam,
+		dto.PlaybackURLClientIPParam,
+		dto.PlaybackURLSignatureParam,
+	} {
+		if value := query.Get(param); value != "" {
+			accessQuery.Set(param, value)
+		}
+	}
+	return accessQuery.Encode()
+}
+
+// writePlaylist does not let the playlists be cached, their URIs expire with the playback URL.
+func writePlaylist(w http.ResponseWriter, playlist string) {
+	header := w.Header()
+	header.Set("Content-Type", hlsPlaylistContentType)
+	header.Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(playlist))
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+	"github.com/cristiano-pacheco/goflix/pkg/httpserver"
+)
+
+// streamIdleTimeout drops a client that stops reading, the download itself has no time limit.
+const streamIdleTimeout = time.Minute
+
+type VideoStreamHandler struct {
+	cfg                      config.Config
+	errorMapper              shared_errs.ErrorMapper
+	streamVideoUseCase       *usecase.StreamVideoUseCase
+	createPlaybackURLUseCase *usecase.CreatePlaybackURLUseCase
+}
+
+func NewVideoStreamHandler(
+	cfg config.Config,
+	errorMapper shared_errs.ErrorMapper,
+	streamVideoUseCase *usecase.StreamVideoUseCase,
+	createPlaybackURLUseCase *usecase.CreatePlaybackURLUseCase,
+) *VideoStreamHandler {
+	return &VideoStreamHandler{cfg, errorMapper, streamVideoUseCase, createPlaybackURLUseCase}
+}
+
+// @Summary		Create playback URL
+// @Description	Signs a URL to stream the video without an Authorization header, e.g. from a native
+// @Description	player or through a CDN. The URL expires and can be bound to the IP address of the caller.
+// @Description	The same grant is signed for the HLS master playlist of the video. The URL belongs to a
+// @Description	playback session of the video and stops working once the session expires or is ended.
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	int	true	"Video ID"
+// @Param		request	body	dto.CreatePlaybackURLRequest	true	"Playback session"
+// @Success		201	{object}	response.Envelope[dto.PlaybackURLResponse]	"Successfully created playback URL"
+// @Failure		400	{object}	errs.Error	"Invalid video ID or playback session for another video"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Active subscription required"
+// @Failure		404	{object}	errs.Error	"Video or playback session not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/videos/{id}/playback-url [post]
+func (h *VideoStreamHandler) CreatePlaybackURL(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoStreamHandler.CreatePlaybackURL")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var createPlaybackURLRequest dto.CreatePlaybackURLRequest
+	if err = request.ReadJSON(w, r, &createPlaybackURLRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreatePlaybackURLInput{
+		UserID:    request.GetUserID(r),
+		VideoID:   videoID,
+		SessionID: createPlaybackURLRequest.SessionID,
+		ClientIP:  request.ClientIP(r),
+	}
+
+	output, err := h.createPlaybackURLUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	query := url.Values{}
+	query.Set(dto.PlaybackURLUserIDParam, strconv.FormatUint(output.UserID, 10))
+	query.Set(dto.PlaybackURLSessionIDParam, output.SessionID)
+	query.Set(dto.PlaybackURLExpiresParam, strconv.FormatInt(output.ExpiresAt.Unix(), 10))
+	if output.BoundToClientIP {
+		query.Set(dto.PlaybackURLClientIPParam, dto.PlaybackURLClientIPBound)
+	}
+	query.Set(dto.PlaybackURLSignatureParam, output.Signature)
+
+	signedQuery := query.Encode()
+	videoURL := fmt.Sprintf("%s/api/v1/videos/%d", strings.TrimSuffix(h.cfg.App.BaseURL, "/"), output.VideoID)
+	envelope := response.NewEnvelope(dto.PlaybackURLResponse{
+		URL:       videoURL + "/stream?" + signedQuery,
+		HLSURL:    videoURL + "/hls/master.m3u8?" + signedQuery,
+		ExpiresAt: output.ExpiresAt,
+	})
+	response.JSON(w, http.StatusCreated, envelope, nil)
+}
+
+// @Summary		Stream video
+// @Description	Serves the file of a video. Supports Range requests, multiple ranges included, and
+// @Description	conditional requests with If-Range, If-None-Match and If-Modified-Since. The access is
+// @Description	checked from the query of a URL issued by the playback URL endpoint, not from a token.
+// @Tags		Catalog
+// @Produce		video/mp4
+// @Param		id		path	int		true	"Video ID"
+// @Param		uid		query	int		true	"User ID of the playback URL"
+// @Param		sid		query	string	true	"Playback session of the playback URL"
+// @Param		exp		query	int		true	"Expiry of the playback URL, in Unix seconds"
+// @Param		ip		query	string	false	"Set to 1 when the playback URL is bound to the client IP"
+// @Param		sig		query	string	true	"Signature of the playback URL"
+// @Param		Range	header	string	false	"Byte ranges, e.g. bytes=0-1048575"
+// @Success		200	{file}		file		"Whole video"
+// @Success		206	{file}		file		"Requested ranges"
+// @Success		304	"Not modified"
+// @Failure		403	{object}	errs.Error	"Invalid or expired playback URL, or active subscription required"
+// @Failure		404	{object}	errs.Error	"Video not found"
+// @Failure		416	"Range not satisfiable"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/videos/{id}/stream [get]
+func (h *VideoStreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoStreamHandler.Stream")
+	defer span.End()
+
+	videoID, err := parseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	output, err := h.streamVideoUseCase.Execute(ctx, usecase.StreamVideoInput{VideoID: videoID})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+	defer output.File.Close()
+
+	header := w.Header()
+	header.Set("Content-Type", output.ContentType)
+	header.Set("Cache-Control", "private, no-transform")
+	if output.ETag != "" {
+		header.Set("ETag", `"`+output.ETag+`"`)
+	}
+
+	// ServeContent answers Range, If-Range and the other conditional headers and sets Accept-Ranges
+	http.ServeContent(
+		httpserver.NewStreamingResponseWriter(w, streamIdleTimeout),
+		r.WithContext(ctx),
+		"",
+		output.LastModified,
+		output.File,
+	)
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+const (
+	// UploadOffsetHeader carries the position of a chunk in the file on requests and the
+	// bytes received so far on responses.
+	UploadOffsetHeader = "Upload-Offset"
+
+	// uploadChunkTimeout replaces the server read and write timeouts, which are too short
+	// for a large chunk on a slow connection.
+	uploadChunkTimeout = 10 * time.Minute
+)
+
+type VideoUploadHandler struct {
+	errorMapper              shared_errs.ErrorMapper
+	createVideoUploadUseCase *usecase.CreateVideoUploadUseCase
+	findVideoUploadUseCase   *usecase.FindVideoUploadUseCase
+	uploadVideoChunkUseCase  *usecase.UploadVideoChunkUseCase
+	abortVideoUploadUseCase  *usecase.AbortVideoUploadUseCase
+}
+
+func NewVideoUploadHandler(
+	errorMapper shared_errs.ErrorMapper,
+	createVideoUploadUseCase *usecase.CreateVideoUploadUseCase,
+	findVideoUploadUseCase *usecase.FindVideoUploadUseCase,
+	uploadVideoChunkUseCase *usecase.UploadVideoChunkUseCase,
+	abortVideoUploadUseCase *usecase.AbortVideoUploadUseCase,
+) *VideoUploadHandler {
+	return &VideoUploadHandler{
+		errorMapper,
+		createVideoUploadUseCase,
+		findVideoUploadUseCase,
+		uploadVideoChunkUseCase,
+		abortVideoUploadUseCase,
+	}
+}
+
+// @Summary		Create video upload
+// @Description	Opens a resumable upload for the video of a movie or of an episode
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		request	body	dto.CreateVideoUploadRequest	true	"Upload data"
+// @Success		201	{object}	response.Envelope[dto.VideoUploadResponse]	"Successfully created upload"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Movie or episode not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/uploads [post]
+func (h *VideoUploadHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoUploadHandler.Create")
+	defer span.End()
+
+	var createVideoUploadRequest dto.CreateVideoUploadRequest
+	if err := request.ReadJSON(w, r, &createVideoUploadRequest); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.CreateVideoUploadInput{
+		MovieID:        createVideoUploadRequest.MovieID,
+		EpisodeID:      createVideoUploadRequest.EpisodeID,
+		ContentType:    createVideoUploadRequest.ContentType,
+		SizeInBytes:    createVideoUploadRequest.SizeInBytes,
+		ChecksumSHA256: createVideoUploadRequest.ChecksumSHA256,
+	}
+
+	output, err := h.createVideoUploadUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toVideoUploadResponse(output))
+	response.JSON(w, http.StatusCreated, envelope, uploadOffsetHeaders(output))
+}
+
+// @Summary		Find video upload
+// @Description	Retrieves an upload, interrupted uploads resume from its received bytes
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	string	true	"Upload ID"
+// @Success		200	{object}	response.Envelope[dto.VideoUploadResponse]	"Successfully retrieved upload"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Upload not found"
+// @Failure		422	{object}	errs.Error	"Invalid upload ID"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/uploads/{id} [get]
+func (h *VideoUploadHandler) Find(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoUploadHandler.Find")
+	defer span.End()
+
+	input := usecase.FindVideoUploadInput{UploadID: request.Param(r, "id")}
+	output, err := h.findVideoUploadUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toVideoUploadResponse(output))
+	response.JSON(w, http.StatusOK, envelope, uploadOffsetHeaders(output))
+}
+
+// @Summary		Upload video chunk
+// @Description	Appends the next chunk of a video upload. The body is the raw chunk and the Upload-Offset
+// @Description	header its position in the file, which must match the bytes received so far. Chunks must be
+// @Description	between 5 MiB and 64 MiB, except for the last one. The upload completes with the last chunk.
+// @Tags		Catalog
+// @Accept		application/offset+octet-stream
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id				path	string	true	"Upload ID"
+// @Param		Upload-Offset	header	int		true	"Position of the chunk in the file"
+// @Param		chunk			body	[]byte	true	"Chunk bytes"
+// @Success		200	{object}	response.Envelope[dto.VideoUploadResponse]	"Successfully uploaded chunk"
+// @Failure		400	{object}	errs.Error	"Invalid chunk or checksum mismatch"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Upload not found"
+// @Failure		409	{object}	errs.Error	"Offset mismatch or upload not pending"
+// @Failure		411	{object}	errs.Error	"Content-Length header required"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/uploads/{id} [patch]
+func (h *VideoUploadHandler) UploadChunk(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoUploadHandler.UploadChunk")
+	defer span.End()
+
+	offset, err := strconv.ParseUint(r.Header.Get(UploadOffsetHeader), 10, 64)
+	if err != nil {
+		response.Error(w, shared_errs.NewBadRequestError("invalid "+UploadOffsetHeader+" header"))
+		return
+	}
+
+	if r.ContentLength < 0 {
+		response.Error(w, h.errorMapper.MapCustomError(http.StatusLengthRequired, "Content-Length header is required"))
+		return
+	}
+
+	// Not every writer supports deadlines, the server timeouts apply then
+	controller := http.NewResponseController(w)
+	deadline := time.Now().Add(uploadChunkTimeout)
+	_ = controller.SetReadDeadline(deadline)
+	_ = controller.SetWriteDeadline(deadline)
+
+	input := usecase.UploadVideoChunkInput{
+		UploadID:    request.Param(r, "id"),
+		Offset:      offset,
+		SizeInBytes: uint64(r.ContentLength),
+		Chunk:       http.MaxBytesReader(w, r.Body, r.ContentLength),
+	}
+
+	output, err := h.uploadVideoChunkUseCase.Execute(ctx, input)
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	envelope := response.NewEnvelope(toVideoUploadResponse(output))
+	response.JSON(w, http.StatusOK, envelope, uploadOffsetHeaders(output))
+}
+
+// @Summary		Abort video upload
+// @Description	Gives up a pending upload and discards the chunks received so far
+// @Tags		Catalog
+// @Accept		json
+// @Produce		json
+// @Security 	BearerAuth
+// @Param		id	path	string	true	"Upload ID"
+// @Success		204	"Successfully aborted upload"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"Upload not found"
+// @Failure		409	{object}	errs.Error	"Upload already completed or aborted"
+// @Failure		422	{object}	errs.Error	"Invalid upload ID"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/catalog/uploads/{id} [delete]
+func (h *VideoUploadHandler) Abort(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "VideoUploadHandler.Abort")
+	defer span.End()
+
+	err := h.abortVideoUploadUseCase.Execute(ctx, usecase.AbortVideoUploadInput{UploadID: request.Param(r, "id")})
+	if err != nil {
+		response.Error(w, mapError(h.errorMapper, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func uploadOffsetHeaders(output usecase.VideoUploadOutput) http.Header {
+	headers := http.Header{}
+	headers.Set(UploadOffsetHeader, strconv.FormatUint(output.ReceivedBytes, 10))
+	return headers
+}
+
+func toVideoUploadResponse(output usecase.VideoUploadOutput) dto.VideoUploadResponse {
+	return dto.VideoUploadResponse{
+		UploadID:      output.UploadID,
+		MovieID:       output.MovieID,
+		EpisodeID:     output.EpisodeID,
+		ContentType:   output.ContentType,
+		SizeInBytes:   output.SizeInBytes,
+		ReceivedBytes: output.ReceivedBytes,
+		Status:        output.Status,
+		VideoID:       output.VideoID,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type PlaybackURLMiddleware struct {
+	playbackURLSigner service.PlaybackURLSigner
+	sessionStore      service.PlaybackSessionStore
+	errorMapper       shared_errs.ErrorMapper
+}
+
+func NewPlaybackURLMiddleware(
+	playbackURLSigner service.PlaybackURLSigner,
+	sessionStore service.PlaybackSessionStore,
+	errorMapper shared_errs.ErrorMapper,
+) *PlaybackURLMiddleware {
+	return &PlaybackURLMiddleware{playbackURLSigner, sessionStore, errorMapper}
+}
+
+// RequireSignedURL stands in for AuthMiddleware on the routes players fetch directly. It only
+// lets the request through when its query carries a valid, unexpired grant for the video of
+// the :id path parameter whose playback session is still live, and stores the user of the
+// grant in the context like AuthMiddleware does, so it can be followed by
+// RequireActiveSubscription.
+func (m *PlaybackURLMiddleware) RequireSignedURL(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grant, signature, err := parsePlaybackGrant(r)
+		if err != nil {
+			m.handleError(w, errs.ErrInvalidPlaybackSignature)
+			return
+		}
+
+		err = m.playbackURLSigner.Verify(grant, signature)
+		if err != nil {
+			m.handleError(w, err)
+			return
+		}
+
+		if grant.IsExpired(time.Now().UTC()) {
+			m.handleError(w, errs.ErrPlaybackURLExpired)
+			return
+		}
+
+		// A session ends when its heartbeats stop or the user ends it, e.g. to free a stream
+		_, err = m.sessionStore.Find(r.Context(), grant.UserID(), grant.SessionID())
+		if errors.Is(err, errs.ErrPlaybackSessionNotFound) {
+			m.handleError(w, errs.ErrPlaybackSessionEnded)
+			return
+		}
+		if err != nil {
+			response.Error(w, m.errorMapper.Map(err))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), request.UserIDKey, grant.UserID())
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func (m *PlaybackURLMiddleware) handleError(w http.ResponseWriter, err error) {
+	rError := m.errorMapper.MapCustomError(http.StatusForbidden, err.Error())
+	response.Error(w, rError)
+}
+
+// parsePlaybackGrant rebuilds the grant the URL claims to carry. Bound grants are rebuilt with
+// the IP of the request, so their signature only matches from the address they were issued to.
+func parsePlaybackGrant(r *http.Request) (model.PlaybackGrantModel, string, error) {
+	query := r.URL.Query()
+
+	videoID, err := strconv.ParseUint(request.Param(r, "id"), 10, 64)
+	if err != nil {
+		return model.PlaybackGrantModel{}, "", err
+	}
+
+	userID, err := strconv.ParseUint(query.Get(dto.PlaybackURLUserIDParam), 10, 64)
+	if err != nil {
+		return model.PlaybackGrantModel{}, "", err
+	}
+
+	sessionID := query.Get(dto.PlaybackURLSessionIDParam)
+
+	expiresAt, err := strconv.ParseInt(query.Get(dto.PlaybackURLExpiresParam), 10, 64)
+	if err != nil {
+		return model.PlaybackGrantModel{}, "", err
+	}
+
+	clientIP := ""
+	if query.Get(dto.PlaybackURLClientIPParam) == dto.PlaybackURLClientIPBound {
+		clientIP = request.ClientIP(r)
+	}
+
+	grant, err := model.RestorePlaybackGrantModel(userID, videoID, sessionID, time.Unix(expiresAt, 0), clientIP)
+	if err != nil {
+		return model.PlaybackGrantModel{}, "", err
+	}
+
+	return grant, query.Get(dto.PlaybackURLSignatureParam), nil
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type SubscriptionMiddleware struct {
+	billingFacade billing.FacadeInterface
+	errorMapper   errs.ErrorMapper
+}
+
+func NewSubscriptionMiddleware(
+	billingFacade billing.FacadeInterface,
+	errorMapper errs.ErrorMapper,
+) *SubscriptionMiddleware {
+	return &SubscriptionMiddleware{billingFacade, errorMapper}
+}
+
+// RequireActiveSubscription only lets the request through when the authenticated user has an
+// active or trialing subscription. It guards playback and streaming routes and relies on the
+// user stored in the context by AuthMiddleware, so it must be wrapped by it.
+func (m *SubscriptionMiddleware) RequireActiveSubscription(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		active, err := m.billingFacade.IsUserSubscriptionActive(r.Context(), request.GetUserID(r))
+		if err != nil {
+			rError := m.errorMapper.Map(err)
+			response.Error(w, rError)
+			return
+		}
+
+		if !active {
+			rError := m.errorMapper.Map(errs.ErrSubscriptionRequired)
+			response.Error(w, rError)
+			return
+		}
+
+		next(w, r)
+	}
+}
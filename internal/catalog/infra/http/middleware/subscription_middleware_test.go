@@ -0,0 +1,88 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	billing_mocks "github.com/cristiano-pacheco/goflix/internal/billing/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+)
+
+const testUserID = uint64(1)
+
+func TestSubscriptionMiddleware_RequireActiveSubscription(t *testing.T) {
+	t.Run("user with an active subscription is let through", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionMiddlewareSUT(t)
+		sut.billingFacade.EXPECT().IsUserSubscriptionActive(mock.Anything, testUserID).Return(true, nil).Once()
+
+		// Act
+		w := sut.do()
+
+		// Assert
+		require.Equal(t, http.StatusOK, w.Code)
+		require.True(t, sut.called)
+	})
+
+	t.Run("user without an active subscription gets 403", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionMiddlewareSUT(t)
+		sut.billingFacade.EXPECT().IsUserSubscriptionActive(mock.Anything, testUserID).Return(false, nil).Once()
+
+		// Act
+		w := sut.do()
+
+		// Assert
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), "SUBSCRIPTION_REQUIRED")
+		require.False(t, sut.called)
+	})
+
+	t.Run("failed check returns error", func(t *testing.T) {
+		// Arrange
+		sut := newSubscriptionMiddlewareSUT(t)
+		sut.billingFacade.EXPECT().IsUserSubscriptionActive(mock.Anything, testUserID).
+			Return(false, errors.New("database is down")).Once()
+
+		// Act
+		w := sut.do()
+
+		// Assert
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.False(t, sut.called)
+	})
+}
+
+type subscriptionMiddlewareSUT struct {
+	subscriptionMiddleware *middleware.SubscriptionMiddleware
+	billingFacade          *billing_mocks.MockFacadeInterface
+	called                 bool
+}
+
+func newSubscriptionMiddlewareSUT(t *testing.T) *subscriptionMiddlewareSUT {
+	t.Helper()
+
+	billingFacade := billing_mocks.NewMockFacadeInterface(t)
+	return &subscriptionMiddlewareSUT{
+		subscriptionMiddleware: middleware.NewSubscriptionMiddleware(billingFacade, errs.New(nil, nil)),
+		billingFacade:          billingFacade,
+	}
+}
+
+func (s *subscriptionMiddlewareSUT) do() *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/videos/1/stream", nil)
+	r = r.WithContext(context.WithValue(r.Context(), request.UserIDKey, testUserID))
+	w := httptest.NewRecorder()
+	s.subscriptionMiddleware.RequireActiveSubscription(func(http.ResponseWriter, *http.Request) {
+		s.called = true
+	})(w, r)
+	return w
+}
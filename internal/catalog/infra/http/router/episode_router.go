@@ -0,0 +1,38 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupEpisodeRoutes(
+	r *Router,
+	episodeHandler *handler.EpisodeHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/seasons/:id/episodes",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(episodeHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/episodes/:id",
+		authMiddleware.Middleware(episodeHandler.Find),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/catalog/episodes/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(episodeHandler.Update, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/catalog/episodes/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(episodeHandler.Delete, enum.EnumRoleAdmin)),
+	)
+}
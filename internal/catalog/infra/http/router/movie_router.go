@@ -0,0 +1,43 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupMovieRoutes(
+	r *Router,
+	movieHandler *handler.MovieHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/movies",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(movieHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/movies",
+		authMiddleware.Middleware(movieHandler.List),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/movies/:id",
+		authMiddleware.Middleware(movieHandler.Find),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/catalog/movies/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(movieHandler.Update, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/catalog/movies/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(movieHandler.Delete, enum.EnumRoleAdmin)),
+	)
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	catalog_middleware "github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupPlaybackSessionRoutes(
+	r *Router,
+	playbackSessionHandler *handler.PlaybackSessionHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	subscriptionMiddleware *catalog_middleware.SubscriptionMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/playback/sessions",
+		authMiddleware.Middleware(subscriptionMiddleware.RequireActiveSubscription(playbackSessionHandler.Start)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/playback/sessions",
+		authMiddleware.Middleware(playbackSessionHandler.List),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/playback/sessions/:id/heartbeat",
+		authMiddleware.Middleware(subscriptionMiddleware.RequireActiveSubscription(playbackSessionHandler.Heartbeat)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/playback/sessions/:id",
+		authMiddleware.Middleware(playbackSessionHandler.End),
+	)
+}
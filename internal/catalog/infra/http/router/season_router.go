@@ -0,0 +1,33 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupSeasonRoutes(
+	r *Router,
+	seasonHandler *handler.SeasonHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/tv-shows/:id/seasons",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(seasonHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/catalog/seasons/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(seasonHandler.Update, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/catalog/seasons/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(seasonHandler.Delete, enum.EnumRoleAdmin)),
+	)
+}
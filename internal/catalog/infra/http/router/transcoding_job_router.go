@@ -0,0 +1,28 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupTranscodingJobRoutes(
+	r *Router,
+	transcodingJobHandler *handler.TranscodingJobHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/videos/:id/transcoding",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(transcodingJobHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/videos/:id/transcoding",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(transcodingJobHandler.Find, enum.EnumRoleAdmin)),
+	)
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupTvShowRoutes(
+	r *Router,
+	tvShowHandler *handler.TvShowHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/tv-shows",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(tvShowHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/tv-shows",
+		authMiddleware.Middleware(tvShowHandler.List),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/tv-shows/:id",
+		authMiddleware.Middleware(tvShowHandler.Find),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/catalog/tv-shows/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(tvShowHandler.Update, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/catalog/tv-shows/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(tvShowHandler.Delete, enum.EnumRoleAdmin)),
+	)
+}
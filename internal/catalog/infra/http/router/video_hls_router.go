@@ -0,0 +1,37 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/middleware"
+)
+
+func SetupVideoHLSRoutes(
+	r *Router,
+	videoHLSHandler *handler.VideoHLSHandler,
+	subscriptionMiddleware *middleware.SubscriptionMiddleware,
+	playbackURLMiddleware *middleware.PlaybackURLMiddleware,
+) {
+	// Like the stream, every playlist and segment is authorized by the signed playback URL
+	signed := func(next http.HandlerFunc) http.HandlerFunc {
+		return playbackURLMiddleware.RequireSignedURL(subscriptionMiddleware.RequireActiveSubscription(next))
+	}
+
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/videos/:id/hls/master.m3u8",
+		signed(videoHLSHandler.MasterPlaylist),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/videos/:id/hls/renditions/:rendition/index.m3u8",
+		signed(videoHLSHandler.MediaPlaylist),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/videos/:id/hls/renditions/:rendition/segments/:segment",
+		signed(videoHLSHandler.Segment),
+	)
+}
@@ -0,0 +1,33 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	catalog_middleware "github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupVideoStreamRoutes(
+	r *Router,
+	videoStreamHandler *handler.VideoStreamHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	subscriptionMiddleware *catalog_middleware.SubscriptionMiddleware,
+	playbackURLMiddleware *catalog_middleware.PlaybackURLMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/videos/:id/playback-url",
+		authMiddleware.Middleware(subscriptionMiddleware.RequireActiveSubscription(videoStreamHandler.CreatePlaybackURL)),
+	)
+
+	// The subscription is checked again on every request, a signed URL stops working as soon
+	// as the subscription ends even if it has not expired yet
+	streamHandler := playbackURLMiddleware.RequireSignedURL(
+		subscriptionMiddleware.RequireActiveSubscription(videoStreamHandler.Stream),
+	)
+	// Players probe the size and range support with HEAD before streaming
+	router.HandlerFunc(http.MethodGet, "/api/v1/videos/:id/stream", streamHandler)
+	router.HandlerFunc(http.MethodHead, "/api/v1/videos/:id/stream", streamHandler)
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupVideoUploadRoutes(
+	r *Router,
+	videoUploadHandler *handler.VideoUploadHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/catalog/uploads",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(videoUploadHandler.Create, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/catalog/uploads/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(videoUploadHandler.Find, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodPatch,
+		"/api/v1/catalog/uploads/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(videoUploadHandler.UploadChunk, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodDelete,
+		"/api/v1/catalog/uploads/:id",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(videoUploadHandler.Abort, enum.EnumRoleAdmin)),
+	)
+}
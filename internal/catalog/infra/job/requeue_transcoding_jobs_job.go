@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/scheduler"
+)
+
+type RequeueTranscodingJobsJobResult struct {
+	fx.Out
+
+	Job scheduler.Job `group:"scheduler_jobs"`
+}
+
+// NewRequeueTranscodingJobsJob queues again the transcoding jobs that went stale, on the interval
+// set by TRANSCODER_SWEEP_INTERVAL_IN_SECONDS. The jobs themselves are run by the transcoding
+// consumer.
+func NewRequeueTranscodingJobsJob(
+	requeueStaleTranscodingJobsUseCase *usecase.RequeueStaleTranscodingJobsUseCase,
+	conf config.Config,
+	logger logger.Logger,
+) RequeueTranscodingJobsJobResult {
+	input := usecase.RequeueStaleTranscodingJobsInput{
+		JobTimeout: conf.Transcoder.GetJobTimeout(),
+	}
+
+	job := scheduler.Job{
+		Name:     "catalog:requeue-transcoding",
+		Interval: time.Duration(conf.Transcoder.SweepIntervalInSeconds) * time.Second,
+		Run: func(ctx context.Context) error {
+			output, err := requeueStaleTranscodingJobsUseCase.Execute(ctx, input)
+			if err != nil {
+				return err
+			}
+
+			if output.Requeued > 0 || output.Failed > 0 {
+				logger.Info("stale transcoding jobs queued again", "requeued", output.Requeued, "failed", output.Failed)
+			}
+			return nil
+		},
+	}
+
+	return RequeueTranscodingJobsJobResult{Job: job}
+}
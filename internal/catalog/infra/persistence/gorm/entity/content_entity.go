@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type ContentEntity struct {
+	ID                uint64     `gorm:"primarykey;autoIncrement;column:id"`
+	Type              string     `gorm:"type:content_type_enum;not null;column:type"`
+	Title             string     `gorm:"type:text;not null;column:title"`
+	Description       string     `gorm:"type:text;not null;column:description"`
+	AgeRecommendation *uint      `gorm:"type:smallint;column:age_recommendation"`
+	ReleaseDate       *time.Time `gorm:"type:date;column:release_date"`
+	CreatedAt         time.Time  `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt         time.Time  `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*ContentEntity) TableName() string {
+	return "content"
+}
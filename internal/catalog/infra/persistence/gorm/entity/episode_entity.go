@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type EpisodeEntity struct {
+	ID            uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	SeasonID      uint64    `gorm:"type:bigint;not null;column:season_id"`
+	Title         string    `gorm:"type:text;not null;column:title"`
+	Description   string    `gorm:"type:text;not null;column:description"`
+	EpisodeNumber uint      `gorm:"type:smallint;not null;column:episode_number"`
+	ThumbnailID   *uint64   `gorm:"type:bigint;column:thumbnail_id"`
+	CreatedAt     time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt     time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*EpisodeEntity) TableName() string {
+	return "episode"
+}
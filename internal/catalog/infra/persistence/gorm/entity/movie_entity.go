@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type MovieEntity struct {
+	ID             uint64        `gorm:"primarykey;autoIncrement;column:id"`
+	ExternalRating *float64      `gorm:"type:double precision;column:external_rating"`
+	ContentID      uint64        `gorm:"type:bigint;not null;unique;column:content_id"`
+	ThumbnailID    *uint64       `gorm:"type:bigint;column:thumbnail_id"`
+	Content        ContentEntity `gorm:"foreignKey:ContentID"`
+	CreatedAt      time.Time     `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt      time.Time     `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*MovieEntity) TableName() string {
+	return "movie"
+}
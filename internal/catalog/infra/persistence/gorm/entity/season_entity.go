@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type SeasonEntity struct {
+	ID           uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	TvShowID     uint64    `gorm:"type:bigint;not null;column:tv_show_id"`
+	SeasonNumber uint      `gorm:"type:smallint;not null;column:season_number"`
+	Title        *string   `gorm:"type:text;column:title"`
+	CreatedAt    time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt    time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*SeasonEntity) TableName() string {
+	return "season"
+}
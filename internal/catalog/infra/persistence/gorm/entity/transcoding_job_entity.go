@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type TranscodingJobEntity struct {
+	ID         uint64     `gorm:"primarykey;autoIncrement;column:id"`
+	VideoID    uint64     `gorm:"type:bigint;not null;column:video_id"`
+	Status     string     `gorm:"type:transcoding_job_status_enum;not null;column:status"`
+	SourceKey  string     `gorm:"type:text;not null;column:source_key"`
+	Attempts   uint       `gorm:"type:int;not null;column:attempts"`
+	LastError  string     `gorm:"type:text;not null;column:last_error"`
+	StartedAt  *time.Time `gorm:"type:timestamptz;column:started_at"`
+	FinishedAt *time.Time `gorm:"type:timestamptz;column:finished_at"`
+	CreatedAt  time.Time  `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt  time.Time  `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*TranscodingJobEntity) TableName() string {
+	return "transcoding_job"
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type VideoEntity struct {
+	ID             uint64    `gorm:"primarykey;autoIncrement;column:id"`
+	URL            string    `gorm:"type:text;not null;column:url"`
+	SizeInKB       *uint64   `gorm:"type:bigint;column:size_in_kb"`
+	Duration       *uint     `gorm:"type:int;column:duration"`
+	MovieID        *uint64   `gorm:"type:bigint;column:movie_id"`
+	EpisodeID      *uint64   `gorm:"type:bigint;column:episode_id"`
+	ContentType    *string   `gorm:"type:text;column:content_type"`
+	ChecksumSHA256 *string   `gorm:"type:char(64);column:checksum_sha256"`
+	CreatedAt      time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt      time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+func (*VideoEntity) TableName() string {
+	return "video"
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+type VideoRenditionEntity struct {
+	ID               uint64               `gorm:"primarykey;autoIncrement;column:id"`
+	VideoID          uint64               `gorm:"type:bigint;not null;column:video_id"`
+	Name             string               `gorm:"type:varchar(16);not null;column:name"`
+	Width            uint                 `gorm:"type:int;not null;column:width"`
+	Height           uint                 `gorm:"type:int;not null;column:height"`
+	Codecs           string               `gorm:"type:text;not null;column:codecs"`
+	Bandwidth        uint64               `gorm:"type:bigint;not null;column:bandwidth"`
+	AverageBandwidth uint64               `gorm:"type:bigint;not null;column:average_bandwidth"`
+	Segments         []VideoSegmentEntity `gorm:"foreignKey:RenditionID"`
+	CreatedAt        time.Time            `gorm:"type:timestamptz;default:now();column:created_at"`
+}
+
+func (*VideoRenditionEntity) TableName() string {
+	return "video_rendition"
+}
+
+type VideoSegmentEntity struct {
+	ID           uint64 `gorm:"primarykey;autoIncrement;column:id"`
+	RenditionID  uint64 `gorm:"type:bigint;not null;column:rendition_id"`
+	Sequence     uint   `gorm:"type:int;not null;column:sequence"`
+	DurationInMS int64  `gorm:"type:int;not null;column:duration_in_ms"`
+	ObjectKey    string `gorm:"type:text;not null;column:object_key"`
+	SizeInBytes  uint64 `gorm:"type:bigint;not null;column:size_in_bytes"`
+}
+
+func (*VideoSegmentEntity) TableName() string {
+	return "video_segment"
+}
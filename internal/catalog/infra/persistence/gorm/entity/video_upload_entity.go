@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type VideoUploadEntity struct {
+	ID               string                  `gorm:"type:uuid;primarykey;column:id"`
+	MovieID          *uint64                 `gorm:"type:bigint;column:movie_id"`
+	EpisodeID        *uint64                 `gorm:"type:bigint;column:episode_id"`
+	ObjectKey        string                  `gorm:"type:text;not null;column:object_key"`
+	StorageUploadID  string                  `gorm:"type:text;not null;column:storage_upload_id"`
+	ContentType      string                  `gorm:"type:text;not null;column:content_type"`
+	TotalSizeInBytes uint64                  `gorm:"type:bigint;not null;column:total_size_in_bytes"`
+	ReceivedBytes    uint64                  `gorm:"type:bigint;not null;column:received_bytes"`
+	Parts            []VideoUploadPartEntity `gorm:"type:jsonb;serializer:json;not null;column:parts"`
+	ChecksumSHA256   string                  `gorm:"type:char(64);not null;column:checksum_sha256"`
+	HashState        []byte                  `gorm:"type:bytea;column:hash_state"`
+	Status           string                  `gorm:"type:video_upload_status_enum;not null;column:status"`
+	VideoID          *uint64                 `gorm:"type:bigint;column:video_id"`
+	CreatedAt        time.Time               `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt        time.Time               `gorm:"type:timestamptz;default:now();column:updated_at"`
+}
+
+// VideoUploadPartEntity is stored as an element of the parts JSON array.
+type VideoUploadPartEntity struct {
+	Number      int    `json:"number"`
+	ETag        string `json:"etag"`
+	SizeInBytes uint64 `json:"size_in_bytes"`
+}
+
+func (*VideoUploadEntity) TableName() string {
+	return "video_upload"
+}
@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+func contentToModel(contentEntity entity.ContentEntity) (model.ContentModel, error) {
+	return model.RestoreContentModel(
+		contentEntity.ID,
+		contentEntity.Type,
+		contentEntity.Title,
+		contentEntity.Description,
+		contentEntity.AgeRecommendation,
+		contentEntity.ReleaseDate,
+		contentEntity.CreatedAt,
+		contentEntity.UpdatedAt,
+	)
+}
+
+func contentToEntity(contentModel model.ContentModel) entity.ContentEntity {
+	var ageRecommendation *uint
+	if contentModel.AgeRecommendation() != nil {
+		years := contentModel.AgeRecommendation().Years()
+		ageRecommendation = &years
+	}
+
+	contentType := contentModel.Type()
+	title := contentModel.Title()
+	description := contentModel.Description()
+
+	return entity.ContentEntity{
+		ID:                contentModel.ID(),
+		Type:              contentType.String(),
+		Title:             title.String(),
+		Description:       description.String(),
+		AgeRecommendation: ageRecommendation,
+		ReleaseDate:       contentModel.ReleaseDate(),
+		CreatedAt:         contentModel.CreatedAt(),
+		UpdatedAt:         contentModel.UpdatedAt(),
+	}
+}
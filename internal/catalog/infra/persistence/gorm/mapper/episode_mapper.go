@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type EpisodeMapper interface {
+	ToModel(entity entity.EpisodeEntity) (model.EpisodeModel, error)
+	ToEntity(model model.EpisodeModel) entity.EpisodeEntity
+}
+
+type episodeMapper struct {
+}
+
+func NewEpisodeMapper() EpisodeMapper {
+	return &episodeMapper{}
+}
+
+func (m *episodeMapper) ToModel(entity entity.EpisodeEntity) (model.EpisodeModel, error) {
+	episodeModel, err := model.RestoreEpisodeModel(
+		entity.ID,
+		entity.SeasonID,
+		entity.EpisodeNumber,
+		entity.Title,
+		entity.Description,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.EpisodeModel{}, err
+	}
+	return episodeModel, nil
+}
+
+func (m *episodeMapper) ToEntity(model model.EpisodeModel) entity.EpisodeEntity {
+	title := model.Title()
+	description := model.Description()
+
+	return entity.EpisodeEntity{
+		ID:            model.ID(),
+		SeasonID:      model.SeasonID(),
+		Title:         title.String(),
+		Description:   description.String(),
+		EpisodeNumber: model.EpisodeNumber(),
+		CreatedAt:     model.CreatedAt(),
+		UpdatedAt:     model.UpdatedAt(),
+	}
+}
@@ -0,0 +1,82 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type EpisodeMapperTestSuite struct {
+	suite.Suite
+	sut mapper.EpisodeMapper
+}
+
+func (s *EpisodeMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewEpisodeMapper()
+}
+
+func TestEpisodeMapperSuite(t *testing.T) {
+	suite.Run(t, new(EpisodeMapperTestSuite))
+}
+
+func (s *EpisodeMapperTestSuite) TestToModel_ValidEpisodeEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	episodeEntity := entity.EpisodeEntity{
+		ID:            5,
+		SeasonID:      3,
+		Title:         "Pilot",
+		Description:   "Walter receives a diagnosis.",
+		EpisodeNumber: 1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// Act
+	episodeModel, err := s.sut.ToModel(episodeEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(5), episodeModel.ID())
+	s.Equal(uint64(3), episodeModel.SeasonID())
+	s.Equal(uint(1), episodeModel.EpisodeNumber())
+	title := episodeModel.Title()
+	s.Equal("Pilot", title.String())
+	s.Equal(now.Unix(), episodeModel.CreatedAt().Unix())
+}
+
+func (s *EpisodeMapperTestSuite) TestToModel_EmptyTitle_ReturnsError() {
+	// Arrange
+	episodeEntity := entity.EpisodeEntity{
+		ID:            5,
+		SeasonID:      3,
+		Description:   "Walter receives a diagnosis.",
+		EpisodeNumber: 1,
+	}
+
+	// Act
+	_, err := s.sut.ToModel(episodeEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *EpisodeMapperTestSuite) TestToEntity_ValidEpisodeModel_ReturnsEntity() {
+	// Arrange
+	episodeModel, err := model.CreateEpisodeModel(3, 2, "Cat's in the Bag", "Aftermath.")
+	s.Require().NoError(err)
+
+	// Act
+	episodeEntity := s.sut.ToEntity(episodeModel)
+
+	// Assert
+	s.Equal(uint64(3), episodeEntity.SeasonID)
+	s.Equal(uint(2), episodeEntity.EpisodeNumber)
+	s.Equal("Cat's in the Bag", episodeEntity.Title)
+	s.Equal("Aftermath.", episodeEntity.Description)
+}
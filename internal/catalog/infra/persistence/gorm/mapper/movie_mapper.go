@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type MovieMapper interface {
+	ToModel(entity entity.MovieEntity) (model.MovieModel, error)
+	ToEntity(model model.MovieModel) entity.MovieEntity
+}
+
+type movieMapper struct {
+}
+
+func NewMovieMapper() MovieMapper {
+	return &movieMapper{}
+}
+
+func (m *movieMapper) ToModel(entity entity.MovieEntity) (model.MovieModel, error) {
+	contentModel, err := contentToModel(entity.Content)
+	if err != nil {
+		return model.MovieModel{}, err
+	}
+
+	movieModel, err := model.RestoreMovieModel(
+		entity.ID,
+		contentModel,
+		entity.ExternalRating,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.MovieModel{}, err
+	}
+	return movieModel, nil
+}
+
+func (m *movieMapper) ToEntity(model model.MovieModel) entity.MovieEntity {
+	var externalRating *float64
+	if model.ExternalRating() != nil {
+		rating := model.ExternalRating().Value()
+		externalRating = &rating
+	}
+
+	contentEntity := contentToEntity(model.Content())
+
+	return entity.MovieEntity{
+		ID:             model.ID(),
+		ExternalRating: externalRating,
+		ContentID:      contentEntity.ID,
+		Content:        contentEntity,
+		CreatedAt:      model.CreatedAt(),
+		UpdatedAt:      model.UpdatedAt(),
+	}
+}
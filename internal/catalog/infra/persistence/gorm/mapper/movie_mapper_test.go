@@ -0,0 +1,109 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type MovieMapperTestSuite struct {
+	suite.Suite
+	sut mapper.MovieMapper
+}
+
+func (s *MovieMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewMovieMapper()
+}
+
+func TestMovieMapperSuite(t *testing.T) {
+	suite.Run(t, new(MovieMapperTestSuite))
+}
+
+func (s *MovieMapperTestSuite) TestToModel_ValidMovieEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	ageRecommendation := uint(16)
+	releaseDate := time.Date(1999, time.March, 31, 0, 0, 0, 0, time.UTC)
+	externalRating := 8.7
+	movieEntity := entity.MovieEntity{
+		ID:             1,
+		ExternalRating: &externalRating,
+		ContentID:      10,
+		Content: entity.ContentEntity{
+			ID:                10,
+			Type:              enum.EnumContentTypeMovie,
+			Title:             "The Matrix",
+			Description:       "Neo wakes up.",
+			AgeRecommendation: &ageRecommendation,
+			ReleaseDate:       &releaseDate,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Act
+	movieModel, err := s.sut.ToModel(movieEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(1), movieModel.ID())
+	content := movieModel.Content()
+	s.Equal(uint64(10), content.ID())
+	title := content.Title()
+	s.Equal("The Matrix", title.String())
+	s.Equal(ageRecommendation, content.AgeRecommendation().Years())
+	s.Equal(releaseDate, *content.ReleaseDate())
+	s.InDelta(externalRating, movieModel.ExternalRating().Value(), 0.0001)
+	s.Equal(now.Unix(), movieModel.CreatedAt().Unix())
+}
+
+func (s *MovieMapperTestSuite) TestToModel_ContentOfWrongType_ReturnsError() {
+	// Arrange
+	now := time.Now().UTC()
+	movieEntity := entity.MovieEntity{
+		ID:        1,
+		ContentID: 10,
+		Content: entity.ContentEntity{
+			ID:          10,
+			Type:        enum.EnumContentTypeTvShow,
+			Title:       "Breaking Bad",
+			Description: "A teacher turns to crime.",
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Act
+	_, err := s.sut.ToModel(movieEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *MovieMapperTestSuite) TestToEntity_ValidMovieModel_ReturnsEntity() {
+	// Arrange
+	externalRating := 9.1
+	movieModel, err := model.CreateMovieModel("Inception", "Dreams within dreams.", nil, nil, &externalRating)
+	s.Require().NoError(err)
+
+	// Act
+	movieEntity := s.sut.ToEntity(movieModel)
+
+	// Assert
+	s.Equal(uint64(0), movieEntity.ID)
+	s.Require().NotNil(movieEntity.ExternalRating)
+	s.InDelta(externalRating, *movieEntity.ExternalRating, 0.0001)
+	s.Equal(enum.EnumContentTypeMovie, movieEntity.Content.Type)
+	s.Equal("Inception", movieEntity.Content.Title)
+	s.Equal("Dreams within dreams.", movieEntity.Content.Description)
+	s.Nil(movieEntity.Content.AgeRecommendation)
+	s.Nil(movieEntity.Content.ReleaseDate)
+}
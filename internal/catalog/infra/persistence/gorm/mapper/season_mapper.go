@@ -0,0 +1,50 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type SeasonMapper interface {
+	ToModel(entity entity.SeasonEntity) (model.SeasonModel, error)
+	ToEntity(model model.SeasonModel) entity.SeasonEntity
+}
+
+type seasonMapper struct {
+}
+
+func NewSeasonMapper() SeasonMapper {
+	return &seasonMapper{}
+}
+
+func (m *seasonMapper) ToModel(entity entity.SeasonEntity) (model.SeasonModel, error) {
+	seasonModel, err := model.RestoreSeasonModel(
+		entity.ID,
+		entity.TvShowID,
+		entity.SeasonNumber,
+		entity.Title,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.SeasonModel{}, err
+	}
+	return seasonModel, nil
+}
+
+func (m *seasonMapper) ToEntity(model model.SeasonModel) entity.SeasonEntity {
+	var title *string
+	if model.Title() != nil {
+		value := model.Title().String()
+		title = &value
+	}
+
+	return entity.SeasonEntity{
+		ID:           model.ID(),
+		TvShowID:     model.TvShowID(),
+		SeasonNumber: model.SeasonNumber(),
+		Title:        title,
+		CreatedAt:    model.CreatedAt(),
+		UpdatedAt:    model.UpdatedAt(),
+	}
+}
@@ -0,0 +1,79 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type SeasonMapperTestSuite struct {
+	suite.Suite
+	sut mapper.SeasonMapper
+}
+
+func (s *SeasonMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewSeasonMapper()
+}
+
+func TestSeasonMapperSuite(t *testing.T) {
+	suite.Run(t, new(SeasonMapperTestSuite))
+}
+
+func (s *SeasonMapperTestSuite) TestToModel_ValidSeasonEntityWithTitle_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	title := "Season One"
+	seasonEntity := entity.SeasonEntity{
+		ID:           3,
+		TvShowID:     2,
+		SeasonNumber: 1,
+		Title:        &title,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// Act
+	seasonModel, err := s.sut.ToModel(seasonEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(3), seasonModel.ID())
+	s.Equal(uint64(2), seasonModel.TvShowID())
+	s.Equal(uint(1), seasonModel.SeasonNumber())
+	s.Require().NotNil(seasonModel.Title())
+	s.Equal(title, seasonModel.Title().String())
+}
+
+func (s *SeasonMapperTestSuite) TestToModel_InvalidSeasonNumber_ReturnsError() {
+	// Arrange
+	seasonEntity := entity.SeasonEntity{
+		ID:           3,
+		TvShowID:     2,
+		SeasonNumber: 0,
+	}
+
+	// Act
+	_, err := s.sut.ToModel(seasonEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *SeasonMapperTestSuite) TestToEntity_SeasonModelWithoutTitle_ReturnsEntity() {
+	// Arrange
+	seasonModel, err := model.CreateSeasonModel(2, 4, "")
+	s.Require().NoError(err)
+
+	// Act
+	seasonEntity := s.sut.ToEntity(seasonModel)
+
+	// Assert
+	s.Equal(uint64(2), seasonEntity.TvShowID)
+	s.Equal(uint(4), seasonEntity.SeasonNumber)
+	s.Nil(seasonEntity.Title)
+}
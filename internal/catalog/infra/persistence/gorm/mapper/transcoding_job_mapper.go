@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type TranscodingJobMapper interface {
+	ToModel(entity entity.TranscodingJobEntity) (model.TranscodingJobModel, error)
+	ToEntity(model model.TranscodingJobModel) entity.TranscodingJobEntity
+}
+
+type transcodingJobMapper struct {
+}
+
+func NewTranscodingJobMapper() TranscodingJobMapper {
+	return &transcodingJobMapper{}
+}
+
+func (m *transcodingJobMapper) ToModel(entity entity.TranscodingJobEntity) (model.TranscodingJobModel, error) {
+	transcodingJobModel, err := model.RestoreTranscodingJobModel(
+		entity.ID,
+		entity.VideoID,
+		entity.Status,
+		entity.SourceKey,
+		entity.Attempts,
+		entity.LastError,
+		entity.StartedAt,
+		entity.FinishedAt,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.TranscodingJobModel{}, err
+	}
+	return transcodingJobModel, nil
+}
+
+func (m *transcodingJobMapper) ToEntity(model model.TranscodingJobModel) entity.TranscodingJobEntity {
+	status := model.Status()
+
+	return entity.TranscodingJobEntity{
+		ID:         model.ID(),
+		VideoID:    model.VideoID(),
+		Status:     status.String(),
+		SourceKey:  model.SourceKey(),
+		Attempts:   model.Attempts(),
+		LastError:  model.LastError(),
+		StartedAt:  model.StartedAt(),
+		FinishedAt: model.FinishedAt(),
+		CreatedAt:  model.CreatedAt(),
+		UpdatedAt:  model.UpdatedAt(),
+	}
+}
@@ -0,0 +1,89 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type TranscodingJobMapperTestSuite struct {
+	suite.Suite
+	sut mapper.TranscodingJobMapper
+}
+
+func (s *TranscodingJobMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewTranscodingJobMapper()
+}
+
+func TestTranscodingJobMapperSuite(t *testing.T) {
+	suite.Run(t, new(TranscodingJobMapperTestSuite))
+}
+
+func (s *TranscodingJobMapperTestSuite) TestToModel_ValidTranscodingJobEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	jobEntity := entity.TranscodingJobEntity{
+		ID:        4,
+		VideoID:   2,
+		Status:    enum.EnumTranscodingJobStatusRunning,
+		SourceKey: "videos/movies/1/source",
+		Attempts:  2,
+		LastError: "ffmpeg exited with status 1",
+		StartedAt: &now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Act
+	jobModel, err := s.sut.ToModel(jobEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(4), jobModel.ID())
+	s.Equal(uint64(2), jobModel.VideoID())
+	status := jobModel.Status()
+	s.Equal(enum.EnumTranscodingJobStatusRunning, status.String())
+	s.Equal("videos/movies/1/source", jobModel.SourceKey())
+	s.Equal(uint(2), jobModel.Attempts())
+	s.Equal("ffmpeg exited with status 1", jobModel.LastError())
+	s.Equal(&now, jobModel.StartedAt())
+	s.Nil(jobModel.FinishedAt())
+}
+
+func (s *TranscodingJobMapperTestSuite) TestToModel_InvalidStatus_ReturnsError() {
+	// Arrange
+	jobEntity := entity.TranscodingJobEntity{
+		ID:        4,
+		VideoID:   2,
+		Status:    "Unknown",
+		SourceKey: "videos/movies/1/source",
+	}
+
+	// Act
+	_, err := s.sut.ToModel(jobEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *TranscodingJobMapperTestSuite) TestToEntity_ValidTranscodingJobModel_ReturnsEntity() {
+	// Arrange
+	jobModel, err := model.CreateTranscodingJobModel(2, "videos/movies/1/source")
+	s.Require().NoError(err)
+
+	// Act
+	jobEntity := s.sut.ToEntity(jobModel)
+
+	// Assert
+	s.Equal(uint64(2), jobEntity.VideoID)
+	s.Equal(enum.EnumTranscodingJobStatusPending, jobEntity.Status)
+	s.Equal("videos/movies/1/source", jobEntity.SourceKey)
+	s.Zero(jobEntity.Attempts)
+	s.Nil(jobEntity.StartedAt)
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type TvShowMapper interface {
+	ToModel(entity entity.TvShowEntity) (model.TvShowModel, error)
+	ToEntity(model model.TvShowModel) entity.TvShowEntity
+}
+
+type tvShowMapper struct {
+}
+
+func NewTvShowMapper() TvShowMapper {
+	return &tvShowMapper{}
+}
+
+func (m *tvShowMapper) ToModel(entity entity.TvShowEntity) (model.TvShowModel, error) {
+	contentModel, err := contentToModel(entity.Content)
+	if err != nil {
+		return model.TvShowModel{}, err
+	}
+
+	tvShowModel, err := model.RestoreTvShowModel(
+		entity.ID,
+		contentModel,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.TvShowModel{}, err
+	}
+	return tvShowModel, nil
+}
+
+func (m *tvShowMapper) ToEntity(model model.TvShowModel) entity.TvShowEntity {
+	contentEntity := contentToEntity(model.Content())
+
+	return entity.TvShowEntity{
+		ID:        model.ID(),
+		ContentID: contentEntity.ID,
+		Content:   contentEntity,
+		CreatedAt: model.CreatedAt(),
+		UpdatedAt: model.UpdatedAt(),
+	}
+}
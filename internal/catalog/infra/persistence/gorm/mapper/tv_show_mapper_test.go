@@ -0,0 +1,94 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type TvShowMapperTestSuite struct {
+	suite.Suite
+	sut mapper.TvShowMapper
+}
+
+func (s *TvShowMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewTvShowMapper()
+}
+
+func TestTvShowMapperSuite(t *testing.T) {
+	suite.Run(t, new(TvShowMapperTestSuite))
+}
+
+func (s *TvShowMapperTestSuite) TestToModel_ValidTvShowEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	tvShowEntity := entity.TvShowEntity{
+		ID:        2,
+		ContentID: 20,
+		Content: entity.ContentEntity{
+			ID:          20,
+			Type:        enum.EnumContentTypeTvShow,
+			Title:       "Breaking Bad",
+			Description: "A teacher turns to crime.",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Act
+	tvShowModel, err := s.sut.ToModel(tvShowEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(2), tvShowModel.ID())
+	content := tvShowModel.Content()
+	s.Equal(uint64(20), content.ID())
+	title := content.Title()
+	s.Equal("Breaking Bad", title.String())
+	s.Nil(content.AgeRecommendation())
+	s.Nil(content.ReleaseDate())
+}
+
+func (s *TvShowMapperTestSuite) TestToModel_InvalidContentType_ReturnsError() {
+	// Arrange
+	tvShowEntity := entity.TvShowEntity{
+		ID: 2,
+		Content: entity.ContentEntity{
+			ID:          20,
+			Type:        "DOCUMENTARY",
+			Title:       "Planet Earth",
+			Description: "Nature documentary.",
+		},
+	}
+
+	// Act
+	_, err := s.sut.ToModel(tvShowEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *TvShowMapperTestSuite) TestToEntity_ValidTvShowModel_ReturnsEntity() {
+	// Arrange
+	ageRecommendation := uint(18)
+	tvShowModel, err := model.CreateTvShowModel("Breaking Bad", "A teacher turns to crime.", &ageRecommendation, nil)
+	s.Require().NoError(err)
+
+	// Act
+	tvShowEntity := s.sut.ToEntity(tvShowModel)
+
+	// Assert
+	s.Equal(uint64(0), tvShowEntity.ID)
+	s.Equal(enum.EnumContentTypeTvShow, tvShowEntity.Content.Type)
+	s.Equal("Breaking Bad", tvShowEntity.Content.Title)
+	s.Require().NotNil(tvShowEntity.Content.AgeRecommendation)
+	s.Equal(ageRecommendation, *tvShowEntity.Content.AgeRecommendation)
+}
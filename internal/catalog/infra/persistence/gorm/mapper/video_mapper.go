@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type VideoMapper interface {
+	ToModel(entity entity.VideoEntity) (model.VideoModel, error)
+	ToEntity(model model.VideoModel) entity.VideoEntity
+}
+
+type videoMapper struct {
+}
+
+func NewVideoMapper() VideoMapper {
+	return &videoMapper{}
+}
+
+func (m *videoMapper) ToModel(entity entity.VideoEntity) (model.VideoModel, error) {
+	var sizeInKB uint64
+	if entity.SizeInKB != nil {
+		sizeInKB = *entity.SizeInKB
+	}
+
+	var contentType string
+	if entity.ContentType != nil {
+		contentType = *entity.ContentType
+	}
+
+	var checksumSHA256 string
+	if entity.ChecksumSHA256 != nil {
+		checksumSHA256 = *entity.ChecksumSHA256
+	}
+
+	videoModel, err := model.RestoreVideoModel(
+		entity.ID,
+		entity.MovieID,
+		entity.EpisodeID,
+		entity.URL,
+		sizeInKB,
+		entity.Duration,
+		contentType,
+		checksumSHA256,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.VideoModel{}, err
+	}
+	return videoModel, nil
+}
+
+func (m *videoMapper) ToEntity(model model.VideoModel) entity.VideoEntity {
+	videoEntity := entity.VideoEntity{
+		ID:        model.ID(),
+		URL:       model.URL(),
+		Duration:  model.Duration(),
+		MovieID:   model.MovieID(),
+		EpisodeID: model.EpisodeID(),
+		CreatedAt: model.CreatedAt(),
+		UpdatedAt: model.UpdatedAt(),
+	}
+
+	if sizeInKB := model.SizeInKB(); sizeInKB != 0 {
+		videoEntity.SizeInKB = &sizeInKB
+	}
+
+	if contentType := model.ContentType(); contentType != "" {
+		videoEntity.ContentType = &contentType
+	}
+
+	if checksumSHA256 := model.ChecksumSHA256(); checksumSHA256 != "" {
+		videoEntity.ChecksumSHA256 = &checksumSHA256
+	}
+
+	return videoEntity
+}
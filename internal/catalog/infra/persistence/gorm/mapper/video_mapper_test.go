@@ -0,0 +1,105 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+const videoChecksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+type VideoMapperTestSuite struct {
+	suite.Suite
+	sut mapper.VideoMapper
+}
+
+func (s *VideoMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewVideoMapper()
+}
+
+func TestVideoMapperSuite(t *testing.T) {
+	suite.Run(t, new(VideoMapperTestSuite))
+}
+
+func (s *VideoMapperTestSuite) TestToModel_ValidVideoEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	movieID := uint64(7)
+	sizeInKB := uint64(2048)
+	contentType := "video/mp4"
+	checksum := videoChecksum
+	videoEntity := entity.VideoEntity{
+		ID:             4,
+		URL:            "videos/movies/7/a",
+		SizeInKB:       &sizeInKB,
+		MovieID:        &movieID,
+		ContentType:    &contentType,
+		ChecksumSHA256: &checksum,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	// Act
+	videoModel, err := s.sut.ToModel(videoEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(4), videoModel.ID())
+	s.Equal(&movieID, videoModel.MovieID())
+	s.Nil(videoModel.EpisodeID())
+	s.Equal(uint64(2048), videoModel.SizeInKB())
+	s.Equal("video/mp4", videoModel.ContentType())
+	s.Equal(videoChecksum, videoModel.ChecksumSHA256())
+}
+
+func (s *VideoMapperTestSuite) TestToModel_VideoEntityWithoutFileDetails_ReturnsModel() {
+	// Arrange
+	episodeID := uint64(3)
+	videoEntity := entity.VideoEntity{ID: 4, URL: "https://cdn.example.com/a.mp4", EpisodeID: &episodeID}
+
+	// Act
+	videoModel, err := s.sut.ToModel(videoEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(0), videoModel.SizeInKB())
+	s.Empty(videoModel.ContentType())
+	s.Empty(videoModel.ChecksumSHA256())
+}
+
+func (s *VideoMapperTestSuite) TestToModel_VideoEntityWithoutContent_ReturnsError() {
+	// Arrange
+	videoEntity := entity.VideoEntity{ID: 4, URL: "videos/a"}
+
+	// Act
+	_, err := s.sut.ToModel(videoEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *VideoMapperTestSuite) TestToEntity_ValidVideoModel_ReturnsEntity() {
+	// Arrange
+	movieID := uint64(7)
+	videoModel, err := model.CreateVideoModel(&movieID, nil, "videos/movies/7/a", 4096, "video/mp4", videoChecksum)
+	s.Require().NoError(err)
+
+	// Act
+	videoEntity := s.sut.ToEntity(videoModel)
+
+	// Assert
+	s.Equal("videos/movies/7/a", videoEntity.URL)
+	s.Equal(&movieID, videoEntity.MovieID)
+	s.Nil(videoEntity.EpisodeID)
+	s.Require().NotNil(videoEntity.SizeInKB)
+	s.Equal(uint64(4), *videoEntity.SizeInKB)
+	s.Require().NotNil(videoEntity.ContentType)
+	s.Equal("video/mp4", *videoEntity.ContentType)
+	s.Require().NotNil(videoEntity.ChecksumSHA256)
+	s.Equal(videoChecksum, *videoEntity.ChecksumSHA256)
+}
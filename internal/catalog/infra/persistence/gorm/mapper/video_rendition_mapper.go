@@ -0,0 +1,87 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type VideoRenditionMapper interface {
+	ToModel(entity entity.VideoRenditionEntity) (model.VideoRenditionModel, error)
+	ToEntity(model model.VideoRenditionModel) entity.VideoRenditionEntity
+	SegmentToModel(entity entity.VideoSegmentEntity) (model.VideoSegmentModel, error)
+}
+
+type videoRenditionMapper struct {
+}
+
+func NewVideoRenditionMapper() VideoRenditionMapper {
+	return &videoRenditionMapper{}
+}
+
+// ToModel maps the segments of the entity when they were loaded, a rendition loaded without
+// them gets none.
+func (m *videoRenditionMapper) ToModel(entity entity.VideoRenditionEntity) (model.VideoRenditionModel, error) {
+	segments := make([]model.VideoSegmentModel, 0, len(entity.Segments))
+	for _, segmentEntity := range entity.Segments {
+		segment, err := m.SegmentToModel(segmentEntity)
+		if err != nil {
+			return model.VideoRenditionModel{}, err
+		}
+		segments = append(segments, segment)
+	}
+
+	videoRenditionModel, err := model.RestoreVideoRenditionModel(
+		entity.ID,
+		entity.VideoID,
+		entity.Name,
+		entity.Width,
+		entity.Height,
+		entity.Codecs,
+		entity.Bandwidth,
+		entity.AverageBandwidth,
+		segments,
+		entity.CreatedAt,
+	)
+	if err != nil {
+		return model.VideoRenditionModel{}, err
+	}
+	return videoRenditionModel, nil
+}
+
+func (m *videoRenditionMapper) ToEntity(model model.VideoRenditionModel) entity.VideoRenditionEntity {
+	segments := make([]entity.VideoSegmentEntity, 0, len(model.Segments()))
+	for _, segment := range model.Segments() {
+		segments = append(segments, entity.VideoSegmentEntity{
+			RenditionID: model.ID(),
+			Sequence:    segment.Sequence(),
+			// Segments shorter than a millisecond would not be valid anymore once read back
+			DurationInMS: max(segment.Duration().Milliseconds(), 1),
+			ObjectKey:    segment.ObjectKey(),
+			SizeInBytes:  segment.SizeInBytes(),
+		})
+	}
+
+	return entity.VideoRenditionEntity{
+		ID:               model.ID(),
+		VideoID:          model.VideoID(),
+		Name:             model.Name(),
+		Width:            model.Width(),
+		Height:           model.Height(),
+		Codecs:           model.Codecs(),
+		Bandwidth:        model.Bandwidth(),
+		AverageBandwidth: model.AverageBandwidth(),
+		Segments:         segments,
+		CreatedAt:        model.CreatedAt(),
+	}
+}
+
+func (m *videoRenditionMapper) SegmentToModel(entity entity.VideoSegmentEntity) (model.VideoSegmentModel, error) {
+	return model.RestoreVideoSegmentModel(
+		entity.Sequence,
+		time.Duration(entity.DurationInMS)*time.Millisecond,
+		entity.ObjectKey,
+		entity.SizeInBytes,
+	)
+}
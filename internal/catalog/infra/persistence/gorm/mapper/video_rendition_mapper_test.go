@@ -0,0 +1,132 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type VideoRenditionMapperTestSuite struct {
+	suite.Suite
+	sut mapper.VideoRenditionMapper
+}
+
+func (s *VideoRenditionMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewVideoRenditionMapper()
+}
+
+func TestVideoRenditionMapperSuite(t *testing.T) {
+	suite.Run(t, new(VideoRenditionMapperTestSuite))
+}
+
+func (s *VideoRenditionMapperTestSuite) TestToModel_RenditionWithSegments_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	renditionEntity := entity.VideoRenditionEntity{
+		ID:               3,
+		VideoID:          1,
+		Name:             "720p",
+		Width:            1280,
+		Height:           720,
+		Codecs:           "avc1.640028,mp4a.40.2",
+		Bandwidth:        3_000_000,
+		AverageBandwidth: 2_500_000,
+		Segments: []entity.VideoSegmentEntity{
+			{
+				ID:           10,
+				RenditionID:  3,
+				Sequence:     0,
+				DurationInMS: 6006,
+				ObjectKey:    "videos/hls/1/1-1/720p/0.ts",
+				SizeInBytes:  1024,
+			},
+		},
+		CreatedAt: now,
+	}
+
+	// Act
+	renditionModel, err := s.sut.ToModel(renditionEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uint64(3), renditionModel.ID())
+	s.Equal("720p", renditionModel.Name())
+	s.Equal(uint64(3_000_000), renditionModel.Bandwidth())
+	s.Require().Len(renditionModel.Segments(), 1)
+	segment := renditionModel.Segments()[0]
+	s.Equal(6006*time.Millisecond, segment.Duration())
+	s.Equal("videos/hls/1/1-1/720p/0.ts", segment.ObjectKey())
+	s.Equal(uint64(1024), segment.SizeInBytes())
+}
+
+func (s *VideoRenditionMapperTestSuite) TestToModel_RenditionWithoutSegments_ReturnsModel() {
+	// Arrange
+	renditionEntity := entity.VideoRenditionEntity{
+		ID:               3,
+		VideoID:          1,
+		Name:             "720p",
+		Width:            1280,
+		Height:           720,
+		Codecs:           "avc1.640028,mp4a.40.2",
+		Bandwidth:        3_000_000,
+		AverageBandwidth: 2_500_000,
+	}
+
+	// Act
+	renditionModel, err := s.sut.ToModel(renditionEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Empty(renditionModel.Segments())
+}
+
+func (s *VideoRenditionMapperTestSuite) TestToModel_InvalidSegment_ReturnsError() {
+	// Arrange
+	renditionEntity := entity.VideoRenditionEntity{
+		ID:               3,
+		VideoID:          1,
+		Name:             "720p",
+		Width:            1280,
+		Height:           720,
+		Codecs:           "avc1.640028,mp4a.40.2",
+		Bandwidth:        3_000_000,
+		AverageBandwidth: 2_500_000,
+		Segments:         []entity.VideoSegmentEntity{{Sequence: 0, DurationInMS: 0, ObjectKey: "key", SizeInBytes: 1}},
+	}
+
+	// Act
+	_, err := s.sut.ToModel(renditionEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *VideoRenditionMapperTestSuite) TestToEntity_ValidRenditionModel_ReturnsEntity() {
+	// Arrange
+	segment, err := model.CreateVideoSegmentModel(0, 4*time.Second, "videos/hls/1/1-1/720p/0.ts", 1_000_000)
+	s.Require().NoError(err)
+	renditionModel, err := model.CreateVideoRenditionModel(
+		1, "720p", 1280, 720, "avc1.640028,mp4a.40.2", []model.VideoSegmentModel{segment},
+	)
+	s.Require().NoError(err)
+
+	// Act
+	renditionEntity := s.sut.ToEntity(renditionModel)
+
+	// Assert
+	s.Equal(uint64(1), renditionEntity.VideoID)
+	s.Equal("720p", renditionEntity.Name)
+	s.Equal(uint64(2_000_000), renditionEntity.Bandwidth)
+	s.Equal(uint64(2_000_000), renditionEntity.AverageBandwidth)
+	s.Equal(
+		[]entity.VideoSegmentEntity{
+			{Sequence: 0, DurationInMS: 4000, ObjectKey: "videos/hls/1/1-1/720p/0.ts", SizeInBytes: 1_000_000},
+		},
+		renditionEntity.Segments,
+	)
+}
@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+)
+
+type VideoUploadMapper interface {
+	ToModel(entity entity.VideoUploadEntity) (model.VideoUploadModel, error)
+	ToEntity(model model.VideoUploadModel) entity.VideoUploadEntity
+}
+
+type videoUploadMapper struct {
+}
+
+func NewVideoUploadMapper() VideoUploadMapper {
+	return &videoUploadMapper{}
+}
+
+func (m *videoUploadMapper) ToModel(entity entity.VideoUploadEntity) (model.VideoUploadModel, error) {
+	parts := make([]model.VideoUploadPartModel, 0, len(entity.Parts))
+	for _, part := range entity.Parts {
+		parts = append(parts, model.CreateVideoUploadPartModel(part.Number, part.ETag, part.SizeInBytes))
+	}
+
+	videoUploadModel, err := model.RestoreVideoUploadModel(
+		entity.ID,
+		entity.MovieID,
+		entity.EpisodeID,
+		entity.ObjectKey,
+		entity.StorageUploadID,
+		entity.ContentType,
+		entity.TotalSizeInBytes,
+		entity.ReceivedBytes,
+		parts,
+		entity.ChecksumSHA256,
+		entity.HashState,
+		entity.Status,
+		entity.VideoID,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	)
+	if err != nil {
+		return model.VideoUploadModel{}, err
+	}
+	return videoUploadModel, nil
+}
+
+func (m *videoUploadMapper) ToEntity(model model.VideoUploadModel) entity.VideoUploadEntity {
+	parts := make([]entity.VideoUploadPartEntity, 0, len(model.Parts()))
+	for _, part := range model.Parts() {
+		parts = append(parts, entity.VideoUploadPartEntity{
+			Number:      part.Number(),
+			ETag:        part.ETag(),
+			SizeInBytes: part.SizeInBytes(),
+		})
+	}
+
+	status := model.Status()
+
+	return entity.VideoUploadEntity{
+		ID:               model.ID(),
+		MovieID:          model.MovieID(),
+		EpisodeID:        model.EpisodeID(),
+		ObjectKey:        model.ObjectKey(),
+		StorageUploadID:  model.StorageUploadID(),
+		ContentType:      model.ContentType(),
+		TotalSizeInBytes: model.TotalSizeInBytes(),
+		ReceivedBytes:    model.ReceivedBytes(),
+		Parts:            parts,
+		ChecksumSHA256:   model.ChecksumSHA256(),
+		HashState:        model.HashState(),
+		Status:           status.String(),
+		VideoID:          model.VideoID(),
+		CreatedAt:        model.CreatedAt(),
+		UpdatedAt:        model.UpdatedAt(),
+	}
+}
@@ -0,0 +1,100 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+)
+
+type VideoUploadMapperTestSuite struct {
+	suite.Suite
+	sut mapper.VideoUploadMapper
+}
+
+func (s *VideoUploadMapperTestSuite) SetupTest() {
+	s.sut = mapper.NewVideoUploadMapper()
+}
+
+func TestVideoUploadMapperSuite(t *testing.T) {
+	suite.Run(t, new(VideoUploadMapperTestSuite))
+}
+
+func (s *VideoUploadMapperTestSuite) TestToModel_ValidVideoUploadEntity_ReturnsModel() {
+	// Arrange
+	now := time.Now().UTC()
+	episodeID := uint64(3)
+	uploadEntity := entity.VideoUploadEntity{
+		ID:               "2b0c1a64-5b8e-4d7c-9a39-0f6f0a9d5c11",
+		EpisodeID:        &episodeID,
+		ObjectKey:        "videos/episodes/3/2b0c1a64-5b8e-4d7c-9a39-0f6f0a9d5c11",
+		StorageUploadID:  "storage-upload-id",
+		ContentType:      "video/mp4",
+		TotalSizeInBytes: 6 * 1024 * 1024,
+		ReceivedBytes:    5 * 1024 * 1024,
+		Parts:            []entity.VideoUploadPartEntity{{Number: 1, ETag: "etag-1", SizeInBytes: 5 * 1024 * 1024}},
+		ChecksumSHA256:   videoChecksum,
+		HashState:        []byte("state"),
+		Status:           enum.EnumVideoUploadStatusPending,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	// Act
+	uploadModel, err := s.sut.ToModel(uploadEntity)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(uploadEntity.ID, uploadModel.ID())
+	s.Equal(&episodeID, uploadModel.EpisodeID())
+	s.Equal("storage-upload-id", uploadModel.StorageUploadID())
+	s.Equal(uint64(5*1024*1024), uploadModel.ReceivedBytes())
+	s.Require().Len(uploadModel.Parts(), 1)
+	part := uploadModel.Parts()[0]
+	s.Equal(1, part.Number())
+	s.Equal("etag-1", part.ETag())
+	s.Equal([]byte("state"), uploadModel.HashState())
+	s.True(uploadModel.IsPending())
+}
+
+func (s *VideoUploadMapperTestSuite) TestToModel_InvalidStatus_ReturnsError() {
+	// Arrange
+	episodeID := uint64(3)
+	uploadEntity := entity.VideoUploadEntity{
+		ID:        "2b0c1a64-5b8e-4d7c-9a39-0f6f0a9d5c11",
+		EpisodeID: &episodeID,
+		ObjectKey: "videos/episodes/3/2b0c1a64-5b8e-4d7c-9a39-0f6f0a9d5c11",
+		Status:    "Unknown",
+	}
+
+	// Act
+	_, err := s.sut.ToModel(uploadEntity)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *VideoUploadMapperTestSuite) TestToEntity_ValidVideoUploadModel_ReturnsEntity() {
+	// Arrange
+	movieID := uint64(7)
+	uploadModel, err := model.CreateVideoUploadModel(&movieID, nil, "video/mp4", 100, videoChecksum)
+	s.Require().NoError(err)
+	s.Require().NoError(uploadModel.StartStorageUpload("storage-upload-id"))
+	s.Require().NoError(uploadModel.AddPart(model.CreateVideoUploadPartModel(1, "etag-1", 100), []byte("state")))
+
+	// Act
+	uploadEntity := s.sut.ToEntity(uploadModel)
+
+	// Assert
+	s.Equal(uploadModel.ID(), uploadEntity.ID)
+	s.Equal(&movieID, uploadEntity.MovieID)
+	s.Equal("storage-upload-id", uploadEntity.StorageUploadID)
+	s.Equal(uint64(100), uploadEntity.ReceivedBytes)
+	s.Equal([]entity.VideoUploadPartEntity{{Number: 1, ETag: "etag-1", SizeInBytes: 100}}, uploadEntity.Parts)
+	s.Equal(enum.EnumVideoUploadStatusPending, uploadEntity.Status)
+}
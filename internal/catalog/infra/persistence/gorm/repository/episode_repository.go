@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type EpisodeRepository interface {
+	repository.EpisodeRepository
+}
+
+type episodeRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.EpisodeMapper
+}
+
+func NewEpisodeRepository(db *database.GoflixDB, mapper mapper.EpisodeMapper) EpisodeRepository {
+	return &episodeRepository{db, mapper}
+}
+
+func (r *episodeRepository) Create(
+	ctx context.Context,
+	episodeModel model.EpisodeModel,
+) (model.EpisodeModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.Create")
+	defer span.End()
+
+	episodeEntity := r.mapper.ToEntity(episodeModel)
+	result := r.db.FromContext(ctx).Create(&episodeEntity)
+	if result.Error != nil {
+		return model.EpisodeModel{}, result.Error
+	}
+
+	episodeModel, err := r.mapper.ToModel(episodeEntity)
+	if err != nil {
+		return model.EpisodeModel{}, err
+	}
+
+	return episodeModel, nil
+}
+
+func (r *episodeRepository) Update(ctx context.Context, episodeModel model.EpisodeModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.Update")
+	defer span.End()
+
+	episodeEntity := r.mapper.ToEntity(episodeModel)
+	result := r.db.FromContext(ctx).Omit("ThumbnailID").Save(&episodeEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *episodeRepository) Delete(ctx context.Context, id uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.Delete")
+	defer span.End()
+
+	result := r.db.FromContext(ctx).Delete(&entity.EpisodeEntity{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrEpisodeNotFound
+	}
+
+	return nil
+}
+
+func (r *episodeRepository) FindByID(ctx context.Context, id uint64) (model.EpisodeModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.FindByID")
+	defer span.End()
+
+	var episodeEntity entity.EpisodeEntity
+	r.db.FromContext(ctx).First(&episodeEntity, id)
+	if episodeEntity.ID == 0 {
+		return model.EpisodeModel{}, errs.ErrEpisodeNotFound
+	}
+
+	episodeModel, err := r.mapper.ToModel(episodeEntity)
+	if err != nil {
+		return model.EpisodeModel{}, err
+	}
+
+	return episodeModel, nil
+}
+
+func (r *episodeRepository) FindBySeasonIDs(
+	ctx context.Context,
+	seasonIDs []uint64,
+) ([]model.EpisodeModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.FindBySeasonIDs")
+	defer span.End()
+
+	if len(seasonIDs) == 0 {
+		return []model.EpisodeModel{}, nil
+	}
+
+	var episodeEntities []entity.EpisodeEntity
+	result := r.db.FromContext(ctx).
+		Where("season_id IN ?", seasonIDs).
+		Order("season_id, episode_number").
+		Find(&episodeEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	episodeModels := make([]model.EpisodeModel, 0, len(episodeEntities))
+	for _, episodeEntity := range episodeEntities {
+		episodeModel, err := r.mapper.ToModel(episodeEntity)
+		if err != nil {
+			return nil, err
+		}
+		episodeModels = append(episodeModels, episodeModel)
+	}
+
+	return episodeModels, nil
+}
+
+func (r *episodeRepository) FindBySeasonIDAndNumber(
+	ctx context.Context,
+	seasonID uint64,
+	episodeNumber uint,
+) (model.EpisodeModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "EpisodeRepository.FindBySeasonIDAndNumber")
+	defer span.End()
+
+	var episodeEntity entity.EpisodeEntity
+	r.db.FromContext(ctx).
+		Where("season_id = ? AND episode_number = ?", seasonID, episodeNumber).
+		First(&episodeEntity)
+	if episodeEntity.ID == 0 {
+		return model.EpisodeModel{}, errs.ErrEpisodeNotFound
+	}
+
+	episodeModel, err := r.mapper.ToModel(episodeEntity)
+	if err != nil {
+		return model.EpisodeModel{}, err
+	}
+
+	return episodeModel, nil
+}
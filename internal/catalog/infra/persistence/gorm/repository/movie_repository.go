@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type MovieRepository interface {
+	repository.MovieRepository
+}
+
+type movieRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.MovieMapper
+}
+
+func NewMovieRepository(db *database.GoflixDB, mapper mapper.MovieMapper) MovieRepository {
+	return &movieRepository{db, mapper}
+}
+
+func (r *movieRepository) Create(ctx context.Context, movieModel model.MovieModel) (model.MovieModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "MovieRepository.Create")
+	defer span.End()
+
+	movieEntity := r.mapper.ToEntity(movieModel)
+	err := r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&movieEntity.Content).Error; err != nil {
+			return err
+		}
+
+		movieEntity.ContentID = movieEntity.Content.ID
+		return tx.Omit("Content").Create(&movieEntity).Error
+	})
+	if err != nil {
+		return model.MovieModel{}, err
+	}
+
+	movieModel, err = r.mapper.ToModel(movieEntity)
+	if err != nil {
+		return model.MovieModel{}, err
+	}
+
+	return movieModel, nil
+}
+
+func (r *movieRepository) Update(ctx context.Context, movieModel model.MovieModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "MovieRepository.Update")
+	defer span.End()
+
+	movieEntity := r.mapper.ToEntity(movieModel)
+	return r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&movieEntity.Content).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit("Content", "ThumbnailID").Save(&movieEntity).Error
+	})
+}
+
+func (r *movieRepository) Delete(ctx context.Context, id uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "MovieRepository.Delete")
+	defer span.End()
+
+	var movieEntity entity.MovieEntity
+	r.db.FromContext(ctx).First(&movieEntity, id)
+	if movieEntity.ID == 0 {
+		return errs.ErrMovieNotFound
+	}
+
+	// the movie row is removed by the content foreign key cascade
+	result := r.db.FromContext(ctx).Delete(&entity.ContentEntity{}, movieEntity.ContentID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *movieRepository) FindByID(ctx context.Context, id uint64) (model.MovieModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "MovieRepository.FindByID")
+	defer span.End()
+
+	var movieEntity entity.MovieEntity
+	r.db.FromContext(ctx).Preload("Content").First(&movieEntity, id)
+	if movieEntity.ID == 0 {
+		return model.MovieModel{}, errs.ErrMovieNotFound
+	}
+
+	movieModel, err := r.mapper.ToModel(movieEntity)
+	if err != nil {
+		return model.MovieModel{}, err
+	}
+
+	return movieModel, nil
+}
+
+func (r *movieRepository) FindAll(ctx context.Context) ([]model.MovieModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "MovieRepository.FindAll")
+	defer span.End()
+
+	var movieEntities []entity.MovieEntity
+	result := r.db.FromContext(ctx).Preload("Content").Order("id").Find(&movieEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	movieModels := make([]model.MovieModel, 0, len(movieEntities))
+	for _, movieEntity := range movieEntities {
+		movieModel, err := r.mapper.ToModel(movieEntity)
+		if err != nil {
+			return nil, err
+		}
+		movieModels = append(movieModels, movieModel)
+	}
+
+	return movieModels, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type SeasonRepository interface {
+	repository.SeasonRepository
+}
+
+type seasonRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.SeasonMapper
+}
+
+func NewSeasonRepository(db *database.GoflixDB, mapper mapper.SeasonMapper) SeasonRepository {
+	return &seasonRepository{db, mapper}
+}
+
+func (r *seasonRepository) Create(ctx context.Context, seasonModel model.SeasonModel) (model.SeasonModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.Create")
+	defer span.End()
+
+	seasonEntity := r.mapper.ToEntity(seasonModel)
+	result := r.db.FromContext(ctx).Create(&seasonEntity)
+	if result.Error != nil {
+		return model.SeasonModel{}, result.Error
+	}
+
+	seasonModel, err := r.mapper.ToModel(seasonEntity)
+	if err != nil {
+		return model.SeasonModel{}, err
+	}
+
+	return seasonModel, nil
+}
+
+func (r *seasonRepository) Update(ctx context.Context, seasonModel model.SeasonModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.Update")
+	defer span.End()
+
+	seasonEntity := r.mapper.ToEntity(seasonModel)
+	result := r.db.FromContext(ctx).Save(&seasonEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *seasonRepository) Delete(ctx context.Context, id uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.Delete")
+	defer span.End()
+
+	result := r.db.FromContext(ctx).Delete(&entity.SeasonEntity{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrSeasonNotFound
+	}
+
+	return nil
+}
+
+func (r *seasonRepository) FindByID(ctx context.Context, id uint64) (model.SeasonModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.FindByID")
+	defer span.End()
+
+	var seasonEntity entity.SeasonEntity
+	r.db.FromContext(ctx).First(&seasonEntity, id)
+	if seasonEntity.ID == 0 {
+		return model.SeasonModel{}, errs.ErrSeasonNotFound
+	}
+
+	seasonModel, err := r.mapper.ToModel(seasonEntity)
+	if err != nil {
+		return model.SeasonModel{}, err
+	}
+
+	return seasonModel, nil
+}
+
+func (r *seasonRepository) FindByTvShowID(ctx context.Context, tvShowID uint64) ([]model.SeasonModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.FindByTvShowID")
+	defer span.End()
+
+	var seasonEntities []entity.SeasonEntity
+	result := r.db.FromContext(ctx).
+		Where("tv_show_id = ?", tvShowID).
+		Order("season_number").
+		Find(&seasonEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	seasonModels := make([]model.SeasonModel, 0, len(seasonEntities))
+	for _, seasonEntity := range seasonEntities {
+		seasonModel, err := r.mapper.ToModel(seasonEntity)
+		if err != nil {
+			return nil, err
+		}
+		seasonModels = append(seasonModels, seasonModel)
+	}
+
+	return seasonModels, nil
+}
+
+func (r *seasonRepository) FindByTvShowIDAndNumber(
+	ctx context.Context,
+	tvShowID uint64,
+	seasonNumber uint,
+) (model.SeasonModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "SeasonRepository.FindByTvShowIDAndNumber")
+	defer span.End()
+
+	var seasonEntity entity.SeasonEntity
+	r.db.FromContext(ctx).
+		Where("tv_show_id = ? AND season_number = ?", tvShowID, seasonNumber).
+		First(&seasonEntity)
+	if seasonEntity.ID == 0 {
+		return model.SeasonModel{}, errs.ErrSeasonNotFound
+	}
+
+	seasonModel, err := r.mapper.ToModel(seasonEntity)
+	if err != nil {
+		return model.SeasonModel{}, err
+	}
+
+	return seasonModel, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type TranscodingJobRepository interface {
+	repository.TranscodingJobRepository
+}
+
+type transcodingJobRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.TranscodingJobMapper
+}
+
+func NewTranscodingJobRepository(
+	db *database.GoflixDB,
+	mapper mapper.TranscodingJobMapper,
+) TranscodingJobRepository {
+	return &transcodingJobRepository{db, mapper}
+}
+
+func (r *transcodingJobRepository) Create(
+	ctx context.Context,
+	jobModel model.TranscodingJobModel,
+) (model.TranscodingJobModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingJobRepository.Create")
+	defer span.End()
+
+	jobEntity := r.mapper.ToEntity(jobModel)
+	result := r.db.FromContext(ctx).Create(&jobEntity)
+	if result.Error != nil {
+		return model.TranscodingJobModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(jobEntity)
+}
+
+// Update relies on attempts growing with every claim, so a worker whose lease went stale and
+// was claimed again cannot overwrite the job anymore.
+func (r *transcodingJobRepository) Update(
+	ctx context.Context,
+	jobModel model.TranscodingJobModel,
+	expectedAttempts uint,
+) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingJobRepository.Update")
+	defer span.End()
+
+	jobEntity := r.mapper.ToEntity(jobModel)
+	result := r.db.FromContext(ctx).
+		Model(&jobEntity).
+		Where("attempts = ?", expectedAttempts).
+		Select("*").
+		Omit("ID", "CreatedAt").
+		Updates(&jobEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrTranscodingJobClaimed
+	}
+
+	return nil
+}
+
+func (r *transcodingJobRepository) FindByID(ctx context.Context, id uint64) (model.TranscodingJobModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingJobRepository.FindByID")
+	defer span.End()
+
+	var jobEntity entity.TranscodingJobEntity
+	result := r.db.FromContext(ctx).First(&jobEntity, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.TranscodingJobModel{}, errs.ErrTranscodingJobNotFound
+		}
+		return model.TranscodingJobModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(jobEntity)
+}
+
+func (r *transcodingJobRepository) FindStale(
+	ctx context.Context,
+	staleBefore time.Time,
+) ([]model.TranscodingJobModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingJobRepository.FindStale")
+	defer span.End()
+
+	var jobEntities []entity.TranscodingJobEntity
+	result := r.db.FromContext(ctx).
+		Where(
+			"status IN ? AND updated_at < ?",
+			[]string{enum.EnumTranscodingJobStatusPending, enum.EnumTranscodingJobStatusRunning},
+			staleBefore,
+		).
+		Order("created_at ASC, id ASC").
+		Find(&jobEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	jobModels := make([]model.TranscodingJobModel, 0, len(jobEntities))
+	for _, jobEntity := range jobEntities {
+		jobModel, err := r.mapper.ToModel(jobEntity)
+		if err != nil {
+			return nil, err
+		}
+		jobModels = append(jobModels, jobModel)
+	}
+
+	return jobModels, nil
+}
+
+func (r *transcodingJobRepository) FindLatestByVideoID(
+	ctx context.Context,
+	videoID uint64,
+) (model.TranscodingJobModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingJobRepository.FindLatestByVideoID")
+	defer span.End()
+
+	var jobEntity entity.TranscodingJobEntity
+	result := r.db.FromContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("created_at DESC, id DESC").
+		First(&jobEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.TranscodingJobModel{}, errs.ErrTranscodingJobNotFound
+		}
+		return model.TranscodingJobModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(jobEntity)
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type TvShowRepository interface {
+	repository.TvShowRepository
+}
+
+type tvShowRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.TvShowMapper
+}
+
+func NewTvShowRepository(db *database.GoflixDB, mapper mapper.TvShowMapper) TvShowRepository {
+	return &tvShowRepository{db, mapper}
+}
+
+func (r *tvShowRepository) Create(ctx context.Context, tvShowModel model.TvShowModel) (model.TvShowModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TvShowRepository.Create")
+	defer span.End()
+
+	tvShowEntity := r.mapper.ToEntity(tvShowModel)
+	err := r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&tvShowEntity.Content).Error; err != nil {
+			return err
+		}
+
+		tvShowEntity.ContentID = tvShowEntity.Content.ID
+		return tx.Omit("Content").Create(&tvShowEntity).Error
+	})
+	if err != nil {
+		return model.TvShowModel{}, err
+	}
+
+	tvShowModel, err = r.mapper.ToModel(tvShowEntity)
+	if err != nil {
+		return model.TvShowModel{}, err
+	}
+
+	return tvShowModel, nil
+}
+
+func (r *tvShowRepository) Update(ctx context.Context, tvShowModel model.TvShowModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TvShowRepository.Update")
+	defer span.End()
+
+	tvShowEntity := r.mapper.ToEntity(tvShowModel)
+	return r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tvShowEntity.Content).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit("Content", "ThumbnailID").Save(&tvShowEntity).Error
+	})
+}
+
+func (r *tvShowRepository) Delete(ctx context.Context, id uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TvShowRepository.Delete")
+	defer span.End()
+
+	var tvShowEntity entity.TvShowEntity
+	r.db.FromContext(ctx).First(&tvShowEntity, id)
+	if tvShowEntity.ID == 0 {
+		return errs.ErrTvShowNotFound
+	}
+
+	// the tv show row is removed by the content foreign key cascade
+	result := r.db.FromContext(ctx).Delete(&entity.ContentEntity{}, tvShowEntity.ContentID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *tvShowRepository) FindByID(ctx context.Context, id uint64) (model.TvShowModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TvShowRepository.FindByID")
+	defer span.End()
+
+	var tvShowEntity entity.TvShowEntity
+	r.db.FromContext(ctx).Preload("Content").First(&tvShowEntity, id)
+	if tvShowEntity.ID == 0 {
+		return model.TvShowModel{}, errs.ErrTvShowNotFound
+	}
+
+	tvShowModel, err := r.mapper.ToModel(tvShowEntity)
+	if err != nil {
+		return model.TvShowModel{}, err
+	}
+
+	return tvShowModel, nil
+}
+
+func (r *tvShowRepository) FindAll(ctx context.Context) ([]model.TvShowModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TvShowRepository.FindAll")
+	defer span.End()
+
+	var tvShowEntities []entity.TvShowEntity
+	result := r.db.FromContext(ctx).Preload("Content").Order("id").Find(&tvShowEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	tvShowModels := make([]model.TvShowModel, 0, len(tvShowEntities))
+	for _, tvShowEntity := range tvShowEntities {
+		tvShowModel, err := r.mapper.ToModel(tvShowEntity)
+		if err != nil {
+			return nil, err
+		}
+		tvShowModels = append(tvShowModels, tvShowModel)
+	}
+
+	return tvShowModels, nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type VideoRenditionRepository interface {
+	repository.VideoRenditionRepository
+}
+
+type videoRenditionRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.VideoRenditionMapper
+}
+
+func NewVideoRenditionRepository(
+	db *database.GoflixDB,
+	mapper mapper.VideoRenditionMapper,
+) VideoRenditionRepository {
+	return &videoRenditionRepository{db, mapper}
+}
+
+func (r *videoRenditionRepository) ReplaceByVideoID(
+	ctx context.Context,
+	videoID uint64,
+	renditions []model.VideoRenditionModel,
+) ([]model.VideoRenditionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRenditionRepository.ReplaceByVideoID")
+	defer span.End()
+
+	var previousEntities []entity.VideoRenditionEntity
+	err := r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Preload("Segments", func(db *gorm.DB) *gorm.DB {
+				return db.Order("sequence ASC")
+			}).
+			Where("video_id = ?", videoID).
+			Find(&previousEntities)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Segments go with their rendition through the foreign key
+		result = tx.Where("video_id = ?", videoID).Delete(&entity.VideoRenditionEntity{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for _, rendition := range renditions {
+			renditionEntity := r.mapper.ToEntity(rendition)
+			result = tx.Create(&renditionEntity)
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toModels(previousEntities)
+}
+
+func (r *videoRenditionRepository) FindByVideoID(
+	ctx context.Context,
+	videoID uint64,
+) ([]model.VideoRenditionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRenditionRepository.FindByVideoID")
+	defer span.End()
+
+	var renditionEntities []entity.VideoRenditionEntity
+	result := r.db.FromContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("height DESC, bandwidth DESC").
+		Find(&renditionEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toModels(renditionEntities)
+}
+
+func (r *videoRenditionRepository) FindByVideoIDAndName(
+	ctx context.Context,
+	videoID uint64,
+	name string,
+) (model.VideoRenditionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRenditionRepository.FindByVideoIDAndName")
+	defer span.End()
+
+	var renditionEntity entity.VideoRenditionEntity
+	result := r.db.FromContext(ctx).
+		Preload("Segments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sequence ASC")
+		}).
+		Where("video_id = ? AND name = ?", videoID, name).
+		First(&renditionEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.VideoRenditionModel{}, errs.ErrVideoRenditionNotFound
+		}
+		return model.VideoRenditionModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(renditionEntity)
+}
+
+func (r *videoRenditionRepository) FindSegment(
+	ctx context.Context,
+	videoID uint64,
+	renditionName string,
+	sequence uint,
+) (model.VideoSegmentModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRenditionRepository.FindSegment")
+	defer span.End()
+
+	var segmentEntity entity.VideoSegmentEntity
+	result := r.db.FromContext(ctx).
+		Select("video_segment.*").
+		Joins("JOIN video_rendition ON video_rendition.id = video_segment.rendition_id").
+		Where(
+			"video_rendition.video_id = ? AND video_rendition.name = ? AND video_segment.sequence = ?",
+			videoID,
+			renditionName,
+			sequence,
+		).
+		First(&segmentEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.VideoSegmentModel{}, errs.ErrVideoSegmentNotFound
+		}
+		return model.VideoSegmentModel{}, result.Error
+	}
+
+	return r.mapper.SegmentToModel(segmentEntity)
+}
+
+func (r *videoRenditionRepository) toModels(
+	renditionEntities []entity.VideoRenditionEntity,
+) ([]model.VideoRenditionModel, error) {
+	renditions := make([]model.VideoRenditionModel, 0, len(renditionEntities))
+	for _, renditionEntity := range renditionEntities {
+		rendition, err := r.mapper.ToModel(renditionEntity)
+		if err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, rendition)
+	}
+	return renditions, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type VideoRepository interface {
+	repository.VideoRepository
+}
+
+type videoRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.VideoMapper
+}
+
+func NewVideoRepository(db *database.GoflixDB, mapper mapper.VideoMapper) VideoRepository {
+	return &videoRepository{db, mapper}
+}
+
+func (r *videoRepository) Create(ctx context.Context, videoModel model.VideoModel) (model.VideoModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRepository.Create")
+	defer span.End()
+
+	videoEntity := r.mapper.ToEntity(videoModel)
+	result := r.db.FromContext(ctx).Create(&videoEntity)
+	if result.Error != nil {
+		return model.VideoModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(videoEntity)
+}
+
+func (r *videoRepository) Update(ctx context.Context, videoModel model.VideoModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRepository.Update")
+	defer span.End()
+
+	videoEntity := r.mapper.ToEntity(videoModel)
+	result := r.db.FromContext(ctx).Save(&videoEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *videoRepository) FindByID(ctx context.Context, id uint64) (model.VideoModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRepository.FindByID")
+	defer span.End()
+
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *videoRepository) FindByMovieID(ctx context.Context, movieID uint64) (model.VideoModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRepository.FindByMovieID")
+	defer span.End()
+
+	return r.findOne(ctx, "movie_id = ?", movieID)
+}
+
+func (r *videoRepository) FindByEpisodeID(ctx context.Context, episodeID uint64) (model.VideoModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoRepository.FindByEpisodeID")
+	defer span.End()
+
+	return r.findOne(ctx, "episode_id = ?", episodeID)
+}
+
+func (r *videoRepository) findOne(ctx context.Context, query string, args ...any) (model.VideoModel, error) {
+	var videoEntity entity.VideoEntity
+	result := r.db.FromContext(ctx).Where(query, args...).First(&videoEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.VideoModel{}, errs.ErrVideoNotFound
+		}
+		return model.VideoModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(videoEntity)
+}
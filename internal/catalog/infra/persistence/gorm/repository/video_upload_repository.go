@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type VideoUploadRepository interface {
+	repository.VideoUploadRepository
+}
+
+type videoUploadRepository struct {
+	db     *database.GoflixDB
+	mapper mapper.VideoUploadMapper
+}
+
+func NewVideoUploadRepository(db *database.GoflixDB, mapper mapper.VideoUploadMapper) VideoUploadRepository {
+	return &videoUploadRepository{db, mapper}
+}
+
+func (r *videoUploadRepository) Create(ctx context.Context, uploadModel model.VideoUploadModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoUploadRepository.Create")
+	defer span.End()
+
+	uploadEntity := r.mapper.ToEntity(uploadModel)
+	result := r.db.FromContext(ctx).Create(&uploadEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Update only touches a row that is still pending with the same received bytes, so when two
+// requests send the same chunk only the first one to finish is recorded.
+func (r *videoUploadRepository) Update(
+	ctx context.Context,
+	uploadModel model.VideoUploadModel,
+	previousReceivedBytes uint64,
+) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoUploadRepository.Update")
+	defer span.End()
+
+	uploadEntity := r.mapper.ToEntity(uploadModel)
+	result := r.db.FromContext(ctx).
+		Model(&uploadEntity).
+		Where("status = ? AND received_bytes = ?", enum.EnumVideoUploadStatusPending, previousReceivedBytes).
+		Select("*").
+		Omit("ID", "CreatedAt").
+		Updates(&uploadEntity)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	currentUpload, err := r.FindByID(ctx, uploadModel.ID())
+	if err != nil {
+		return err
+	}
+
+	if !currentUpload.IsPending() {
+		return errs.ErrVideoUploadNotPending
+	}
+
+	return errs.ErrVideoUploadOffsetMismatch
+}
+
+func (r *videoUploadRepository) FindByID(ctx context.Context, id string) (model.VideoUploadModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoUploadRepository.FindByID")
+	defer span.End()
+
+	var uploadEntity entity.VideoUploadEntity
+	result := r.db.FromContext(ctx).Where("id = ?", id).First(&uploadEntity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.VideoUploadModel{}, errs.ErrVideoUploadNotFound
+		}
+		return model.VideoUploadModel{}, result.Error
+	}
+
+	return r.mapper.ToModel(uploadEntity)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+// fakeHLSPackager cuts the source into chunks instead of encoding it, sized so that every
+// chunk lasts the segment duration at the bit rate of the profile. The segments cannot be
+// played, but the pipeline runs end to end where ffmpeg is not installed, e.g. in tests.
+type fakeHLSPackager struct {
+	segmentDuration time.Duration
+}
+
+func newFakeHLSPackager(segmentDuration time.Duration) *fakeHLSPackager {
+	return &fakeHLSPackager{segmentDuration: segmentDuration}
+}
+
+func (p *fakeHLSPackager) sourceHeight(_ context.Context, _ string) (uint, error) {
+	return 0, nil
+}
+
+func (p *fakeHLSPackager) packageRendition(
+	_ context.Context,
+	sourcePath, outputDir string,
+	profile model.RenditionProfileModel,
+) ([]packagedSegment, error) {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close()
+
+	bitsPerSecond := uint64(profile.VideoBitrate() + profile.AudioBitrate())
+	segmentSize := max(int64(bitsPerSecond*uint64(p.segmentDuration.Milliseconds())/8/1000), 1)
+
+	var segments []packagedSegment
+	for {
+		segmentPath := filepath.Join(outputDir, strconv.Itoa(len(segments))+segmentExtension)
+		written, errWrite := p.writeSegment(source, segmentPath, segmentSize)
+		if errWrite != nil {
+			return nil, errWrite
+		}
+
+		if written == 0 {
+			break
+		}
+
+		// The last chunk lasts as long as its share of a full one
+		duration := time.Duration(int64(p.segmentDuration) * written / segmentSize)
+		segments = append(segments, packagedSegment{path: segmentPath, duration: max(duration, time.Millisecond)})
+
+		if written < segmentSize {
+			break
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, errors.New("source file is empty")
+	}
+
+	return segments, nil
+}
+
+func (p *fakeHLSPackager) writeSegment(source io.Reader, segmentPath string, size int64) (int64, error) {
+	file, err := os.Create(segmentPath)
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := io.CopyN(file, source, size)
+	if err != nil && !errors.Is(err, io.EOF) {
+		_ = file.Close()
+		return 0, err
+	}
+
+	if err = file.Close(); err != nil {
+		return 0, err
+	}
+
+	if written == 0 {
+		return 0, os.Remove(segmentPath)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+)
+
+const (
+	defaultFFmpegPath  = "ffmpeg"
+	defaultFFprobePath = "ffprobe"
+	ffmpegPlaylistName = "index.m3u8"
+	// ffmpegErrorTailLength keeps the end of the output of a failed run, where ffmpeg explains why.
+	ffmpegErrorTailLength = 500
+	// maxrate and bufsize bound the bit rate of a variable bit rate encoding, relative to the
+	// target bit rate of the profile.
+	ffmpegMaxRatePercent    = 107
+	ffmpegBufferSizePercent = 150
+)
+
+// ffmpegHLSPackager runs a local ffmpeg binary once per rendition. Key frames are forced on
+// every segment boundary, so all renditions switch at the same points.
+type ffmpegHLSPackager struct {
+	ffmpegPath      string
+	ffprobePath     string
+	segmentDuration time.Duration
+}
+
+func newFFmpegHLSPackager(ffmpegPath, ffprobePath string, segmentDuration time.Duration) *ffmpegHLSPackager {
+	if ffmpegPath == "" {
+		ffmpegPath = defaultFFmpegPath
+	}
+	if ffprobePath == "" {
+		ffprobePath = defaultFFprobePath
+	}
+
+	return &ffmpegHLSPackager{
+		ffmpegPath:      ffmpegPath,
+		ffprobePath:     ffprobePath,
+		segmentDuration: segmentDuration,
+	}
+}
+
+func (p *ffmpegHLSPackager) sourceHeight(ctx context.Context, sourcePath string) (uint, error) {
+	output, err := p.run(
+		ctx,
+		p.ffprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=height",
+		"-of", "csv=p=0",
+		sourcePath,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseUint(strings.TrimSpace(output), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("source has no video stream: %w", err)
+	}
+
+	return uint(height), nil
+}
+
+func (p *ffmpegHLSPackager) packageRendition(
+	ctx context.Context,
+	sourcePath, outputDir string,
+	profile model.RenditionProfileModel,
+) ([]packagedSegment, error) {
+	segmentSeconds := strconv.FormatFloat(p.segmentDuration.Seconds(), 'f', -1, 64)
+	videoBitrate := uint64(profile.VideoBitrate())
+
+	// Letterboxed to the exact resolution of the profile, which is what the master playlist announces
+	scale := fmt.Sprintf(
+		"scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2,setsar=1",
+		profile.Width(), profile.Height(), profile.Width(), profile.Height(),
+	)
+
+	_, err := p.run(
+		ctx,
+		p.ffmpegPath,
+		"-hide_banner", "-nostdin", "-loglevel", "error", "-y",
+		"-i", sourcePath,
+		"-map", "0:v:0", "-map", "0:a:0?",
+		"-vf", scale,
+		"-c:v", "libx264", "-profile:v", "high", "-level:v", "4.0", "-pix_fmt", "yuv420p", "-preset", "veryfast",
+		"-b:v", strconv.FormatUint(videoBitrate, 10),
+		"-maxrate", strconv.FormatUint(videoBitrate*ffmpegMaxRatePercent/100, 10),
+		"-bufsize", strconv.FormatUint(videoBitrate*ffmpegBufferSizePercent/100, 10),
+		"-force_key_frames", "expr:gte(t,n_forced*"+segmentSeconds+")",
+		"-sc_threshold", "0",
+		"-c:a", "aac", "-ac", "2", "-b:a", strconv.FormatUint(uint64(profile.AudioBitrate()), 10),
+		"-f", "hls",
+		"-hls_time", segmentSeconds,
+		"-hls_playlist_type", "vod",
+		"-hls_segment_type", "mpegts",
+		"-hls_segment_filename", filepath.Join(outputDir, "%d"+segmentExtension),
+		filepath.Join(outputDir, ffmpegPlaylistName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.readPlaylist(outputDir)
+}
+
+// readPlaylist takes the segments and their exact durations from the playlist ffmpeg wrote.
+func (p *ffmpegHLSPackager) readPlaylist(outputDir string) ([]packagedSegment, error) {
+	file, err := os.Open(filepath.Join(outputDir, ffmpegPlaylistName))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var segments []packagedSegment
+	var duration time.Duration
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			seconds, errParse := strconv.ParseFloat(value, 64)
+			if errParse != nil {
+				return nil, fmt.Errorf("invalid segment duration in ffmpeg playlist: %q", line)
+			}
+			duration = time.Duration(seconds * float64(time.Second))
+		case strings.HasPrefix(line, "#"):
+			continue
+		default:
+			segments = append(segments, packagedSegment{
+				path:     filepath.Join(outputDir, filepath.Base(line)),
+				duration: duration,
+			})
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+func (p *ffmpegHLSPackager) run(ctx context.Context, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		output := strings.TrimSpace(stderr.String())
+		if len(output) > ffmpegErrorTailLength {
+			output = output[len(output)-ffmpegErrorTailLength:]
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && output != "" {
+			return "", fmt.Errorf("%s: %w: %s", filepath.Base(name), err, output)
+		}
+		return "", fmt.Errorf("%s: %w", filepath.Base(name), err)
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	redis_client "github.com/redis/go-redis/v9"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+// The user id is a hash tag so every key of a user lands on the same slot, which the
+// scripts below need on a Redis cluster.
+const (
+	playbackSessionsKeyFormat = "catalog:playback_sessions:{%d}"
+	playbackSessionKeyFormat  = "catalog:playback_session:{%d}:%s"
+)
+
+// addPlaybackSessionScript drops the expired sessions of the user and adds the new one only
+// when the user is below the limit, in one step so concurrent starts cannot exceed it.
+// KEYS: sessions set, session. ARGV: now ms, expires at ms, max sessions, session id, payload, ttl ms.
+const addPlaybackSessionScript = `
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[4])
+redis.call('PEXPIRE', KEYS[1], ARGV[6])
+redis.call('SET', KEYS[2], ARGV[5], 'PX', ARGV[6])
+return 1
+`
+
+// refreshPlaybackSessionScript extends a session that has not expired yet.
+// KEYS: sessions set, session. ARGV: now ms, expires at ms, session id, payload, ttl ms.
+const refreshPlaybackSessionScript = `
+local expiresAt = redis.call('ZSCORE', KEYS[1], ARGV[3])
+if not expiresAt or tonumber(expiresAt) <= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], 'XX', ARGV[2], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[5])
+redis.call('SET', KEYS[2], ARGV[4], 'PX', ARGV[5])
+return 1
+`
+
+type PlaybackSessionStore interface {
+	service.PlaybackSessionStore
+}
+
+// playbackSessionStore keeps a sorted set of session ids per user, scored by their expiry,
+// next to one key per session holding its details.
+type playbackSessionStore struct {
+	redis         redis.Redis
+	conf          config.Config
+	addScript     *redis_client.Script
+	refreshScript *redis_client.Script
+}
+
+type playbackSessionData struct {
+	ID              string    `json:"id"`
+	UserID          uint64    `json:"user_id"`
+	VideoID         uint64    `json:"video_id"`
+	DeviceName      string    `json:"device_name"`
+	StartedAt       time.Time `json:"started_at"`
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at"`
+}
+
+func NewPlaybackSessionStore(redis redis.Redis, conf config.Config) PlaybackSessionStore {
+	return &playbackSessionStore{
+		redis,
+		conf,
+		redis_client.NewScript(addPlaybackSessionScript),
+		redis_client.NewScript(refreshPlaybackSessionScript),
+	}
+}
+
+func (s *playbackSessionStore) Add(ctx context.Context, session model.PlaybackSessionModel, maxSessions uint) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "PlaybackSessionStore.Add")
+	defer span.End()
+
+	payload, err := s.marshal(session)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ttl := s.ttl()
+	added, err := s.addScript.Run(
+		ctx,
+		s.redis.Client(),
+		[]string{s.sessionsKey(session.UserID()), s.sessionKey(session.UserID(), session.ID())},
+		now.UnixMilli(),
+		now.Add(ttl).UnixMilli(),
+		maxSessions,
+		session.ID(),
+		payload,
+		ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	if added == 0 {
+		return errs.ErrConcurrentStreamLimitReached
+	}
+
+	return nil
+}
+
+func (s *playbackSessionStore) Refresh(ctx context.Context, session model.PlaybackSessionModel) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "PlaybackSessionStore.Refresh")
+	defer span.End()
+
+	payload, err := s.marshal(session)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ttl := s.ttl()
+	refreshed, err := s.refreshScript.Run(
+		ctx,
+		s.redis.Client(),
+		[]string{s.sessionsKey(session.UserID()), s.sessionKey(session.UserID(), session.ID())},
+		now.UnixMilli(),
+		now.Add(ttl).UnixMilli(),
+		session.ID(),
+		payload,
+		ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	if refreshed == 0 {
+		return errs.ErrPlaybackSessionNotFound
+	}
+
+	return nil
+}
+
+func (s *playbackSessionStore) Find(
+	ctx context.Context,
+	userID uint64,
+	sessionID string,
+) (model.PlaybackSessionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "PlaybackSessionStore.Find")
+	defer span.End()
+
+	payload, err := s.redis.Client().Get(ctx, s.sessionKey(userID, sessionID)).Result()
+	if err != nil {
+		if errors.Is(err, redis_client.Nil) {
+			return model.PlaybackSessionModel{}, errs.ErrPlaybackSessionNotFound
+		}
+		return model.PlaybackSessionModel{}, err
+	}
+
+	return s.unmarshal(payload)
+}
+
+func (s *playbackSessionStore) List(ctx context.Context, userID uint64) ([]model.PlaybackSessionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "PlaybackSessionStore.List")
+	defer span.End()
+
+	client := s.redis.Client()
+	sessionsKey := s.sessionsKey(userID)
+
+	sessionIDs, err := client.ZRangeByScore(ctx, sessionsKey, &redis_client.ZRangeBy{
+		Min: "(" + strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sessionIDs) == 0 {
+		return []model.PlaybackSessionModel{}, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.sessionKey(userID, sessionID))
+	}
+
+	payloads, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]model.PlaybackSessionModel, 0, len(payloads))
+	for _, payload := range payloads {
+		// The session key may expire between the two calls
+		value, ok := payload.(string)
+		if !ok {
+			continue
+		}
+
+		session, errUnmarshal := s.unmarshal(value)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (s *playbackSessionStore) Remove(ctx context.Context, userID uint64, sessionID string) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "PlaybackSessionStore.Remove")
+	defer span.End()
+
+	var removed *redis_client.IntCmd
+	_, err := s.redis.Client().TxPipelined(ctx, func(pipe redis_client.Pipeliner) error {
+		removed = pipe.ZRem(ctx, s.sessionsKey(userID), sessionID)
+		pipe.Del(ctx, s.sessionKey(userID, sessionID))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if removed.Val() == 0 {
+		return errs.ErrPlaybackSessionNotFound
+	}
+
+	return nil
+}
+
+func (s *playbackSessionStore) ttl() time.Duration {
+	return s.conf.Playback.GetSessionTTL()
+}
+
+func (s *playbackSessionStore) sessionsKey(userID uint64) string {
+	return fmt.Sprintf(playbackSessionsKeyFormat, userID)
+}
+
+func (s *playbackSessionStore) sessionKey(userID uint64, sessionID string) string {
+	return fmt.Sprintf(playbackSessionKeyFormat, userID, sessionID)
+}
+
+func (s *playbackSessionStore) marshal(session model.PlaybackSessionModel) (string, error) {
+	payload, err := json.Marshal(playbackSessionData{
+		ID:              session.ID(),
+		UserID:          session.UserID(),
+		VideoID:         session.VideoID(),
+		DeviceName:      session.DeviceName(),
+		StartedAt:       session.StartedAt(),
+		LastHeartbeatAt: session.LastHeartbeatAt(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
+
+func (s *playbackSessionStore) unmarshal(payload string) (model.PlaybackSessionModel, error) {
+	var data playbackSessionData
+	if err := json.Unmarshal([]byte(payload), &data); err != nil {
+		return model.PlaybackSessionModel{}, err
+	}
+
+	return model.RestorePlaybackSessionModel(
+		data.ID,
+		data.UserID,
+		data.VideoID,
+		data.DeviceName,
+		data.StartedAt,
+		data.LastHeartbeatAt,
+	)
+}
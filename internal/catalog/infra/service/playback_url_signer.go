@@ -0,0 +1,134 @@
+package service
+
+import (
+	"crypto"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/registry"
+)
+
+const (
+	defaultPlaybackURLTTL = 4 * time.Hour
+	// playbackURLKeyInfo separates the HMAC key from any other key derived from the JWT key.
+	playbackURLKeyInfo   = "goflix playback url hmac key"
+	playbackURLKeyLength = 32
+)
+
+type PlaybackURLSigner interface {
+	service.PlaybackURLSigner
+}
+
+// playbackURLSigner signs with the key of the JWTs, so there is no extra secret to deploy
+// and rotating the JWT key also invalidates every playback URL. HS256 derives a symmetric
+// key from it and gives shorter URLs, RS256 signs with the private key itself.
+type playbackURLSigner struct {
+	algorithm    string
+	hmacKey      []byte
+	privateKey   *rsa.PrivateKey
+	ttl          time.Duration
+	bindClientIP bool
+}
+
+func NewPlaybackURLSigner(
+	cfg config.Config,
+	privateKeyRegistry registry.PrivateKeyRegistry,
+) (PlaybackURLSigner, error) {
+	signer := &playbackURLSigner{
+		algorithm:    cfg.Playback.URLSigningAlgorithm,
+		privateKey:   privateKeyRegistry.Get(),
+		ttl:          cfg.Playback.GetURLTTL(),
+		bindClientIP: cfg.Playback.URLBindClientIP,
+	}
+
+	if signer.ttl <= 0 {
+		signer.ttl = defaultPlaybackURLTTL
+	}
+
+	switch signer.algorithm {
+	case config.PlaybackURLSigningHMAC, "":
+		signer.algorithm = config.PlaybackURLSigningHMAC
+		secret := x509.MarshalPKCS1PrivateKey(signer.privateKey)
+		hmacKey, err := hkdf.Key(sha256.New, secret, nil, playbackURLKeyInfo, playbackURLKeyLength)
+		if err != nil {
+			return nil, err
+		}
+		signer.hmacKey = hmacKey
+	case config.PlaybackURLSigningRSA:
+		// Signs with the private key as is
+	default:
+		return nil, fmt.Errorf("unknown playback url signing algorithm %q", signer.algorithm)
+	}
+
+	return signer, nil
+}
+
+func (s *playbackURLSigner) Sign(
+	userID, videoID uint64,
+	sessionID, clientIP string,
+) (service.SignedPlaybackGrant, error) {
+	if !s.bindClientIP {
+		clientIP = ""
+	}
+
+	grant, err := model.CreatePlaybackGrantModel(userID, videoID, sessionID, s.ttl, clientIP)
+	if err != nil {
+		return service.SignedPlaybackGrant{}, err
+	}
+
+	signature, err := s.sign(grant.Payload())
+	if err != nil {
+		return service.SignedPlaybackGrant{}, err
+	}
+
+	return service.SignedPlaybackGrant{
+		Grant:     grant,
+		Signature: base64.RawURLEncoding.EncodeToString(signature),
+	}, nil
+}
+
+func (s *playbackURLSigner) Verify(grant model.PlaybackGrantModel, signature string) error {
+	decodedSignature, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return errs.ErrInvalidPlaybackSignature
+	}
+
+	payload := grant.Payload()
+	if s.algorithm == config.PlaybackURLSigningRSA {
+		digest := sha256.Sum256(payload)
+		err = rsa.VerifyPKCS1v15(&s.privateKey.PublicKey, crypto.SHA256, digest[:], decodedSignature)
+		if err != nil {
+			return errs.ErrInvalidPlaybackSignature
+		}
+		return nil
+	}
+
+	if !hmac.Equal(s.hmac(payload), decodedSignature) {
+		return errs.ErrInvalidPlaybackSignature
+	}
+	return nil
+}
+
+func (s *playbackURLSigner) sign(payload []byte) ([]byte, error) {
+	if s.algorithm == config.PlaybackURLSigningRSA {
+		digest := sha256.Sum256(payload)
+		return rsa.SignPKCS1v15(nil, s.privateKey, crypto.SHA256, digest[:])
+	}
+	return s.hmac(payload), nil
+}
+
+func (s *playbackURLSigner) hmac(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.hmacKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const TranscodingTopic = "catalog.transcode"
+
+// TranscodingMessage is the payload of the transcoding topic.
+type TranscodingMessage struct {
+	JobID uint64 `json:"job_id"`
+}
+
+type TranscodingQueue interface {
+	service.TranscodingQueue
+}
+
+type transcodingQueue struct {
+	publisher queue.Publisher
+}
+
+func NewTranscodingQueue(publisher queue.Publisher) TranscodingQueue {
+	return &transcodingQueue{publisher}
+}
+
+func (q *transcodingQueue) EnqueueTranscoding(ctx context.Context, jobID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TranscodingQueue.EnqueueTranscoding")
+	defer span.End()
+
+	return q.publisher.Publish(ctx, TranscodingTopic, TranscodingMessage{JobID: jobID})
+}
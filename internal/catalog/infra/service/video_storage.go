@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/blobstore"
+)
+
+type VideoStorage interface {
+	service.VideoStorage
+}
+
+// videoStorage keeps the video files in the blob store configured for the application,
+// the local filesystem in development and an S3 compatible bucket otherwise.
+type videoStorage struct {
+	blobStore blobstore.BlobStore
+}
+
+func NewVideoStorage(blobStore blobstore.BlobStore) VideoStorage {
+	return &videoStorage{blobStore: blobStore}
+}
+
+func (s *videoStorage) StartUpload(ctx context.Context, objectKey, contentType string) (string, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.StartUpload")
+	defer span.End()
+
+	return s.blobStore.CreateMultipartUpload(ctx, objectKey, contentType)
+}
+
+func (s *videoStorage) UploadPart(
+	ctx context.Context,
+	objectKey, storageUploadID string,
+	partNumber int,
+	reader io.Reader,
+	sizeInBytes uint64,
+) (model.VideoUploadPartModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.UploadPart")
+	defer span.End()
+
+	part, err := s.blobStore.UploadPart(ctx, objectKey, storageUploadID, partNumber, reader, int64(sizeInBytes))
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return model.VideoUploadPartModel{}, errs.ErrVideoUploadChunkIncomplete
+		}
+		return model.VideoUploadPartModel{}, err
+	}
+
+	// The local store stops writing when the reader ends, a part shorter than announced is an incomplete chunk
+	if uint64(part.Size) != sizeInBytes {
+		return model.VideoUploadPartModel{}, errs.ErrVideoUploadChunkIncomplete
+	}
+
+	return model.CreateVideoUploadPartModel(part.Number, part.ETag, uint64(part.Size)), nil
+}
+
+func (s *videoStorage) CompleteUpload(
+	ctx context.Context,
+	objectKey, storageUploadID string,
+	parts []model.VideoUploadPartModel,
+) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.CompleteUpload")
+	defer span.End()
+
+	blobParts := make([]blobstore.Part, 0, len(parts))
+	for _, part := range parts {
+		blobParts = append(blobParts, blobstore.Part{
+			Number: part.Number(),
+			ETag:   part.ETag(),
+			Size:   int64(part.SizeInBytes()),
+		})
+	}
+
+	return s.blobStore.CompleteMultipartUpload(ctx, objectKey, storageUploadID, blobParts)
+}
+
+func (s *videoStorage) AbortUpload(ctx context.Context, objectKey, storageUploadID string) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.AbortUpload")
+	defer span.End()
+
+	err := s.blobStore.AbortMultipartUpload(ctx, objectKey, storageUploadID)
+	if err != nil && !errors.Is(err, blobstore.ErrUploadNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *videoStorage) Delete(ctx context.Context, objectKey string) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.Delete")
+	defer span.End()
+
+	return s.blobStore.Delete(ctx, objectKey)
+}
+
+func (s *videoStorage) Open(ctx context.Context, objectKey string) (service.VideoFile, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoStorage.Open")
+	defer span.End()
+
+	info, err := s.blobStore.Stat(ctx, objectKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrObjectNotFound) || errors.Is(err, blobstore.ErrInvalidKey) {
+			return nil, errs.ErrVideoFileNotFound
+		}
+		return nil, err
+	}
+
+	return &videoFile{
+		ctx:       ctx,
+		blobStore: s.blobStore,
+		key:       objectKey,
+		info: service.VideoFileInfo{
+			SizeInBytes:  uint64(info.Size),
+			ContentType:  info.ContentType,
+			ETag:         info.ETag,
+			LastModified: info.LastModified,
+		},
+	}, nil
+}
+
+// videoFile opens one ranged read from the current offset to the end of the file and keeps it
+// while the reads are sequential, a seek elsewhere drops it and the next read opens a new one.
+//
+//nolint:containedctx // reads happen after Open returns, on behalf of the same request
+type videoFile struct {
+	ctx       context.Context
+	blobStore blobstore.BlobStore
+	key       string
+	info      service.VideoFileInfo
+	offset    int64
+	reader    io.ReadCloser
+}
+
+func (f *videoFile) Info() service.VideoFileInfo {
+	return f.info
+}
+
+func (f *videoFile) Read(p []byte) (int, error) {
+	size := int64(f.info.SizeInBytes)
+	if f.offset >= size {
+		return 0, io.EOF
+	}
+
+	if f.reader == nil {
+		reader, err := f.blobStore.Get(f.ctx, f.key, f.offset, size-f.offset)
+		if err != nil {
+			return 0, err
+		}
+		f.reader = reader
+	}
+
+	n, err := f.reader.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *videoFile) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	switch whence {
+	case io.SeekStart:
+		position = offset
+	case io.SeekCurrent:
+		position = f.offset + offset
+	case io.SeekEnd:
+		position = int64(f.info.SizeInBytes) + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if position < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	if position != f.offset {
+		if err := f.Close(); err != nil {
+			return 0, err
+		}
+		f.offset = position
+	}
+
+	return position, nil
+}
+
+func (f *videoFile) Close() error {
+	if f.reader == nil {
+		return nil
+	}
+
+	err := f.reader.Close()
+	f.reader = nil
+	return err
+}
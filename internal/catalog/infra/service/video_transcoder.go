@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/blobstore"
+)
+
+const (
+	defaultSegmentDuration = 6 * time.Second
+	segmentContentType     = "video/mp2t"
+	segmentExtension       = ".ts"
+)
+
+type VideoTranscoder interface {
+	service.VideoTranscoder
+}
+
+// hlsPackager encodes a source file on disk into the HLS segments of a rendition.
+type hlsPackager interface {
+	// sourceHeight returns the height of the video in the source, 0 when it is unknown.
+	sourceHeight(ctx context.Context, sourcePath string) (uint, error)
+	// packageRendition writes the segments of the rendition to outputDir and returns them in
+	// playback order.
+	packageRendition(
+		ctx context.Context,
+		sourcePath, outputDir string,
+		profile model.RenditionProfileModel,
+	) ([]packagedSegment, error)
+}
+
+type packagedSegment struct {
+	path     string
+	duration time.Duration
+}
+
+// videoTranscoder works on a local copy of the source in a scratch directory, which is removed
+// once the renditions are uploaded to the blob store.
+type videoTranscoder struct {
+	blobStore blobstore.BlobStore
+	packager  hlsPackager
+	workDir   string
+	logger    logger.Logger
+}
+
+func NewVideoTranscoder(
+	cfg config.Config,
+	blobStore blobstore.BlobStore,
+	logger logger.Logger,
+) (VideoTranscoder, error) {
+	segmentDuration := time.Duration(cfg.Transcoder.SegmentDurationInSeconds) * time.Second
+	if segmentDuration <= 0 {
+		segmentDuration = defaultSegmentDuration
+	}
+
+	var packager hlsPackager
+	switch cfg.Transcoder.Driver {
+	case config.TranscoderDriverFFmpeg, "":
+		packager = newFFmpegHLSPackager(cfg.Transcoder.FFmpegPath, cfg.Transcoder.FFprobePath, segmentDuration)
+	case config.TranscoderDriverFake:
+		packager = newFakeHLSPackager(segmentDuration)
+	default:
+		return nil, fmt.Errorf("unknown transcoder driver %q", cfg.Transcoder.Driver)
+	}
+
+	return &videoTranscoder{
+		blobStore: blobStore,
+		packager:  packager,
+		workDir:   cfg.Transcoder.WorkDir,
+		logger:    logger,
+	}, nil
+}
+
+func (t *videoTranscoder) Transcode(
+	ctx context.Context,
+	input service.TranscodeInput,
+) ([]model.VideoRenditionModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "VideoTranscoder.Transcode")
+	defer span.End()
+
+	workDir, err := os.MkdirTemp(t.workDir, "goflix-transcode-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errRemove := os.RemoveAll(workDir); errRemove != nil {
+			t.logger.Error("error removing transcoder work dir", "error", errRemove, "path", workDir)
+		}
+	}()
+
+	sourcePath := filepath.Join(workDir, "source")
+	err = t.download(ctx, input.SourceKey, sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := t.selectProfiles(ctx, sourcePath, input.Profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploadedKeys []string
+	renditions := make([]model.VideoRenditionModel, 0, len(profiles))
+	for _, profile := range profiles {
+		rendition, keys, errRendition := t.transcodeRendition(ctx, input, sourcePath, workDir, profile)
+		uploadedKeys = append(uploadedKeys, keys...)
+		if errRendition != nil {
+			t.deleteSegments(ctx, uploadedKeys)
+			return nil, errRendition
+		}
+		renditions = append(renditions, rendition)
+	}
+
+	return renditions, nil
+}
+
+// selectProfiles leaves out the profiles that would upscale the source, but keeps the lowest
+// one when the source is smaller than all of them.
+func (t *videoTranscoder) selectProfiles(
+	ctx context.Context,
+	sourcePath string,
+	profiles []model.RenditionProfileModel,
+) ([]model.RenditionProfileModel, error) {
+	if len(profiles) == 0 {
+		return nil, errors.New("no rendition profile to transcode")
+	}
+
+	height, err := t.packager.sourceHeight(ctx, sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		return profiles, nil
+	}
+
+	selected := make([]model.RenditionProfileModel, 0, len(profiles))
+	lowest := profiles[0]
+	for _, profile := range profiles {
+		if profile.Height() <= height {
+			selected = append(selected, profile)
+		}
+		if profile.Height() < lowest.Height() {
+			lowest = profile
+		}
+	}
+
+	if len(selected) == 0 {
+		selected = append(selected, lowest)
+	}
+
+	return selected, nil
+}
+
+// transcodeRendition returns the keys of the segments it uploaded even when it fails, so that
+// the caller can delete them.
+func (t *videoTranscoder) transcodeRendition(
+	ctx context.Context,
+	input service.TranscodeInput,
+	sourcePath, workDir string,
+	profile model.RenditionProfileModel,
+) (model.VideoRenditionModel, []string, error) {
+	outputDir := filepath.Join(workDir, profile.Name())
+	err := os.Mkdir(outputDir, 0o700)
+	if err != nil {
+		return model.VideoRenditionModel{}, nil, err
+	}
+
+	packagedSegments, err := t.packager.packageRendition(ctx, sourcePath, outputDir, profile)
+	if err != nil {
+		return model.VideoRenditionModel{}, nil, err
+	}
+
+	uploadedKeys := make([]string, 0, len(packagedSegments))
+	segments := make([]model.VideoSegmentModel, 0, len(packagedSegments))
+	for i, packaged := range packagedSegments {
+		sequence := uint(i)
+		objectKey := path.Join(
+			input.OutputPrefix,
+			profile.Name(),
+			strconv.FormatUint(uint64(sequence), 10)+segmentExtension,
+		)
+
+		sizeInBytes, errUpload := t.upload(ctx, packaged.path, objectKey)
+		if errUpload != nil {
+			return model.VideoRenditionModel{}, uploadedKeys, errUpload
+		}
+		uploadedKeys = append(uploadedKeys, objectKey)
+
+		segment, errSegment := model.CreateVideoSegmentModel(sequence, packaged.duration, objectKey, sizeInBytes)
+		if errSegment != nil {
+			return model.VideoRenditionModel{}, uploadedKeys, errSegment
+		}
+		segments = append(segments, segment)
+	}
+
+	rendition, err := model.CreateVideoRenditionModel(
+		input.VideoID,
+		profile.Name(),
+		profile.Width(),
+		profile.Height(),
+		profile.Codecs(),
+		segments,
+	)
+	if err != nil {
+		return model.VideoRenditionModel{}, uploadedKeys, err
+	}
+
+	return rendition, uploadedKeys, nil
+}
+
+func (t *videoTranscoder) download(ctx context.Context, objectKey, destinationPath string) error {
+	reader, err := t.blobStore.Get(ctx, objectKey, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.Create(destinationPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (t *videoTranscoder) upload(ctx context.Context, sourcePath, objectKey string) (uint64, error) {
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	err = t.blobStore.Put(ctx, objectKey, file, info.Size(), segmentContentType)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(info.Size()), nil
+}
+
+func (t *videoTranscoder) deleteSegments(ctx context.Context, objectKeys []string) {
+	for _, objectKey := range objectKeys {
+		err := t.blobStore.Delete(ctx, objectKey)
+		if err != nil {
+			t.logger.Error("error deleting segment of failed transcoding", "error", err, "objectKey", objectKey)
+		}
+	}
+}
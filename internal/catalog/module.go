@@ -0,0 +1,174 @@
+package catalog
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/catalog/application/usecase"
+	domain_repository "github.com/cristiano-pacheco/goflix/internal/catalog/domain/repository"
+	domain_service "github.com/cristiano-pacheco/goflix/internal/catalog/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/consumer"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/http/router"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/job"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/persistence/gorm/repository"
+	"github.com/cristiano-pacheco/goflix/internal/catalog/infra/service"
+)
+
+var Module = fx.Module(
+	"catalog",
+	fx.Provide(
+		// #################### APPLICATION ####################################
+		// usecases
+		usecase.NewCreateMovieUseCase,
+		usecase.NewUpdateMovieUseCase,
+		usecase.NewFindMovieUseCase,
+		usecase.NewListMoviesUseCase,
+		usecase.NewDeleteMovieUseCase,
+		usecase.NewCreateTvShowUseCase,
+		usecase.NewUpdateTvShowUseCase,
+		usecase.NewFindTvShowUseCase,
+		usecase.NewListTvShowsUseCase,
+		usecase.NewDeleteTvShowUseCase,
+		usecase.NewCreateSeasonUseCase,
+		usecase.NewUpdateSeasonUseCase,
+		usecase.NewDeleteSeasonUseCase,
+		usecase.NewCreateEpisodeUseCase,
+		usecase.NewUpdateEpisodeUseCase,
+		usecase.NewFindEpisodeUseCase,
+		usecase.NewDeleteEpisodeUseCase,
+		usecase.NewStartPlaybackSessionUseCase,
+		usecase.NewHeartbeatPlaybackSessionUseCase,
+		usecase.NewListPlaybackSessionsUseCase,
+		usecase.NewEndPlaybackSessionUseCase,
+		usecase.NewCreateVideoUploadUseCase,
+		usecase.NewFindVideoUploadUseCase,
+		usecase.NewUploadVideoChunkUseCase,
+		usecase.NewAbortVideoUploadUseCase,
+		usecase.NewStreamVideoUseCase,
+		usecase.NewCreatePlaybackURLUseCase,
+		usecase.NewCreateTranscodingJobUseCase,
+		usecase.NewFindTranscodingJobUseCase,
+		usecase.NewProcessTranscodingJobUseCase,
+		usecase.NewRequeueStaleTranscodingJobsUseCase,
+		usecase.NewFindHLSMasterPlaylistUseCase,
+		usecase.NewFindHLSMediaPlaylistUseCase,
+		usecase.NewStreamHLSSegmentUseCase,
+
+		// #################### DOMAIN #########################################
+		domain_service.NewHLSPlaylistService,
+
+		// #################### INFRA ##########################################
+		router.NewRouter,
+
+		// handlers
+		handler.NewMovieHandler,
+		handler.NewTvShowHandler,
+		handler.NewSeasonHandler,
+		handler.NewEpisodeHandler,
+		handler.NewPlaybackSessionHandler,
+		handler.NewVideoUploadHandler,
+		handler.NewVideoStreamHandler,
+		handler.NewVideoHLSHandler,
+		handler.NewTranscodingJobHandler,
+
+		// middlewares
+		middleware.NewSubscriptionMiddleware,
+		middleware.NewPlaybackURLMiddleware,
+
+		// mappers
+		mapper.NewMovieMapper,
+		mapper.NewTvShowMapper,
+		mapper.NewSeasonMapper,
+		mapper.NewEpisodeMapper,
+		mapper.NewVideoMapper,
+		mapper.NewVideoUploadMapper,
+		mapper.NewTranscodingJobMapper,
+		mapper.NewVideoRenditionMapper,
+
+		// repositories
+		fx.Annotate(
+			repository.NewMovieRepository,
+			fx.As(new(domain_repository.MovieRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewTvShowRepository,
+			fx.As(new(domain_repository.TvShowRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewSeasonRepository,
+			fx.As(new(domain_repository.SeasonRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewEpisodeRepository,
+			fx.As(new(domain_repository.EpisodeRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewVideoRepository,
+			fx.As(new(domain_repository.VideoRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewVideoUploadRepository,
+			fx.As(new(domain_repository.VideoUploadRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewTranscodingJobRepository,
+			fx.As(new(domain_repository.TranscodingJobRepository)),
+		),
+
+		fx.Annotate(
+			repository.NewVideoRenditionRepository,
+			fx.As(new(domain_repository.VideoRenditionRepository)),
+		),
+
+		// services
+		fx.Annotate(
+			service.NewPlaybackSessionStore,
+			fx.As(new(domain_service.PlaybackSessionStore)),
+		),
+
+		fx.Annotate(
+			service.NewVideoStorage,
+			fx.As(new(domain_service.VideoStorage)),
+		),
+
+		fx.Annotate(
+			service.NewPlaybackURLSigner,
+			fx.As(new(domain_service.PlaybackURLSigner)),
+		),
+
+		fx.Annotate(
+			service.NewVideoTranscoder,
+			fx.As(new(domain_service.VideoTranscoder)),
+		),
+
+		fx.Annotate(
+			service.NewTranscodingQueue,
+			fx.As(new(domain_service.TranscodingQueue)),
+		),
+
+		// jobs
+		job.NewRequeueTranscodingJobsJob,
+
+		// consumers
+		consumer.NewTranscodeVideoConsumer,
+	),
+	fx.Invoke(
+		router.SetupMovieRoutes,
+		router.SetupTvShowRoutes,
+		router.SetupSeasonRoutes,
+		router.SetupEpisodeRoutes,
+		router.SetupPlaybackSessionRoutes,
+		router.SetupVideoUploadRoutes,
+		router.SetupVideoStreamRoutes,
+		router.SetupVideoHLSRoutes,
+		router.SetupTranscodingJobRoutes,
+	),
+)
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type AccessTokenRevokeUseCase struct {
+	validator              validator.Validate
+	tokenRevocationService service.TokenRevocationService
+}
+
+func NewAccessTokenRevokeUseCase(
+	validator validator.Validate,
+	tokenRevocationService service.TokenRevocationService,
+) *AccessTokenRevokeUseCase {
+	return &AccessTokenRevokeUseCase{validator, tokenRevocationService}
+}
+
+type AccessTokenRevokeInput struct {
+	TokenID   string    `validate:"required"`
+	ExpiresAt time.Time `validate:"required"`
+}
+
+func (uc *AccessTokenRevokeUseCase) Execute(ctx context.Context, input AccessTokenRevokeInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "AccessTokenRevokeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	return uc.tokenRevocationService.RevokeToken(ctx, input.TokenID, input.ExpiresAt)
+}
@@ -0,0 +1,45 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+func TestAccessTokenRevokeUseCase_Execute(t *testing.T) {
+	t.Run("revokes the token until it expires", func(t *testing.T) {
+		// Arrange
+		expiresAt := time.Now().Add(time.Hour)
+		tokenRevocationService := service_mocks.NewMockTokenRevocationService(t)
+		tokenRevocationService.EXPECT().RevokeToken(mock.Anything, "token-1", expiresAt).Return(nil).Once()
+		sut := usecase.NewAccessTokenRevokeUseCase(validator.New(), tokenRevocationService)
+
+		// Act
+		err := sut.Execute(context.Background(), usecase.AccessTokenRevokeInput{
+			TokenID:   "token-1",
+			ExpiresAt: expiresAt,
+		})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("missing token returns validation error", func(t *testing.T) {
+		// Arrange
+		tokenRevocationService := service_mocks.NewMockTokenRevocationService(t)
+		sut := usecase.NewAccessTokenRevokeUseCase(validator.New(), tokenRevocationService)
+
+		// Act
+		err := sut.Execute(context.Background(), usecase.AccessTokenRevokeInput{ExpiresAt: time.Now()})
+
+		// Assert
+		require.Error(t, err)
+	})
+}
@@ -4,31 +4,41 @@ import (
 	"context"
 	"errors"
 
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
-	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
 )
 
 type TokenGenerateUseCase struct {
-	validator    validator.Validate
-	userRepo     repository.UserRepository
-	hashService  service.HashService
-	tokenService service.TokenService
+	validator           validator.Validate
+	userRepo            repository.UserRepository
+	authTokenRepo       repository.AuthTokenRepository
+	hashService         service.HashService
+	tokenService        service.TokenService
+	refreshTokenService service.RefreshTokenService
+	loginAttemptService service.LoginAttemptService
 }
 
 func NewTokenGenerateUseCase(
 	validator validator.Validate,
 	userRepo repository.UserRepository,
+	authTokenRepo repository.AuthTokenRepository,
 	hashService service.HashService,
 	tokenService service.TokenService,
+	refreshTokenService service.RefreshTokenService,
+	loginAttemptService service.LoginAttemptService,
 ) *TokenGenerateUseCase {
 	return &TokenGenerateUseCase{
 		validator,
 		userRepo,
+		authTokenRepo,
 		hashService,
 		tokenService,
+		refreshTokenService,
+		loginAttemptService,
 	}
 }
 
@@ -38,7 +48,8 @@ type TokenGenerateInput struct {
 }
 
 type TokenGenerateOutput struct {
-	Token string
+	Token        string
+	RefreshToken string
 }
 
 func (uc *TokenGenerateUseCase) Execute(ctx context.Context, input TokenGenerateInput) (TokenGenerateOutput, error) {
@@ -52,23 +63,37 @@ func (uc *TokenGenerateUseCase) Execute(ctx context.Context, input TokenGenerate
 		return output, err
 	}
 
+	lockedFor, err := uc.loginAttemptService.LockedFor(ctx, input.Email)
+	if err != nil {
+		return output, err
+	}
+
+	if lockedFor > 0 {
+		return output, &errs.AccountLockedError{RetryAfter: lockedFor}
+	}
+
 	user, err := uc.userRepo.FindByEmail(ctx, input.Email)
 	if err != nil {
-		if errors.Is(err, errs.ErrNotFound) {
-			return output, errs.ErrInvalidCredentials
+		if errors.Is(err, shared_errs.ErrNotFound) {
+			return output, uc.failLogin(ctx, input.Email)
 		}
 		return output, err
 	}
 
 	if !user.IsActivated() {
-		return output, errs.ErrUserIsNotActivated
+		return output, shared_errs.ErrUserIsNotActivated
 	}
 
 	hash := []byte(user.PasswordHash())
 	pass := []byte(input.Password)
 	err = uc.hashService.CompareHashAndPassword(hash, pass)
 	if err != nil {
-		return output, errs.ErrInvalidCredentials
+		return output, uc.failLogin(ctx, input.Email)
+	}
+
+	err = uc.loginAttemptService.Reset(ctx, input.Email)
+	if err != nil {
+		return output, err
 	}
 
 	token, err := uc.tokenService.Generate(ctx, user)
@@ -76,6 +101,31 @@ func (uc *TokenGenerateUseCase) Execute(ctx context.Context, input TokenGenerate
 		return output, err
 	}
 
+	refreshToken, authToken, err := uc.refreshTokenService.Generate(ctx, user.ID(), "")
+	if err != nil {
+		return output, err
+	}
+
+	_, err = uc.authTokenRepo.Create(ctx, authToken)
+	if err != nil {
+		return output, err
+	}
+
 	output.Token = token
+	output.RefreshToken = refreshToken
 	return output, nil
 }
+
+// failLogin records the failed login, and returns the error telling the client why it failed.
+func (uc *TokenGenerateUseCase) failLogin(ctx context.Context, email string) error {
+	lockedFor, err := uc.loginAttemptService.RecordFailure(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if lockedFor > 0 {
+		return &errs.AccountLockedError{RetryAfter: lockedFor}
+	}
+
+	return shared_errs.ErrInvalidCredentials
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type TokenRefreshUseCase struct {
+	validator           validator.Validate
+	userRepo            repository.UserRepository
+	authTokenRepo       repository.AuthTokenRepository
+	tokenService        service.TokenService
+	refreshTokenService service.RefreshTokenService
+	txManager           database.TxManager
+}
+
+func NewTokenRefreshUseCase(
+	validator validator.Validate,
+	userRepo repository.UserRepository,
+	authTokenRepo repository.AuthTokenRepository,
+	tokenService service.TokenService,
+	refreshTokenService service.RefreshTokenService,
+	txManager database.TxManager,
+) *TokenRefreshUseCase {
+	return &TokenRefreshUseCase{
+		validator,
+		userRepo,
+		authTokenRepo,
+		tokenService,
+		refreshTokenService,
+		txManager,
+	}
+}
+
+type TokenRefreshInput struct {
+	RefreshToken string `validate:"required"`
+}
+
+type TokenRefreshOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+func (uc *TokenRefreshUseCase) Execute(ctx context.Context, input TokenRefreshInput) (TokenRefreshOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TokenRefreshUseCase.Execute")
+	defer span.End()
+
+	output := TokenRefreshOutput{}
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return output, err
+	}
+
+	hash := uc.refreshTokenService.Hash(input.RefreshToken)
+	authToken, err := uc.authTokenRepo.FindByToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return output, errs.ErrInvalidToken
+		}
+		return output, err
+	}
+
+	if authToken.IsRevoked() || authToken.IsExpired() {
+		return output, errs.ErrInvalidToken
+	}
+
+	// A rotated token is only presented again when it was stolen, so the whole family is revoked.
+	if authToken.IsRotated() {
+		err = uc.authTokenRepo.RevokeFamily(ctx, authToken.FamilyID())
+		if err != nil {
+			return output, err
+		}
+		return output, errs.ErrInvalidToken
+	}
+
+	// The token is rotated in the transaction of its successor, so a refresh that fails after
+	// the rotation leaves the token usable and its retry is not taken for a theft.
+	var user model.UserModel
+	var refreshToken string
+	var rotatedConcurrently bool
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		err := uc.authTokenRepo.Rotate(ctx, authToken.ID())
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				rotatedConcurrently = true
+				return errs.ErrInvalidToken
+			}
+			return err
+		}
+
+		user, err = uc.userRepo.FindByID(ctx, authToken.UserID())
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				return errs.ErrInvalidToken
+			}
+			return err
+		}
+
+		if !user.IsActivated() {
+			return errs.ErrUserIsNotActivated
+		}
+
+		var newAuthToken model.AuthTokenModel
+		refreshToken, newAuthToken, err = uc.refreshTokenService.Generate(ctx, user.ID(), authToken.FamilyID())
+		if err != nil {
+			return err
+		}
+
+		_, err = uc.authTokenRepo.Create(ctx, newAuthToken)
+		return err
+	})
+	if rotatedConcurrently {
+		// Another request rotated the token first.
+		err = uc.authTokenRepo.RevokeFamily(ctx, authToken.FamilyID())
+		if err != nil {
+			return output, err
+		}
+		return output, errs.ErrInvalidToken
+	}
+	if err != nil {
+		return output, err
+	}
+
+	// The access token is only signed once its refresh token is committed
+	token, err := uc.tokenService.Generate(ctx, user)
+	if err != nil {
+		return output, err
+	}
+
+	output.Token = token
+	output.RefreshToken = refreshToken
+	return output, nil
+}
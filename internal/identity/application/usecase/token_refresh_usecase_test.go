@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/test/dbtest"
+)
+
+const (
+	refreshToken     = "refresh-token"
+	refreshTokenHash = "refresh-token-hash"
+	tokenFamilyID    = "family-1"
+)
+
+func TestTokenRefreshUseCase_Execute(t *testing.T) {
+	input := usecase.TokenRefreshInput{RefreshToken: refreshToken}
+
+	t.Run("token is rotated and a new pair is issued", func(t *testing.T) {
+		// Arrange
+		sut := newTokenRefreshUseCaseSUT(t)
+		user := sut.newUser(t, true)
+		newAuthToken := sut.newAuthToken(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectCommit()
+		sut.authTokenRepo.EXPECT().Rotate(mock.Anything, uint64(1)).Return(nil).Once()
+		sut.userRepo.EXPECT().FindByID(mock.Anything, uint64(1)).Return(user, nil).Once()
+		sut.refreshTokenService.EXPECT().Generate(mock.Anything, uint64(1), tokenFamilyID).
+			Return("new-refresh-token", newAuthToken, nil).Once()
+		sut.authTokenRepo.EXPECT().Create(mock.Anything, newAuthToken).Return(newAuthToken, nil).Once()
+		sut.tokenService.EXPECT().Generate(mock.Anything, user).Return("access-token", nil).Once()
+
+		// Act
+		output, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "access-token", output.Token)
+		require.Equal(t, "new-refresh-token", output.RefreshToken)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("rotation is rolled back when the user is not activated", func(t *testing.T) {
+		// Arrange
+		sut := newTokenRefreshUseCaseSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectRollback()
+		sut.authTokenRepo.EXPECT().Rotate(mock.Anything, uint64(1)).Return(nil).Once()
+		sut.userRepo.EXPECT().FindByID(mock.Anything, uint64(1)).Return(sut.newUser(t, false), nil).Once()
+
+		// Act
+		_, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrUserIsNotActivated)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("rotation is rolled back when the new token is not stored", func(t *testing.T) {
+		// Arrange
+		errDB := errors.New("database is down")
+		sut := newTokenRefreshUseCaseSUT(t)
+		newAuthToken := sut.newAuthToken(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectRollback()
+		sut.authTokenRepo.EXPECT().Rotate(mock.Anything, uint64(1)).Return(nil).Once()
+		sut.userRepo.EXPECT().FindByID(mock.Anything, uint64(1)).Return(sut.newUser(t, true), nil).Once()
+		sut.refreshTokenService.EXPECT().Generate(mock.Anything, uint64(1), tokenFamilyID).
+			Return("new-refresh-token", newAuthToken, nil).Once()
+		sut.authTokenRepo.EXPECT().Create(mock.Anything, newAuthToken).Return(model.AuthTokenModel{}, errDB).Once()
+
+		// Act
+		_, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errDB)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("token rotated by another request revokes the family", func(t *testing.T) {
+		// Arrange
+		sut := newTokenRefreshUseCaseSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectRollback()
+		sut.authTokenRepo.EXPECT().Rotate(mock.Anything, uint64(1)).Return(errs.ErrNotFound).Once()
+		sut.authTokenRepo.EXPECT().RevokeFamily(mock.Anything, tokenFamilyID).Return(nil).Once()
+
+		// Act
+		_, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidToken)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("rotated token revokes the family", func(t *testing.T) {
+		// Arrange
+		sut := newTokenRefreshUseCaseSUT(t)
+		rotatedAt := time.Now().UTC().Add(-time.Minute)
+		sut.authToken = sut.restoreAuthToken(t, &rotatedAt)
+		sut.authTokenRepo.EXPECT().RevokeFamily(mock.Anything, tokenFamilyID).Return(nil).Once()
+
+		// Act
+		_, err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidToken)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+}
+
+type tokenRefreshUseCaseSUT struct {
+	useCase             *usecase.TokenRefreshUseCase
+	userRepo            *repository_mocks.MockUserRepository
+	authTokenRepo       *repository_mocks.MockAuthTokenRepository
+	tokenService        *service_mocks.MockTokenService
+	refreshTokenService *service_mocks.MockRefreshTokenService
+	sqlMock             sqlmock.Sqlmock
+	authToken           model.AuthTokenModel
+}
+
+func newTokenRefreshUseCaseSUT(t *testing.T) *tokenRefreshUseCaseSUT {
+	t.Helper()
+
+	sqlDB, db, sqlMock := dbtest.NewDBMock(t)
+	t.Cleanup(func() { dbtest.CloseWithErrorCheck(sqlDB) })
+
+	sut := &tokenRefreshUseCaseSUT{
+		userRepo:            repository_mocks.NewMockUserRepository(t),
+		authTokenRepo:       repository_mocks.NewMockAuthTokenRepository(t),
+		tokenService:        service_mocks.NewMockTokenService(t),
+		refreshTokenService: service_mocks.NewMockRefreshTokenService(t),
+		sqlMock:             sqlMock,
+	}
+	sut.authToken = sut.restoreAuthToken(t, nil)
+
+	sut.refreshTokenService.EXPECT().Hash(refreshToken).Return(refreshTokenHash).Once()
+	sut.authTokenRepo.EXPECT().FindByToken(mock.Anything, refreshTokenHash).
+		RunAndReturn(func(context.Context, string) (model.AuthTokenModel, error) {
+			return sut.authToken, nil
+		}).Once()
+
+	sut.useCase = usecase.NewTokenRefreshUseCase(
+		validator.New(),
+		sut.userRepo,
+		sut.authTokenRepo,
+		sut.tokenService,
+		sut.refreshTokenService,
+		database.NewTxManager(db),
+	)
+	return sut
+}
+
+func (s *tokenRefreshUseCaseSUT) restoreAuthToken(t *testing.T, rotatedAt *time.Time) model.AuthTokenModel {
+	t.Helper()
+
+	now := time.Now().UTC()
+	authToken, err := model.RestoreAuthTokenModel(
+		1, 1, refreshTokenHash, tokenFamilyID, now.Add(time.Hour), rotatedAt, nil, now, now,
+	)
+	require.NoError(t, err)
+	return authToken
+}
+
+func (s *tokenRefreshUseCaseSUT) newAuthToken(t *testing.T) model.AuthTokenModel {
+	t.Helper()
+
+	authToken, err := model.CreateAuthTokenModel(1, "new-refresh-token-hash", tokenFamilyID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	return authToken
+}
+
+func (s *tokenRefreshUseCaseSUT) newUser(t *testing.T, isActivated bool) model.UserModel {
+	t.Helper()
+
+	user, err := model.RestoreUserModel(
+		1, "John Doe", "john.doe@example.com", newPasswordHash, isActivated, enum.EnumRoleUser,
+		nil, nil, nil, nil, nil,
+		time.Now().UTC(), time.Now().UTC(),
+	)
+	require.NoError(t, err)
+	return user
+}
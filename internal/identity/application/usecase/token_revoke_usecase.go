@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type TokenRevokeUseCase struct {
+	validator           validator.Validate
+	authTokenRepo       repository.AuthTokenRepository
+	refreshTokenService service.RefreshTokenService
+}
+
+func NewTokenRevokeUseCase(
+	validator validator.Validate,
+	authTokenRepo repository.AuthTokenRepository,
+	refreshTokenService service.RefreshTokenService,
+) *TokenRevokeUseCase {
+	return &TokenRevokeUseCase{validator, authTokenRepo, refreshTokenService}
+}
+
+type TokenRevokeInput struct {
+	RefreshToken string `validate:"required"`
+}
+
+// Execute revokes the refresh token family the given token belongs to, ending the login session.
+// Unknown tokens are ignored so that logging out twice is not an error.
+func (uc *TokenRevokeUseCase) Execute(ctx context.Context, input TokenRevokeInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TokenRevokeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	hash := uc.refreshTokenService.Hash(input.RefreshToken)
+	authToken, err := uc.authTokenRepo.FindByToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return uc.authTokenRepo.RevokeFamily(ctx, authToken.FamilyID())
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
 	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
@@ -19,24 +20,27 @@ import (
 const confirmationTokenExpiryHours = 24
 
 type UserCreateUseCase struct {
-	sendEmailConfirmationService service.SendEmailConfirmationService
-	hashService                  service.HashService
-	userRepository               repository.UserRepository
-	validate                     validator.Validate
-	logger                       logger.Logger
+	emailQueueService service.EmailQueueService
+	hashService       service.HashService
+	userRepository    repository.UserRepository
+	txManager         database.TxManager
+	validate          validator.Validate
+	logger            logger.Logger
 }
 
 func NewUserCreateUseCase(
-	sendEmailConfirmationService service.SendEmailConfirmationService,
+	emailQueueService service.EmailQueueService,
 	hashService service.HashService,
 	userRepo repository.UserRepository,
+	txManager database.TxManager,
 	validate validator.Validate,
 	logger logger.Logger,
 ) *UserCreateUseCase {
 	return &UserCreateUseCase{
-		sendEmailConfirmationService,
+		emailQueueService,
 		hashService,
 		userRepo,
+		txManager,
 		validate,
 		logger,
 	}
@@ -106,17 +110,27 @@ func (uc *UserCreateUseCase) Execute(ctx context.Context, input UserCreateInput)
 		return output, err
 	}
 
-	newUserModel, err := uc.userRepository.Create(ctx, userModel)
+	// The account confirmation email is queued in the transaction of the user, it is sent even if
+	// the mail server is down right now
+	var newUserModel model.UserModel
+	err = uc.txManager.Transaction(ctx, func(ctx context.Context) error {
+		newUserModel, err = uc.userRepository.Create(ctx, userModel)
+		if err != nil {
+			message := "error creating user"
+			uc.logger.Error(message, "error", err)
+			return err
+		}
+
+		err = uc.emailQueueService.EnqueueEmailConfirmation(ctx, newUserModel.ID())
+		if err != nil {
+			message := "error queueing account confirmation email"
+			uc.logger.Error(message, "error", err)
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
-		message := "error creating user"
-		uc.logger.Error(message, "error", err)
-		return output, err
-	}
-
-	err = uc.sendEmailConfirmationService.Execute(ctx, newUserModel.ID())
-	if err != nil {
-		message := "error sending account confirmation email"
-		uc.logger.Error(message, "error", err)
 		return output, err
 	}
 
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+type UserListUseCase struct {
+	userRepo repository.UserRepository
+	logger   logger.Logger
+}
+
+func NewUserListUseCase(
+	userRepo repository.UserRepository,
+	logger logger.Logger,
+) *UserListUseCase {
+	return &UserListUseCase{userRepo, logger}
+}
+
+type UserListOutput struct {
+	UserID      uint64
+	Name        string
+	Email       string
+	Role        string
+	IsActivated bool
+	CreatedAt   time.Time
+}
+
+func (uc *UserListUseCase) Execute(ctx context.Context) ([]UserListOutput, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserListUseCase.Execute")
+	defer span.End()
+
+	userModels, err := uc.userRepo.FindAll(ctx)
+	if err != nil {
+		message := "error listing users"
+		uc.logger.Error(message, "error", err)
+		return nil, err
+	}
+
+	output := make([]UserListOutput, len(userModels))
+	for i, userModel := range userModels {
+		output[i] = UserListOutput{
+			UserID:      userModel.ID(),
+			Name:        userModel.Name(),
+			Email:       userModel.Email(),
+			Role:        userModel.Role(),
+			IsActivated: userModel.IsActivated(),
+			CreatedAt:   userModel.CreatedAt(),
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UserPasswordForgotUseCase struct {
+	emailQueueService service.EmailQueueService
+	userRepository    repository.UserRepository
+	validate          validator.Validate
+	logger            logger.Logger
+}
+
+func NewUserPasswordForgotUseCase(
+	emailQueueService service.EmailQueueService,
+	userRepository repository.UserRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UserPasswordForgotUseCase {
+	return &UserPasswordForgotUseCase{
+		emailQueueService,
+		userRepository,
+		validate,
+		logger,
+	}
+}
+
+type UserPasswordForgotInput struct {
+	Email string `validate:"required,email"`
+}
+
+// Execute queues the reset password email of the user, the token is issued when the email is
+// sent. It succeeds silently for unknown emails so the endpoint cannot be used to enumerate
+// accounts.
+func (uc *UserPasswordForgotUseCase) Execute(ctx context.Context, input UserPasswordForgotInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserPasswordForgotUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.userRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrNotFound) {
+			return nil
+		}
+		uc.logger.Error("error finding user by email", "error", err)
+		return err
+	}
+
+	// A delivery failure must not change the response, otherwise it would reveal the account exists
+	err = uc.emailQueueService.EnqueueResetPasswordEmail(ctx, user.ID())
+	if err != nil {
+		message := "error queueing reset password email"
+		uc.logger.Error(message, "error", err, "userID", user.ID())
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+func TestUserPasswordForgotUseCase_Execute(t *testing.T) {
+	input := usecase.UserPasswordForgotInput{Email: "john.doe@example.com"}
+
+	t.Run("reset password email is queued", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordForgotUseCaseSUT(t)
+		user, err := model.RestoreUserModel(
+			1, "John Doe", "john.doe@example.com", newPasswordHash, true, enum.EnumRoleUser,
+			nil, nil, nil, nil, nil,
+			time.Now().UTC(), time.Now().UTC(),
+		)
+		require.NoError(t, err)
+		sut.userRepository.EXPECT().FindByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+		sut.emailQueueService.EXPECT().EnqueueResetPasswordEmail(mock.Anything, uint64(1)).Return(nil).Once()
+
+		// Act
+		err = sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown email succeeds without queueing an email", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordForgotUseCaseSUT(t)
+		sut.userRepository.EXPECT().FindByEmail(mock.Anything, input.Email).
+			Return(model.UserModel{}, shared_errs.ErrNotFound).Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("failed queueing succeeds", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordForgotUseCaseSUT(t)
+		user, err := model.RestoreUserModel(
+			1, "John Doe", "john.doe@example.com", newPasswordHash, true, enum.EnumRoleUser,
+			nil, nil, nil, nil, nil,
+			time.Now().UTC(), time.Now().UTC(),
+		)
+		require.NoError(t, err)
+		sut.userRepository.EXPECT().FindByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+		sut.emailQueueService.EXPECT().EnqueueResetPasswordEmail(mock.Anything, uint64(1)).
+			Return(errors.New("broker is down")).Once()
+		sut.logger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return().Once()
+
+		// Act
+		err = sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+type userPasswordForgotUseCaseSUT struct {
+	useCase           *usecase.UserPasswordForgotUseCase
+	emailQueueService *service_mocks.MockEmailQueueService
+	userRepository    *repository_mocks.MockUserRepository
+	logger            *logger_mocks.MockLogger
+}
+
+func newUserPasswordForgotUseCaseSUT(t *testing.T) *userPasswordForgotUseCaseSUT {
+	t.Helper()
+
+	sut := &userPasswordForgotUseCaseSUT{
+		emailQueueService: service_mocks.NewMockEmailQueueService(t),
+		userRepository:    repository_mocks.NewMockUserRepository(t),
+		logger:            logger_mocks.NewMockLogger(t),
+	}
+	sut.useCase = usecase.NewUserPasswordForgotUseCase(
+		sut.emailQueueService,
+		sut.userRepository,
+		validator.New(),
+		sut.logger,
+	)
+	return sut
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	domain_validator "github.com/cristiano-pacheco/goflix/internal/identity/domain/validator"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UserPasswordResetUseCase struct {
+	passwordValidator         domain_validator.PasswordValidator
+	hashService               service.HashService
+	resetPasswordTokenService service.ResetPasswordTokenService
+	tokenRevocationService    service.TokenRevocationService
+	userRepository            repository.UserRepository
+	authTokenRepository       repository.AuthTokenRepository
+	validate                  validator.Validate
+	logger                    logger.Logger
+}
+
+func NewUserPasswordResetUseCase(
+	passwordValidator domain_validator.PasswordValidator,
+	hashService service.HashService,
+	resetPasswordTokenService service.ResetPasswordTokenService,
+	tokenRevocationService service.TokenRevocationService,
+	userRepository repository.UserRepository,
+	authTokenRepository repository.AuthTokenRepository,
+	validate validator.Validate,
+	logger logger.Logger,
+) *UserPasswordResetUseCase {
+	return &UserPasswordResetUseCase{
+		passwordValidator,
+		hashService,
+		resetPasswordTokenService,
+		tokenRevocationService,
+		userRepository,
+		authTokenRepository,
+		validate,
+		logger,
+	}
+}
+
+type UserPasswordResetInput struct {
+	Token    string `validate:"required"`
+	Password string `validate:"required"`
+}
+
+func (uc *UserPasswordResetUseCase) Execute(ctx context.Context, input UserPasswordResetInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserPasswordResetUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.passwordValidator.Validate(input.Password)
+	if err != nil {
+		return err
+	}
+
+	// Only the hash of the token is stored
+	tokenHash := uc.resetPasswordTokenService.Hash(input.Token)
+	user, err := uc.userRepository.FindByResetPasswordToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, shared_errs.ErrNotFound) {
+			return errs.ErrInvalidResetPasswordToken
+		}
+		uc.logger.Error("error finding user by reset password token", "error", err)
+		return err
+	}
+
+	if !user.IsResetPasswordTokenValid(tokenHash) {
+		return errs.ErrInvalidResetPasswordToken
+	}
+
+	ph, err := uc.hashService.GenerateFromPassword([]byte(input.Password))
+	if err != nil {
+		message := "error generating password hash"
+		uc.logger.Error(message, "error", err)
+		return err
+	}
+
+	err = user.UpdatePasswordHash(string(ph))
+	if err != nil {
+		message := "error updating password hash"
+		uc.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	user.ClearResetPasswordDetails()
+
+	err = uc.userRepository.Update(ctx, user)
+	if err != nil {
+		message := "error updating user with id %d"
+		uc.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	// Sessions opened with the old password must not outlive it
+	err = uc.tokenRevocationService.RevokeUserTokens(ctx, user.ID())
+	if err != nil {
+		message := "error revoking access tokens"
+		uc.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	err = uc.authTokenRepository.RevokeAllByUserID(ctx, user.ID())
+	if err != nil {
+		message := "error revoking refresh tokens"
+		uc.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	return nil
+}
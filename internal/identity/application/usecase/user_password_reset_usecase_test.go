@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	validator_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/validator/mocks"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+const (
+	resetPasswordToken     = "reset-password-token"
+	resetPasswordTokenHash = "reset-password-token-hash"
+)
+
+func TestUserPasswordResetUseCase_Execute(t *testing.T) {
+	input := usecase.UserPasswordResetInput{Token: resetPasswordToken, Password: "new-password"}
+
+	t.Run("password is reset and the token is cleared", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordResetUseCaseSUT(t)
+		sut.userRepository.EXPECT().FindByResetPasswordToken(mock.Anything, resetPasswordTokenHash).
+			Return(sut.newUser(t, time.Now().UTC().Add(time.Hour)), nil).Once()
+		sut.hashService.EXPECT().GenerateFromPassword([]byte("new-password")).Return([]byte(newPasswordHash), nil).Once()
+		sut.userRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(user model.UserModel) bool {
+			return user.PasswordHash() == newPasswordHash &&
+				user.ResetPasswordToken() == nil &&
+				user.ResetPasswordExpiresAt() == nil
+		})).Return(nil).Once()
+		sut.tokenRevocationService.EXPECT().RevokeUserTokens(mock.Anything, uint64(1)).Return(nil).Once()
+		sut.authTokenRepository.EXPECT().RevokeAllByUserID(mock.Anything, uint64(1)).Return(nil).Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("expired token returns error", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordResetUseCaseSUT(t)
+		sut.userRepository.EXPECT().FindByResetPasswordToken(mock.Anything, resetPasswordTokenHash).
+			Return(sut.newUser(t, time.Now().UTC().Add(-time.Minute)), nil).Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidResetPasswordToken)
+	})
+
+	t.Run("used token returns error", func(t *testing.T) {
+		// Arrange
+		sut := newUserPasswordResetUseCaseSUT(t)
+		// The token was cleared when it was used, no user has it anymore
+		sut.userRepository.EXPECT().FindByResetPasswordToken(mock.Anything, resetPasswordTokenHash).
+			Return(model.UserModel{}, shared_errs.ErrNotFound).Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidResetPasswordToken)
+	})
+}
+
+type userPasswordResetUseCaseSUT struct {
+	useCase                *usecase.UserPasswordResetUseCase
+	hashService            *service_mocks.MockHashService
+	tokenRevocationService *service_mocks.MockTokenRevocationService
+	userRepository         *repository_mocks.MockUserRepository
+	authTokenRepository    *repository_mocks.MockAuthTokenRepository
+}
+
+func newUserPasswordResetUseCaseSUT(t *testing.T) *userPasswordResetUseCaseSUT {
+	t.Helper()
+
+	passwordValidator := validator_mocks.NewMockPasswordValidator(t)
+	passwordValidator.EXPECT().Validate("new-password").Return(nil).Once()
+
+	resetPasswordTokenService := service_mocks.NewMockResetPasswordTokenService(t)
+	resetPasswordTokenService.EXPECT().Hash(resetPasswordToken).Return(resetPasswordTokenHash).Once()
+
+	sut := &userPasswordResetUseCaseSUT{
+		hashService:            service_mocks.NewMockHashService(t),
+		tokenRevocationService: service_mocks.NewMockTokenRevocationService(t),
+		userRepository:         repository_mocks.NewMockUserRepository(t),
+		authTokenRepository:    repository_mocks.NewMockAuthTokenRepository(t),
+	}
+	sut.useCase = usecase.NewUserPasswordResetUseCase(
+		passwordValidator,
+		sut.hashService,
+		resetPasswordTokenService,
+		sut.tokenRevocationService,
+		sut.userRepository,
+		sut.authTokenRepository,
+		validator.New(),
+		logger_mocks.NewMockLogger(t),
+	)
+	return sut
+}
+
+func (s *userPasswordResetUseCaseSUT) newUser(t *testing.T, resetPasswordExpiresAt time.Time) model.UserModel {
+	t.Helper()
+
+	tokenHash := resetPasswordTokenHash
+	user, err := model.RestoreUserModel(
+		1, "John Doe", "john.doe@example.com", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy",
+		true, enum.EnumRoleUser,
+		nil, nil, nil, &tokenHash, &resetPasswordExpiresAt,
+		time.Now().UTC(), time.Now().UTC(),
+	)
+	require.NoError(t, err)
+	return user
+}
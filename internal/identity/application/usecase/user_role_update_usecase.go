@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UserRoleUpdateUseCase struct {
+	validate               validator.Validate
+	userRepo               repository.UserRepository
+	tokenRevocationService service.TokenRevocationService
+	logger                 logger.Logger
+}
+
+func NewUserRoleUpdateUseCase(
+	validate validator.Validate,
+	userRepo repository.UserRepository,
+	tokenRevocationService service.TokenRevocationService,
+	logger logger.Logger,
+) *UserRoleUpdateUseCase {
+	return &UserRoleUpdateUseCase{validate, userRepo, tokenRevocationService, logger}
+}
+
+type UserRoleUpdateInput struct {
+	ActorID uint64 `validate:"required"`
+	UserID  uint64 `validate:"required"`
+	Role    string `validate:"required"`
+}
+
+func (uc *UserRoleUpdateUseCase) Execute(ctx context.Context, input UserRoleUpdateInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserRoleUpdateUseCase.Execute")
+	defer span.End()
+
+	err := uc.validate.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	// Prevents an administrator from locking themselves out
+	if input.ActorID == input.UserID {
+		return errs.ErrCannotChangeOwnRole
+	}
+
+	userModel, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = userModel.ChangeRole(input.Role)
+	if err != nil {
+		return err
+	}
+
+	err = uc.userRepo.Update(ctx, userModel)
+	if err != nil {
+		message := "error updating user role"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return err
+	}
+
+	// The role is embedded in access tokens, so the ones already issued must not keep the old role
+	err = uc.tokenRevocationService.RevokeUserTokens(ctx, input.UserID)
+	if err != nil {
+		message := "error revoking access tokens"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+type UserTokensRevokeUseCase struct {
+	validator              validator.Validate
+	authTokenRepo          repository.AuthTokenRepository
+	tokenRevocationService service.TokenRevocationService
+}
+
+func NewUserTokensRevokeUseCase(
+	validator validator.Validate,
+	authTokenRepo repository.AuthTokenRepository,
+	tokenRevocationService service.TokenRevocationService,
+) *UserTokensRevokeUseCase {
+	return &UserTokensRevokeUseCase{validator, authTokenRepo, tokenRevocationService}
+}
+
+type UserTokensRevokeInput struct {
+	UserID uint64 `validate:"required"`
+}
+
+// Execute revokes every access and refresh token issued to the user so far.
+func (uc *UserTokensRevokeUseCase) Execute(ctx context.Context, input UserTokensRevokeInput) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserTokensRevokeUseCase.Execute")
+	defer span.End()
+
+	err := uc.validator.Struct(input)
+	if err != nil {
+		return err
+	}
+
+	err = uc.tokenRevocationService.RevokeUserTokens(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	return uc.authTokenRepo.RevokeAllByUserID(ctx, input.UserID)
+}
@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+func TestUserTokensRevokeUseCase_Execute(t *testing.T) {
+	t.Run("revokes the access and refresh tokens of the user", func(t *testing.T) {
+		// Arrange
+		authTokenRepo := repository_mocks.NewMockAuthTokenRepository(t)
+		tokenRevocationService := service_mocks.NewMockTokenRevocationService(t)
+		tokenRevocationService.EXPECT().RevokeUserTokens(mock.Anything, uint64(1)).Return(nil).Once()
+		authTokenRepo.EXPECT().RevokeAllByUserID(mock.Anything, uint64(1)).Return(nil).Once()
+		sut := usecase.NewUserTokensRevokeUseCase(validator.New(), authTokenRepo, tokenRevocationService)
+
+		// Act
+		err := sut.Execute(context.Background(), usecase.UserTokensRevokeInput{UserID: 1})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("refresh tokens are kept when the access tokens cannot be revoked", func(t *testing.T) {
+		// Arrange
+		errRedis := errors.New("redis is down")
+		authTokenRepo := repository_mocks.NewMockAuthTokenRepository(t)
+		tokenRevocationService := service_mocks.NewMockTokenRevocationService(t)
+		tokenRevocationService.EXPECT().RevokeUserTokens(mock.Anything, uint64(1)).Return(errRedis).Once()
+		sut := usecase.NewUserTokensRevokeUseCase(validator.New(), authTokenRepo, tokenRevocationService)
+
+		// Act
+		err := sut.Execute(context.Background(), usecase.UserTokensRevokeInput{UserID: 1})
+
+		// Assert
+		require.ErrorIs(t, err, errRedis)
+	})
+
+	t.Run("missing user returns validation error", func(t *testing.T) {
+		// Arrange
+		authTokenRepo := repository_mocks.NewMockAuthTokenRepository(t)
+		tokenRevocationService := service_mocks.NewMockTokenRevocationService(t)
+		sut := usecase.NewUserTokensRevokeUseCase(validator.New(), authTokenRepo, tokenRevocationService)
+
+		// Act
+		err := sut.Execute(context.Background(), usecase.UserTokensRevokeInput{})
+
+		// Assert
+		require.Error(t, err)
+	})
+}
@@ -12,19 +12,23 @@ import (
 )
 
 type UserUpdateUseCase struct {
-	validate    validator.Validate
-	userRepo    repository.UserRepository
-	logger      logger.Logger
-	hashService service.HashService
+	validate               validator.Validate
+	userRepo               repository.UserRepository
+	authTokenRepo          repository.AuthTokenRepository
+	logger                 logger.Logger
+	hashService            service.HashService
+	tokenRevocationService service.TokenRevocationService
 }
 
 func NewUserUpdateUseCase(
 	validate validator.Validate,
 	userRepo repository.UserRepository,
+	authTokenRepo repository.AuthTokenRepository,
 	logger logger.Logger,
 	hashService service.HashService,
+	tokenRevocationService service.TokenRevocationService,
 ) *UserUpdateUseCase {
-	return &UserUpdateUseCase{validate, userRepo, logger, hashService}
+	return &UserUpdateUseCase{validate, userRepo, authTokenRepo, logger, hashService, tokenRevocationService}
 }
 
 type UserUpdateInput struct {
@@ -60,11 +64,12 @@ func (uc *UserUpdateUseCase) Execute(ctx context.Context, input UserUpdateInput)
 		userModel.Email(),
 		string(ph),
 		userModel.IsActivated(),
-		userModel.ResetPasswordToken(),
-		userModel.ResetPasswordExpiresAt(),
-		userModel.ConfirmedAt(),
+		userModel.Role(),
 		userModel.ConfirmationToken(),
 		userModel.ConfirmationExpiresAt(),
+		userModel.ConfirmedAt(),
+		userModel.ResetPasswordToken(),
+		userModel.ResetPasswordExpiresAt(),
 		userModel.CreatedAt(),
 		userModel.UpdatedAt(),
 	)
@@ -79,5 +84,21 @@ func (uc *UserUpdateUseCase) Execute(ctx context.Context, input UserUpdateInput)
 		return err
 	}
 
+	// The password is changed on every update, and sessions opened with the old one must not
+	// outlive it. The caller logs in again, like after a password reset
+	err = uc.tokenRevocationService.RevokeUserTokens(ctx, input.UserID)
+	if err != nil {
+		message := "error revoking access tokens"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return err
+	}
+
+	err = uc.authTokenRepo.RevokeAllByUserID(ctx, input.UserID)
+	if err != nil {
+		message := "error revoking refresh tokens"
+		uc.logger.Error(message, "error", err, "userID", input.UserID)
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+)
+
+const newPasswordHash = "$2a$10$7EqJtq98hPqEX7fNZaFWoOhi5BWX4Z3ZxJ6F8p6P0e1QW0l8p5u6K"
+
+func TestUserUpdateUseCase_Execute(t *testing.T) {
+	input := usecase.UserUpdateInput{UserID: 1, Name: "Jane Doe", Password: "new-password"}
+
+	t.Run("password change revokes every token of the user", func(t *testing.T) {
+		// Arrange
+		sut := newUserUpdateUseCaseSUT(t)
+		sut.userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(user model.UserModel) bool {
+			return user.PasswordHash() == newPasswordHash && user.Name() == "Jane Doe"
+		})).Return(nil).Once()
+		sut.tokenRevocationService.EXPECT().RevokeUserTokens(mock.Anything, uint64(1)).Return(nil).Once()
+		sut.authTokenRepo.EXPECT().RevokeAllByUserID(mock.Anything, uint64(1)).Return(nil).Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("failed revocation returns error", func(t *testing.T) {
+		// Arrange
+		errRedis := errors.New("redis is down")
+		sut := newUserUpdateUseCaseSUT(t)
+		sut.userRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+		sut.tokenRevocationService.EXPECT().RevokeUserTokens(mock.Anything, uint64(1)).Return(errRedis).Once()
+		sut.logger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errRedis)
+	})
+
+	t.Run("tokens are kept when the update fails", func(t *testing.T) {
+		// Arrange
+		errDB := errors.New("database is down")
+		sut := newUserUpdateUseCaseSUT(t)
+		sut.userRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(errDB).Once()
+		sut.logger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		// Act
+		err := sut.useCase.Execute(context.Background(), input)
+
+		// Assert
+		require.ErrorIs(t, err, errDB)
+	})
+}
+
+type userUpdateUseCaseSUT struct {
+	useCase                *usecase.UserUpdateUseCase
+	userRepo               *repository_mocks.MockUserRepository
+	authTokenRepo          *repository_mocks.MockAuthTokenRepository
+	tokenRevocationService *service_mocks.MockTokenRevocationService
+	logger                 *logger_mocks.MockLogger
+}
+
+func newUserUpdateUseCaseSUT(t *testing.T) userUpdateUseCaseSUT {
+	t.Helper()
+
+	user, err := model.RestoreUserModel(
+		1, "John Doe", "john.doe@example.com", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy",
+		true, enum.EnumRoleUser,
+		nil, nil, nil, nil, nil,
+		time.Now().UTC(), time.Now().UTC(),
+	)
+	require.NoError(t, err)
+
+	userRepo := repository_mocks.NewMockUserRepository(t)
+	userRepo.EXPECT().FindByID(mock.Anything, uint64(1)).Return(user, nil).Once()
+
+	hashService := service_mocks.NewMockHashService(t)
+	hashService.EXPECT().GenerateFromPassword([]byte("new-password")).Return([]byte(newPasswordHash), nil).Once()
+
+	sut := userUpdateUseCaseSUT{
+		userRepo:               userRepo,
+		authTokenRepo:          repository_mocks.NewMockAuthTokenRepository(t),
+		tokenRevocationService: service_mocks.NewMockTokenRevocationService(t),
+		logger:                 logger_mocks.NewMockLogger(t),
+	}
+	sut.useCase = usecase.NewUserUpdateUseCase(
+		validator.New(),
+		sut.userRepo,
+		sut.authTokenRepo,
+		sut.logger,
+		hashService,
+		sut.tokenRevocationService,
+	)
+	return sut
+}
@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+)
+
+const (
+	EnumRoleUser  string = "user"
+	EnumRoleAdmin string = "admin"
+)
+
+type RoleEnum struct {
+	value string
+}
+
+func NewRoleEnum(value string) (RoleEnum, error) {
+	if err := validateRoleEnum(value); err != nil {
+		return RoleEnum{}, err
+	}
+
+	return RoleEnum{value: value}, nil
+}
+
+func (r *RoleEnum) String() string {
+	return r.value
+}
+
+func validateRoleEnum(value string) error {
+	allowedValues := map[string]struct{}{
+		EnumRoleUser:  {},
+		EnumRoleAdmin: {},
+	}
+
+	if _, ok := allowedValues[value]; !ok {
+		return fmt.Errorf("%w: %s", errs.ErrInvalidRole, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package enum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+)
+
+func TestNewRoleEnum(t *testing.T) {
+	t.Run("valid user role returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumRoleUser
+
+		// Act
+		result, err := enum.NewRoleEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("valid admin role returns enum without error", func(t *testing.T) {
+		// Arrange
+		value := enum.EnumRoleAdmin
+
+		// Act
+		result, err := enum.NewRoleEnum(value)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, value, result.String())
+	})
+
+	t.Run("invalid role returns error", func(t *testing.T) {
+		// Arrange
+		value := "superuser"
+
+		// Act
+		result, err := enum.NewRoleEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRole)
+		require.Empty(t, result.String())
+	})
+
+	t.Run("empty role returns error", func(t *testing.T) {
+		// Arrange
+		value := ""
+
+		// Act
+		result, err := enum.NewRoleEnum(value)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRole)
+		require.Empty(t, result.String())
+	})
+}
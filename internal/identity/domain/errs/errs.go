@@ -2,6 +2,9 @@ package errs
 
 import (
 	"errors"
+	"time"
+
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 )
 
 // Password validation errors.
@@ -13,3 +16,32 @@ var (
 	ErrPasswordNoSpecialChar = errors.New("password must contain at least one special character")
 	ErrEmailAlreadyInUse     = errors.New("email already in use")
 )
+
+// Password reset errors.
+var (
+	ErrInvalidResetPasswordToken = errors.New("invalid or expired reset password token")
+)
+
+// Authorization errors.
+var (
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrCannotChangeOwnRole = errors.New("cannot change your own role")
+)
+
+// Login lockout errors.
+var (
+	ErrInvalidLoginLockoutPolicy = errors.New("login lockout needs a threshold and a base duration up to the max duration")
+)
+
+// AccountLockedError is returned while an account is locked after repeated failed logins.
+type AccountLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *AccountLockedError) Error() string {
+	return "account locked for " + e.RetryAfter.Round(time.Second).String()
+}
+
+func (e *AccountLockedError) Unwrap() error {
+	return shared_errs.ErrAccountLocked
+}
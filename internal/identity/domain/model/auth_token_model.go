@@ -7,16 +7,21 @@ import (
 	"github.com/samber/lo"
 )
 
+// AuthTokenModel is an opaque refresh token. Tokens issued from the same login share a family,
+// so a replayed token can revoke every token derived from it.
 type AuthTokenModel struct {
 	id        uint64
 	userID    uint64
 	token     string
+	familyID  string
 	expiresAt time.Time
+	rotatedAt *time.Time
+	revokedAt *time.Time
 	createdAt time.Time
 	updatedAt time.Time
 }
 
-func CreateAuthTokenModel(userID uint64, token string, expiresAt time.Time) (AuthTokenModel, error) {
+func CreateAuthTokenModel(userID uint64, token, familyID string, expiresAt time.Time) (AuthTokenModel, error) {
 	if userID == 0 {
 		return AuthTokenModel{}, errors.New("user ID is required")
 	}
@@ -25,6 +30,10 @@ func CreateAuthTokenModel(userID uint64, token string, expiresAt time.Time) (Aut
 		return AuthTokenModel{}, errors.New("token is required")
 	}
 
+	if lo.IsEmpty(familyID) {
+		return AuthTokenModel{}, errors.New("family ID is required")
+	}
+
 	if expiresAt.IsZero() {
 		return AuthTokenModel{}, errors.New("expiration time is required")
 	}
@@ -32,6 +41,7 @@ func CreateAuthTokenModel(userID uint64, token string, expiresAt time.Time) (Aut
 	return AuthTokenModel{
 		userID:    userID,
 		token:     token,
+		familyID:  familyID,
 		expiresAt: expiresAt,
 		createdAt: time.Now().UTC(),
 		updatedAt: time.Now().UTC(),
@@ -42,7 +52,10 @@ func RestoreAuthTokenModel(
 	id uint64,
 	userID uint64,
 	token string,
+	familyID string,
 	expiresAt time.Time,
+	rotatedAt *time.Time,
+	revokedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) (AuthTokenModel, error) {
@@ -58,6 +71,10 @@ func RestoreAuthTokenModel(
 		return AuthTokenModel{}, errors.New("token is required")
 	}
 
+	if lo.IsEmpty(familyID) {
+		return AuthTokenModel{}, errors.New("family ID is required")
+	}
+
 	if expiresAt.IsZero() {
 		return AuthTokenModel{}, errors.New("expiration time is required")
 	}
@@ -66,7 +83,10 @@ func RestoreAuthTokenModel(
 		id:        id,
 		userID:    userID,
 		token:     token,
+		familyID:  familyID,
 		expiresAt: expiresAt,
+		rotatedAt: rotatedAt,
+		revokedAt: revokedAt,
 		createdAt: createdAt,
 		updatedAt: updatedAt,
 	}, nil
@@ -84,10 +104,22 @@ func (t *AuthTokenModel) Token() string {
 	return t.token
 }
 
+func (t *AuthTokenModel) FamilyID() string {
+	return t.familyID
+}
+
 func (t *AuthTokenModel) ExpiresAt() time.Time {
 	return t.expiresAt
 }
 
+func (t *AuthTokenModel) RotatedAt() *time.Time {
+	return t.rotatedAt
+}
+
+func (t *AuthTokenModel) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
 func (t *AuthTokenModel) CreatedAt() time.Time {
 	return t.createdAt
 }
@@ -100,6 +132,24 @@ func (t *AuthTokenModel) IsExpired() bool {
 	return time.Now().UTC().After(t.expiresAt)
 }
 
+func (t *AuthTokenModel) IsRotated() bool {
+	return t.rotatedAt != nil
+}
+
+func (t *AuthTokenModel) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
 func (t *AuthTokenModel) IsValid() bool {
-	return !t.IsExpired()
+	return !t.IsExpired() && !t.IsRotated() && !t.IsRevoked()
+}
+
+func (t *AuthTokenModel) Revoke() {
+	if t.revokedAt != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	t.revokedAt = &now
+	t.updatedAt = now
 }
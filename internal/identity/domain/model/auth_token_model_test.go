@@ -10,6 +10,8 @@ import (
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 )
 
+const familyID = "family-123"
+
 func TestCreateAuthTokenModel(t *testing.T) {
 	t.Run("valid input returns auth token model", func(t *testing.T) {
 		// Arrange
@@ -18,7 +20,7 @@ func TestCreateAuthTokenModel(t *testing.T) {
 		expiresAt := time.Now().UTC().Add(time.Hour)
 
 		// Act
-		result, err := model.CreateAuthTokenModel(userID, token, expiresAt)
+		result, err := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Assert
 		require.NoError(t, err)
@@ -37,7 +39,7 @@ func TestCreateAuthTokenModel(t *testing.T) {
 		expiresAt := time.Now().UTC().Add(time.Hour)
 
 		// Act
-		result, err := model.CreateAuthTokenModel(userID, token, expiresAt)
+		result, err := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Assert
 		require.Error(t, err)
@@ -52,7 +54,7 @@ func TestCreateAuthTokenModel(t *testing.T) {
 		expiresAt := time.Now().UTC().Add(time.Hour)
 
 		// Act
-		result, err := model.CreateAuthTokenModel(userID, token, expiresAt)
+		result, err := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Assert
 		require.Error(t, err)
@@ -60,6 +62,21 @@ func TestCreateAuthTokenModel(t *testing.T) {
 		assert.Equal(t, model.AuthTokenModel{}, result)
 	})
 
+	t.Run("empty family ID returns error", func(t *testing.T) {
+		// Arrange
+		userID := uint64(123)
+		token := "valid-token"
+		expiresAt := time.Now().UTC().Add(time.Hour)
+
+		// Act
+		result, err := model.CreateAuthTokenModel(userID, token, "", expiresAt)
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, "family ID is required", err.Error())
+		assert.Equal(t, model.AuthTokenModel{}, result)
+	})
+
 	t.Run("zero expiration time returns error", func(t *testing.T) {
 		// Arrange
 		userID := uint64(123)
@@ -67,7 +84,7 @@ func TestCreateAuthTokenModel(t *testing.T) {
 		expiresAt := time.Time{}
 
 		// Act
-		result, err := model.CreateAuthTokenModel(userID, token, expiresAt)
+		result, err := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Assert
 		require.Error(t, err)
@@ -87,7 +104,7 @@ func TestRestoreAuthTokenModel(t *testing.T) {
 		updatedAt := time.Now().UTC()
 
 		// Act
-		result, err := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		result, err := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Assert
 		require.NoError(t, err)
@@ -109,7 +126,7 @@ func TestRestoreAuthTokenModel(t *testing.T) {
 		updatedAt := time.Now().UTC()
 
 		// Act
-		result, err := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		result, err := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Assert
 		require.Error(t, err)
@@ -127,7 +144,7 @@ func TestRestoreAuthTokenModel(t *testing.T) {
 		updatedAt := time.Now().UTC()
 
 		// Act
-		result, err := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		result, err := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Assert
 		require.Error(t, err)
@@ -145,7 +162,7 @@ func TestRestoreAuthTokenModel(t *testing.T) {
 		updatedAt := time.Now().UTC()
 
 		// Act
-		result, err := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		result, err := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Assert
 		require.Error(t, err)
@@ -163,7 +180,7 @@ func TestRestoreAuthTokenModel(t *testing.T) {
 		updatedAt := time.Now().UTC()
 
 		// Act
-		result, err := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		result, err := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Assert
 		require.Error(t, err)
@@ -178,7 +195,7 @@ func TestAuthTokenModel_IsExpired(t *testing.T) {
 		userID := uint64(123)
 		token := "valid-token"
 		expiresAt := time.Now().UTC().Add(time.Hour)
-		authToken, _ := model.CreateAuthTokenModel(userID, token, expiresAt)
+		authToken, _ := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Act
 		result := authToken.IsExpired()
@@ -192,7 +209,7 @@ func TestAuthTokenModel_IsExpired(t *testing.T) {
 		userID := uint64(123)
 		token := "valid-token"
 		expiresAt := time.Now().UTC().Add(-time.Hour)
-		authToken, _ := model.CreateAuthTokenModel(userID, token, expiresAt)
+		authToken, _ := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Act
 		result := authToken.IsExpired()
@@ -208,7 +225,7 @@ func TestAuthTokenModel_IsValid(t *testing.T) {
 		userID := uint64(123)
 		token := "valid-token"
 		expiresAt := time.Now().UTC().Add(time.Hour)
-		authToken, _ := model.CreateAuthTokenModel(userID, token, expiresAt)
+		authToken, _ := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Act
 		result := authToken.IsValid()
@@ -222,7 +239,7 @@ func TestAuthTokenModel_IsValid(t *testing.T) {
 		userID := uint64(123)
 		token := "valid-token"
 		expiresAt := time.Now().UTC().Add(-time.Hour)
-		authToken, _ := model.CreateAuthTokenModel(userID, token, expiresAt)
+		authToken, _ := model.CreateAuthTokenModel(userID, token, familyID, expiresAt)
 
 		// Act
 		result := authToken.IsValid()
@@ -232,6 +249,50 @@ func TestAuthTokenModel_IsValid(t *testing.T) {
 	})
 }
 
+func TestAuthTokenModel_Revoke(t *testing.T) {
+	t.Run("revoked token is not valid", func(t *testing.T) {
+		// Arrange
+		expiresAt := time.Now().UTC().Add(time.Hour)
+		authToken, _ := model.CreateAuthTokenModel(123, "valid-token", familyID, expiresAt)
+
+		// Act
+		authToken.Revoke()
+
+		// Assert
+		assert.True(t, authToken.IsRevoked())
+		assert.NotNil(t, authToken.RevokedAt())
+		assert.False(t, authToken.IsValid())
+	})
+
+	t.Run("revoking twice keeps the first revocation time", func(t *testing.T) {
+		// Arrange
+		expiresAt := time.Now().UTC().Add(time.Hour)
+		authToken, _ := model.CreateAuthTokenModel(123, "valid-token", familyID, expiresAt)
+		authToken.Revoke()
+		revokedAt := *authToken.RevokedAt()
+
+		// Act
+		authToken.Revoke()
+
+		// Assert
+		assert.Equal(t, revokedAt, *authToken.RevokedAt())
+	})
+
+	t.Run("rotated token is not valid", func(t *testing.T) {
+		// Arrange
+		now := time.Now().UTC()
+		expiresAt := now.Add(time.Hour)
+
+		// Act
+		authToken, err := model.RestoreAuthTokenModel(1, 123, "valid-token", familyID, expiresAt, &now, nil, now, now)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, authToken.IsRotated())
+		assert.False(t, authToken.IsValid())
+	})
+}
+
 func TestAuthTokenModel_Getters(t *testing.T) {
 	t.Run("getters return correct values", func(t *testing.T) {
 		// Arrange
@@ -241,12 +302,13 @@ func TestAuthTokenModel_Getters(t *testing.T) {
 		expiresAt := time.Now().UTC().Add(time.Hour)
 		createdAt := time.Now().UTC().Add(-time.Hour)
 		updatedAt := time.Now().UTC()
-		authToken, _ := model.RestoreAuthTokenModel(id, userID, token, expiresAt, createdAt, updatedAt)
+		authToken, _ := model.RestoreAuthTokenModel(id, userID, token, familyID, expiresAt, nil, nil, createdAt, updatedAt)
 
 		// Act & Assert
 		assert.Equal(t, id, authToken.ID())
 		assert.Equal(t, userID, authToken.UserID())
 		assert.Equal(t, token, authToken.Token())
+		assert.Equal(t, familyID, authToken.FamilyID())
 		assert.Equal(t, expiresAt, authToken.ExpiresAt())
 		assert.Equal(t, createdAt, authToken.CreatedAt())
 		assert.Equal(t, updatedAt, authToken.UpdatedAt())
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+)
+
+// LoginLockoutPolicyModel locks an account out once its failed logins reach the threshold. The
+// lock starts at the base duration and doubles with every further failure, up to the max.
+type LoginLockoutPolicyModel struct {
+	threshold    uint
+	baseDuration time.Duration
+	maxDuration  time.Duration
+}
+
+func CreateLoginLockoutPolicyModel(
+	threshold uint,
+	baseDuration time.Duration,
+	maxDuration time.Duration,
+) (LoginLockoutPolicyModel, error) {
+	if threshold == 0 || baseDuration <= 0 || maxDuration < baseDuration {
+		return LoginLockoutPolicyModel{}, errs.ErrInvalidLoginLockoutPolicy
+	}
+	return LoginLockoutPolicyModel{threshold, baseDuration, maxDuration}, nil
+}
+
+// LockDuration returns how long the account is locked after failures consecutive failed logins,
+// or zero when it is not locked yet.
+func (p LoginLockoutPolicyModel) LockDuration(failures uint) time.Duration {
+	if failures < p.threshold {
+		return 0
+	}
+
+	duration := p.baseDuration
+	for range failures - p.threshold {
+		duration *= 2
+		if duration >= p.maxDuration {
+			return p.maxDuration
+		}
+	}
+	return duration
+}
@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+)
+
+func TestCreateLoginLockoutPolicyModel(t *testing.T) {
+	t.Run("valid policy", func(t *testing.T) {
+		// Act
+		_, err := model.CreateLoginLockoutPolicyModel(5, time.Minute, time.Hour)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("zero threshold returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateLoginLockoutPolicyModel(0, time.Minute, time.Hour)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidLoginLockoutPolicy)
+	})
+
+	t.Run("zero base duration returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateLoginLockoutPolicyModel(5, 0, time.Hour)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidLoginLockoutPolicy)
+	})
+
+	t.Run("max duration below the base duration returns error", func(t *testing.T) {
+		// Act
+		_, err := model.CreateLoginLockoutPolicyModel(5, time.Hour, time.Minute)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidLoginLockoutPolicy)
+	})
+}
+
+func TestLoginLockoutPolicyModel_LockDuration(t *testing.T) {
+	policy, err := model.CreateLoginLockoutPolicyModel(5, time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("failures below the threshold do not lock", func(t *testing.T) {
+		// Act
+		duration := policy.LockDuration(4)
+
+		// Assert
+		require.Zero(t, duration)
+	})
+
+	t.Run("reaching the threshold locks for the base duration", func(t *testing.T) {
+		// Act
+		duration := policy.LockDuration(5)
+
+		// Assert
+		require.Equal(t, time.Minute, duration)
+	})
+
+	t.Run("every further failure doubles the lock", func(t *testing.T) {
+		// Act
+		duration := policy.LockDuration(8)
+
+		// Assert
+		require.Equal(t, 8*time.Minute, duration)
+	})
+
+	t.Run("lock is capped at the max duration", func(t *testing.T) {
+		// Act
+		duration := policy.LockDuration(1000)
+
+		// Assert
+		require.Equal(t, time.Hour, duration)
+	})
+}
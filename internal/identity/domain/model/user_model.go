@@ -5,6 +5,8 @@ import (
 	"errors"
 	"strings"
 	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
 )
 
 const (
@@ -20,6 +22,7 @@ type UserModel struct {
 	email                  EmailModel
 	passwordHash           string
 	isActivated            bool
+	role                   enum.RoleEnum
 	confirmationToken      *string
 	confirmationExpiresAt  *time.Time
 	confirmedAt            *time.Time
@@ -59,12 +62,19 @@ func CreateUserModel(
 		return UserModel{}, err
 	}
 
+	// New users never start as administrators
+	roleEnum, err := enum.NewRoleEnum(enum.EnumRoleUser)
+	if err != nil {
+		return UserModel{}, err
+	}
+
 	// Create user model
 	return UserModel{
 		name:                  nameModel,
 		email:                 emailModel,
 		passwordHash:          passwordHash,
 		isActivated:           false,
+		role:                  roleEnum,
 		confirmationToken:     &confirmationToken,
 		confirmationExpiresAt: &confirmationExpiresAt,
 		createdAt:             time.Now().UTC(),
@@ -78,6 +88,7 @@ func RestoreUserModel(
 	email string,
 	passwordHash string,
 	isActivated bool,
+	role string,
 	confirmationToken *string,
 	confirmationExpiresAt *time.Time,
 	confirmedAt *time.Time,
@@ -108,6 +119,11 @@ func RestoreUserModel(
 		return UserModel{}, err
 	}
 
+	roleEnum, err := enum.NewRoleEnum(role)
+	if err != nil {
+		return UserModel{}, err
+	}
+
 	// Create user model with all fields
 	return UserModel{
 		id:                     id,
@@ -115,6 +131,7 @@ func RestoreUserModel(
 		email:                  emailModel,
 		passwordHash:           passwordHash,
 		isActivated:            isActivated,
+		role:                   roleEnum,
 		confirmationToken:      confirmationToken,
 		confirmationExpiresAt:  confirmationExpiresAt,
 		confirmedAt:            confirmedAt,
@@ -145,6 +162,14 @@ func (u *UserModel) IsActivated() bool {
 	return u.isActivated
 }
 
+func (u *UserModel) Role() string {
+	return u.role.String()
+}
+
+func (u *UserModel) IsAdmin() bool {
+	return u.role.String() == enum.EnumRoleAdmin
+}
+
 func (u *UserModel) ConfirmationToken() *string {
 	return u.confirmationToken
 }
@@ -178,6 +203,17 @@ func (u *UserModel) Activate() {
 	u.updatedAt = time.Now().UTC()
 }
 
+func (u *UserModel) ChangeRole(role string) error {
+	roleEnum, err := enum.NewRoleEnum(role)
+	if err != nil {
+		return err
+	}
+
+	u.role = roleEnum
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
 func (u *UserModel) ConfirmAccount() {
 	now := time.Now().UTC()
 	u.isActivated = true
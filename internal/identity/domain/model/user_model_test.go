@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 )
 
@@ -29,6 +31,8 @@ func TestCreateUserModel(t *testing.T) {
 		assert.Equal(t, email, user.Email())
 		assert.Equal(t, passwordHash, user.PasswordHash())
 		assert.False(t, user.IsActivated())
+		assert.Equal(t, enum.EnumRoleUser, user.Role())
+		assert.False(t, user.IsAdmin())
 		assert.NotNil(t, user.ConfirmationToken())
 		assert.Equal(t, confirmationToken, *user.ConfirmationToken())
 		assert.NotNil(t, user.ConfirmationExpiresAt())
@@ -211,7 +215,7 @@ func TestRestoreUserModel(t *testing.T) {
 
 		// Act
 		user, err := model.RestoreUserModel(
-			id, name, email, passwordHash, isActivated,
+			id, name, email, passwordHash, isActivated, enum.EnumRoleAdmin,
 			confirmationToken, confirmationExpiresAt, confirmedAt,
 			resetPasswordToken, resetPasswordExpiresAt,
 			createdAt, updatedAt,
@@ -224,6 +228,8 @@ func TestRestoreUserModel(t *testing.T) {
 		assert.Equal(t, email, user.Email())
 		assert.Equal(t, passwordHash, user.PasswordHash())
 		assert.Equal(t, isActivated, user.IsActivated())
+		assert.Equal(t, enum.EnumRoleAdmin, user.Role())
+		assert.True(t, user.IsAdmin())
 		assert.Equal(t, confirmationToken, user.ConfirmationToken())
 		assert.Equal(t, confirmationExpiresAt, user.ConfirmationExpiresAt())
 		assert.Equal(t, confirmedAt, user.ConfirmedAt())
@@ -244,7 +250,7 @@ func TestRestoreUserModel(t *testing.T) {
 
 		// Act
 		user, err := model.RestoreUserModel(
-			id, name, email, passwordHash, false,
+			id, name, email, passwordHash, false, enum.EnumRoleUser,
 			nil, nil, nil, nil, nil,
 			createdAt, updatedAt,
 		)
@@ -266,7 +272,7 @@ func TestRestoreUserModel(t *testing.T) {
 
 		// Act
 		user, err := model.RestoreUserModel(
-			id, name, email, passwordHash, false,
+			id, name, email, passwordHash, false, enum.EnumRoleUser,
 			nil, nil, nil, nil, nil,
 			createdAt, updatedAt,
 		)
@@ -276,6 +282,27 @@ func TestRestoreUserModel(t *testing.T) {
 		require.Equal(t, "updated at timestamp cannot be before created at timestamp", err.Error())
 		require.Equal(t, model.UserModel{}, user)
 	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		// Arrange
+		id := uint64(123)
+		name := "John Doe"
+		email := "john.doe@example.com"
+		passwordHash := "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+		createdAt := time.Now().UTC().Add(-24 * time.Hour)
+		updatedAt := time.Now().UTC()
+
+		// Act
+		user, err := model.RestoreUserModel(
+			id, name, email, passwordHash, false, "superuser",
+			nil, nil, nil, nil, nil,
+			createdAt, updatedAt,
+		)
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRole)
+		require.Equal(t, model.UserModel{}, user)
+	})
 }
 
 func TestUserModel_BusinessMethods(t *testing.T) {
@@ -466,6 +493,34 @@ func TestUserModel_BusinessMethods(t *testing.T) {
 		require.Error(t, err)
 		assert.Equal(t, "password hash appears to be too short (minimum 32 characters)", err.Error())
 	})
+
+	t.Run("ChangeRole - valid", func(t *testing.T) {
+		// Arrange
+		user := createValidUser(t)
+		originalUpdatedAt := user.UpdatedAt()
+
+		// Act
+		time.Sleep(1 * time.Millisecond) // ensure time difference
+		err := user.ChangeRole(enum.EnumRoleAdmin)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, enum.EnumRoleAdmin, user.Role())
+		assert.True(t, user.IsAdmin())
+		assert.True(t, user.UpdatedAt().After(originalUpdatedAt))
+	})
+
+	t.Run("ChangeRole - invalid", func(t *testing.T) {
+		// Arrange
+		user := createValidUser(t)
+
+		// Act
+		err := user.ChangeRole("superuser")
+
+		// Assert
+		require.ErrorIs(t, err, errs.ErrInvalidRole)
+		assert.Equal(t, enum.EnumRoleUser, user.Role())
+	})
 }
 
 func createValidUser(t *testing.T) model.UserModel {
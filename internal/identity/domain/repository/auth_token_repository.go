@@ -11,4 +11,7 @@ type AuthTokenRepository interface {
 	Update(ctx context.Context, authToken model.AuthTokenModel) error
 	Delete(ctx context.Context, id uint64) error
 	FindByToken(ctx context.Context, token string) (model.AuthTokenModel, error)
+	Rotate(ctx context.Context, id uint64) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllByUserID(ctx context.Context, userID uint64) error
 }
@@ -183,6 +183,147 @@ func (_c *MockAuthTokenRepository_FindByToken_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// RevokeAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *MockAuthTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthTokenRepository_RevokeAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllByUserID'
+type MockAuthTokenRepository_RevokeAllByUserID_Call struct {
+	*mock.Call
+}
+
+// RevokeAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockAuthTokenRepository_Expecter) RevokeAllByUserID(ctx interface{}, userID interface{}) *MockAuthTokenRepository_RevokeAllByUserID_Call {
+	return &MockAuthTokenRepository_RevokeAllByUserID_Call{Call: _e.mock.On("RevokeAllByUserID", ctx, userID)}
+}
+
+func (_c *MockAuthTokenRepository_RevokeAllByUserID_Call) Run(run func(ctx context.Context, userID uint64)) *MockAuthTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_RevokeAllByUserID_Call) Return(_a0 error) *MockAuthTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_RevokeAllByUserID_Call) RunAndReturn(run func(context.Context, uint64) error) *MockAuthTokenRepository_RevokeAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, familyID
+func (_m *MockAuthTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthTokenRepository_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type MockAuthTokenRepository_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *MockAuthTokenRepository_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *MockAuthTokenRepository_RevokeFamily_Call {
+	return &MockAuthTokenRepository_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *MockAuthTokenRepository_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *MockAuthTokenRepository_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_RevokeFamily_Call) Return(_a0 error) *MockAuthTokenRepository_RevokeFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_RevokeFamily_Call) RunAndReturn(run func(context.Context, string) error) *MockAuthTokenRepository_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields: ctx, id
+func (_m *MockAuthTokenRepository) Rotate(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthTokenRepository_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockAuthTokenRepository_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockAuthTokenRepository_Expecter) Rotate(ctx interface{}, id interface{}) *MockAuthTokenRepository_Rotate_Call {
+	return &MockAuthTokenRepository_Rotate_Call{Call: _e.mock.On("Rotate", ctx, id)}
+}
+
+func (_c *MockAuthTokenRepository_Rotate_Call) Run(run func(ctx context.Context, id uint64)) *MockAuthTokenRepository_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_Rotate_Call) Return(_a0 error) *MockAuthTokenRepository_Rotate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthTokenRepository_Rotate_Call) RunAndReturn(run func(context.Context, uint64) error) *MockAuthTokenRepository_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, authToken
 func (_m *MockAuthTokenRepository) Update(ctx context.Context, authToken model.AuthTokenModel) error {
 	ret := _m.Called(ctx, authToken)
@@ -79,6 +79,64 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindAll provides a mock function with given fields: ctx
+func (_m *MockUserRepository) FindAll(ctx context.Context) ([]model.UserModel, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.UserModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.UserModel, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.UserModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UserModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockUserRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserRepository_Expecter) FindAll(ctx interface{}) *MockUserRepository_FindAll_Call {
+	return &MockUserRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockUserRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockUserRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_FindAll_Call) Return(_a0 []model.UserModel, _a1 error) *MockUserRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_FindAll_Call) RunAndReturn(run func(context.Context) ([]model.UserModel, error)) *MockUserRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByConfirmationToken provides a mock function with given fields: ctx, token
 func (_m *MockUserRepository) FindByConfirmationToken(ctx context.Context, token string) (model.UserModel, error) {
 	ret := _m.Called(ctx, token)
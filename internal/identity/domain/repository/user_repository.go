@@ -10,6 +10,7 @@ type UserRepository interface {
 	Create(ctx context.Context, user model.UserModel) (model.UserModel, error)
 	Update(ctx context.Context, user model.UserModel) error
 
+	FindAll(ctx context.Context) ([]model.UserModel, error)
 	FindByEmail(ctx context.Context, email string) (model.UserModel, error)
 	FindByID(ctx context.Context, id uint64) (model.UserModel, error)
 	FindByConfirmationToken(ctx context.Context, token string) (model.UserModel, error)
@@ -0,0 +1,12 @@
+package service
+
+import "context"
+
+// EmailQueueService queues the emails of a user, they are sent in the background and retried
+// when the delivery fails.
+type EmailQueueService interface {
+	// EnqueueEmailConfirmation queues the email within the transaction carried by ctx, it is
+	// only sent if the transaction commits.
+	EnqueueEmailConfirmation(ctx context.Context, userID uint64) error
+	EnqueueResetPasswordEmail(ctx context.Context, userID uint64) error
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptService counts the failed logins of an email, so that repeated failures lock it
+// out whether or not an account exists for it.
+type LoginAttemptService interface {
+	// LockedFor returns how long the email is still locked out, or zero.
+	LockedFor(ctx context.Context, email string) (time.Duration, error)
+	// RecordFailure counts a failed login and returns how long it locks the email out, or zero.
+	RecordFailure(ctx context.Context, email string) (time.Duration, error)
+	// Reset forgets the failed logins of the email after a successful one.
+	Reset(ctx context.Context, email string) error
+}
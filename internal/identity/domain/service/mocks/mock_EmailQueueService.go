@@ -0,0 +1,130 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEmailQueueService is an autogenerated mock type for the EmailQueueService type
+type MockEmailQueueService struct {
+	mock.Mock
+}
+
+type MockEmailQueueService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmailQueueService) EXPECT() *MockEmailQueueService_Expecter {
+	return &MockEmailQueueService_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueEmailConfirmation provides a mock function with given fields: ctx, userID
+func (_m *MockEmailQueueService) EnqueueEmailConfirmation(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueEmailConfirmation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailQueueService_EnqueueEmailConfirmation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueEmailConfirmation'
+type MockEmailQueueService_EnqueueEmailConfirmation_Call struct {
+	*mock.Call
+}
+
+// EnqueueEmailConfirmation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockEmailQueueService_Expecter) EnqueueEmailConfirmation(ctx interface{}, userID interface{}) *MockEmailQueueService_EnqueueEmailConfirmation_Call {
+	return &MockEmailQueueService_EnqueueEmailConfirmation_Call{Call: _e.mock.On("EnqueueEmailConfirmation", ctx, userID)}
+}
+
+func (_c *MockEmailQueueService_EnqueueEmailConfirmation_Call) Run(run func(ctx context.Context, userID uint64)) *MockEmailQueueService_EnqueueEmailConfirmation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEmailQueueService_EnqueueEmailConfirmation_Call) Return(_a0 error) *MockEmailQueueService_EnqueueEmailConfirmation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailQueueService_EnqueueEmailConfirmation_Call) RunAndReturn(run func(context.Context, uint64) error) *MockEmailQueueService_EnqueueEmailConfirmation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueResetPasswordEmail provides a mock function with given fields: ctx, userID
+func (_m *MockEmailQueueService) EnqueueResetPasswordEmail(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueResetPasswordEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailQueueService_EnqueueResetPasswordEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueResetPasswordEmail'
+type MockEmailQueueService_EnqueueResetPasswordEmail_Call struct {
+	*mock.Call
+}
+
+// EnqueueResetPasswordEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockEmailQueueService_Expecter) EnqueueResetPasswordEmail(ctx interface{}, userID interface{}) *MockEmailQueueService_EnqueueResetPasswordEmail_Call {
+	return &MockEmailQueueService_EnqueueResetPasswordEmail_Call{Call: _e.mock.On("EnqueueResetPasswordEmail", ctx, userID)}
+}
+
+func (_c *MockEmailQueueService_EnqueueResetPasswordEmail_Call) Run(run func(ctx context.Context, userID uint64)) *MockEmailQueueService_EnqueueResetPasswordEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEmailQueueService_EnqueueResetPasswordEmail_Call) Return(_a0 error) *MockEmailQueueService_EnqueueResetPasswordEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailQueueService_EnqueueResetPasswordEmail_Call) RunAndReturn(run func(context.Context, uint64) error) *MockEmailQueueService_EnqueueResetPasswordEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmailQueueService creates a new instance of MockEmailQueueService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmailQueueService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmailQueueService {
+	mock := &MockEmailQueueService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
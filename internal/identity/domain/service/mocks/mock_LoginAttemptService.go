@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockLoginAttemptService is an autogenerated mock type for the LoginAttemptService type
+type MockLoginAttemptService struct {
+	mock.Mock
+}
+
+type MockLoginAttemptService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginAttemptService) EXPECT() *MockLoginAttemptService_Expecter {
+	return &MockLoginAttemptService_Expecter{mock: &_m.Mock}
+}
+
+// LockedFor provides a mock function with given fields: ctx, email
+func (_m *MockLoginAttemptService) LockedFor(ctx context.Context, email string) (time.Duration, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockedFor")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Duration, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoginAttemptService_LockedFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockedFor'
+type MockLoginAttemptService_LockedFor_Call struct {
+	*mock.Call
+}
+
+// LockedFor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockLoginAttemptService_Expecter) LockedFor(ctx interface{}, email interface{}) *MockLoginAttemptService_LockedFor_Call {
+	return &MockLoginAttemptService_LockedFor_Call{Call: _e.mock.On("LockedFor", ctx, email)}
+}
+
+func (_c *MockLoginAttemptService_LockedFor_Call) Run(run func(ctx context.Context, email string)) *MockLoginAttemptService_LockedFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptService_LockedFor_Call) Return(_a0 time.Duration, _a1 error) *MockLoginAttemptService_LockedFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoginAttemptService_LockedFor_Call) RunAndReturn(run func(context.Context, string) (time.Duration, error)) *MockLoginAttemptService_LockedFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function with given fields: ctx, email
+func (_m *MockLoginAttemptService) RecordFailure(ctx context.Context, email string) (time.Duration, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Duration, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoginAttemptService_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type MockLoginAttemptService_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockLoginAttemptService_Expecter) RecordFailure(ctx interface{}, email interface{}) *MockLoginAttemptService_RecordFailure_Call {
+	return &MockLoginAttemptService_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, email)}
+}
+
+func (_c *MockLoginAttemptService_RecordFailure_Call) Run(run func(ctx context.Context, email string)) *MockLoginAttemptService_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptService_RecordFailure_Call) Return(_a0 time.Duration, _a1 error) *MockLoginAttemptService_RecordFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoginAttemptService_RecordFailure_Call) RunAndReturn(run func(context.Context, string) (time.Duration, error)) *MockLoginAttemptService_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: ctx, email
+func (_m *MockLoginAttemptService) Reset(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoginAttemptService_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockLoginAttemptService_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockLoginAttemptService_Expecter) Reset(ctx interface{}, email interface{}) *MockLoginAttemptService_Reset_Call {
+	return &MockLoginAttemptService_Reset_Call{Call: _e.mock.On("Reset", ctx, email)}
+}
+
+func (_c *MockLoginAttemptService_Reset_Call) Run(run func(ctx context.Context, email string)) *MockLoginAttemptService_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoginAttemptService_Reset_Call) Return(_a0 error) *MockLoginAttemptService_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoginAttemptService_Reset_Call) RunAndReturn(run func(context.Context, string) error) *MockLoginAttemptService_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginAttemptService creates a new instance of MockLoginAttemptService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginAttemptService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginAttemptService {
+	mock := &MockLoginAttemptService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRefreshTokenService is an autogenerated mock type for the RefreshTokenService type
+type MockRefreshTokenService struct {
+	mock.Mock
+}
+
+type MockRefreshTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRefreshTokenService) EXPECT() *MockRefreshTokenService_Expecter {
+	return &MockRefreshTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Generate provides a mock function with given fields: ctx, userID, familyID
+func (_m *MockRefreshTokenService) Generate(ctx context.Context, userID uint64, familyID string) (string, model.AuthTokenModel, error) {
+	ret := _m.Called(ctx, userID, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Generate")
+	}
+
+	var r0 string
+	var r1 model.AuthTokenModel
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (string, model.AuthTokenModel, error)); ok {
+		return rf(ctx, userID, familyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) string); ok {
+		r0 = rf(ctx, userID, familyID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) model.AuthTokenModel); ok {
+		r1 = rf(ctx, userID, familyID)
+	} else {
+		r1 = ret.Get(1).(model.AuthTokenModel)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, string) error); ok {
+		r2 = rf(ctx, userID, familyID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRefreshTokenService_Generate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Generate'
+type MockRefreshTokenService_Generate_Call struct {
+	*mock.Call
+}
+
+// Generate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - familyID string
+func (_e *MockRefreshTokenService_Expecter) Generate(ctx interface{}, userID interface{}, familyID interface{}) *MockRefreshTokenService_Generate_Call {
+	return &MockRefreshTokenService_Generate_Call{Call: _e.mock.On("Generate", ctx, userID, familyID)}
+}
+
+func (_c *MockRefreshTokenService_Generate_Call) Run(run func(ctx context.Context, userID uint64, familyID string)) *MockRefreshTokenService_Generate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenService_Generate_Call) Return(_a0 string, _a1 model.AuthTokenModel, _a2 error) *MockRefreshTokenService_Generate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRefreshTokenService_Generate_Call) RunAndReturn(run func(context.Context, uint64, string) (string, model.AuthTokenModel, error)) *MockRefreshTokenService_Generate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hash provides a mock function with given fields: token
+func (_m *MockRefreshTokenService) Hash(token string) string {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hash")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockRefreshTokenService_Hash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hash'
+type MockRefreshTokenService_Hash_Call struct {
+	*mock.Call
+}
+
+// Hash is a helper method to define mock.On call
+//   - token string
+func (_e *MockRefreshTokenService_Expecter) Hash(token interface{}) *MockRefreshTokenService_Hash_Call {
+	return &MockRefreshTokenService_Hash_Call{Call: _e.mock.On("Hash", token)}
+}
+
+func (_c *MockRefreshTokenService_Hash_Call) Run(run func(token string)) *MockRefreshTokenService_Hash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenService_Hash_Call) Return(_a0 string) *MockRefreshTokenService_Hash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRefreshTokenService_Hash_Call) RunAndReturn(run func(string) string) *MockRefreshTokenService_Hash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRefreshTokenService creates a new instance of MockRefreshTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRefreshTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRefreshTokenService {
+	mock := &MockRefreshTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockResetPasswordTokenService is an autogenerated mock type for the ResetPasswordTokenService type
+type MockResetPasswordTokenService struct {
+	mock.Mock
+}
+
+type MockResetPasswordTokenService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResetPasswordTokenService) EXPECT() *MockResetPasswordTokenService_Expecter {
+	return &MockResetPasswordTokenService_Expecter{mock: &_m.Mock}
+}
+
+// Generate provides a mock function with no fields
+func (_m *MockResetPasswordTokenService) Generate() (string, string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Generate")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (string, string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() string); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockResetPasswordTokenService_Generate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Generate'
+type MockResetPasswordTokenService_Generate_Call struct {
+	*mock.Call
+}
+
+// Generate is a helper method to define mock.On call
+func (_e *MockResetPasswordTokenService_Expecter) Generate() *MockResetPasswordTokenService_Generate_Call {
+	return &MockResetPasswordTokenService_Generate_Call{Call: _e.mock.On("Generate")}
+}
+
+func (_c *MockResetPasswordTokenService_Generate_Call) Run(run func()) *MockResetPasswordTokenService_Generate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResetPasswordTokenService_Generate_Call) Return(_a0 string, _a1 string, _a2 error) *MockResetPasswordTokenService_Generate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockResetPasswordTokenService_Generate_Call) RunAndReturn(run func() (string, string, error)) *MockResetPasswordTokenService_Generate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hash provides a mock function with given fields: token
+func (_m *MockResetPasswordTokenService) Hash(token string) string {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hash")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockResetPasswordTokenService_Hash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hash'
+type MockResetPasswordTokenService_Hash_Call struct {
+	*mock.Call
+}
+
+// Hash is a helper method to define mock.On call
+//   - token string
+func (_e *MockResetPasswordTokenService_Expecter) Hash(token interface{}) *MockResetPasswordTokenService_Hash_Call {
+	return &MockResetPasswordTokenService_Hash_Call{Call: _e.mock.On("Hash", token)}
+}
+
+func (_c *MockResetPasswordTokenService_Hash_Call) Run(run func(token string)) *MockResetPasswordTokenService_Hash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockResetPasswordTokenService_Hash_Call) Return(_a0 string) *MockResetPasswordTokenService_Hash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResetPasswordTokenService_Hash_Call) RunAndReturn(run func(string) string) *MockResetPasswordTokenService_Hash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockResetPasswordTokenService creates a new instance of MockResetPasswordTokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResetPasswordTokenService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResetPasswordTokenService {
+	mock := &MockResetPasswordTokenService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSendResetPasswordEmailService is an autogenerated mock type for the SendResetPasswordEmailService type
+type MockSendResetPasswordEmailService struct {
+	mock.Mock
+}
+
+type MockSendResetPasswordEmailService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSendResetPasswordEmailService) EXPECT() *MockSendResetPasswordEmailService_Expecter {
+	return &MockSendResetPasswordEmailService_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, userID
+func (_m *MockSendResetPasswordEmailService) Execute(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSendResetPasswordEmailService_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSendResetPasswordEmailService_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockSendResetPasswordEmailService_Expecter) Execute(ctx interface{}, userID interface{}) *MockSendResetPasswordEmailService_Execute_Call {
+	return &MockSendResetPasswordEmailService_Execute_Call{Call: _e.mock.On("Execute", ctx, userID)}
+}
+
+func (_c *MockSendResetPasswordEmailService_Execute_Call) Run(run func(ctx context.Context, userID uint64)) *MockSendResetPasswordEmailService_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockSendResetPasswordEmailService_Execute_Call) Return(_a0 error) *MockSendResetPasswordEmailService_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSendResetPasswordEmailService_Execute_Call) RunAndReturn(run func(context.Context, uint64) error) *MockSendResetPasswordEmailService_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSendResetPasswordEmailService creates a new instance of MockSendResetPasswordEmailService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSendResetPasswordEmailService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSendResetPasswordEmailService {
+	mock := &MockSendResetPasswordEmailService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
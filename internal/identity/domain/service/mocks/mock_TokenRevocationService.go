@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTokenRevocationService is an autogenerated mock type for the TokenRevocationService type
+type MockTokenRevocationService struct {
+	mock.Mock
+}
+
+type MockTokenRevocationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRevocationService) EXPECT() *MockTokenRevocationService_Expecter {
+	return &MockTokenRevocationService_Expecter{mock: &_m.Mock}
+}
+
+// IsRevoked provides a mock function with given fields: ctx, tokenID, userID, issuedAt
+func (_m *MockTokenRevocationService) IsRevoked(ctx context.Context, tokenID string, userID uint64, issuedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, tokenID, userID, issuedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, time.Time) (bool, error)); ok {
+		return rf(ctx, tokenID, userID, issuedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, time.Time) bool); ok {
+		r0 = rf(ctx, tokenID, userID, issuedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, time.Time) error); ok {
+		r1 = rf(ctx, tokenID, userID, issuedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTokenRevocationService_IsRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRevoked'
+type MockTokenRevocationService_IsRevoked_Call struct {
+	*mock.Call
+}
+
+// IsRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - userID uint64
+//   - issuedAt time.Time
+func (_e *MockTokenRevocationService_Expecter) IsRevoked(ctx interface{}, tokenID interface{}, userID interface{}, issuedAt interface{}) *MockTokenRevocationService_IsRevoked_Call {
+	return &MockTokenRevocationService_IsRevoked_Call{Call: _e.mock.On("IsRevoked", ctx, tokenID, userID, issuedAt)}
+}
+
+func (_c *MockTokenRevocationService_IsRevoked_Call) Run(run func(ctx context.Context, tokenID string, userID uint64, issuedAt time.Time)) *MockTokenRevocationService_IsRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTokenRevocationService_IsRevoked_Call) Return(_a0 bool, _a1 error) *MockTokenRevocationService_IsRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTokenRevocationService_IsRevoked_Call) RunAndReturn(run func(context.Context, string, uint64, time.Time) (bool, error)) *MockTokenRevocationService_IsRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: ctx, tokenID, expiresAt
+func (_m *MockTokenRevocationService) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, tokenID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, tokenID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRevocationService_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockTokenRevocationService_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenID string
+//   - expiresAt time.Time
+func (_e *MockTokenRevocationService_Expecter) RevokeToken(ctx interface{}, tokenID interface{}, expiresAt interface{}) *MockTokenRevocationService_RevokeToken_Call {
+	return &MockTokenRevocationService_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, tokenID, expiresAt)}
+}
+
+func (_c *MockTokenRevocationService_RevokeToken_Call) Run(run func(ctx context.Context, tokenID string, expiresAt time.Time)) *MockTokenRevocationService_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTokenRevocationService_RevokeToken_Call) Return(_a0 error) *MockTokenRevocationService_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRevocationService_RevokeToken_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockTokenRevocationService_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function with given fields: ctx, userID
+func (_m *MockTokenRevocationService) RevokeUserTokens(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTokenRevocationService_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type MockTokenRevocationService_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockTokenRevocationService_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}) *MockTokenRevocationService_RevokeUserTokens_Call {
+	return &MockTokenRevocationService_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID)}
+}
+
+func (_c *MockTokenRevocationService_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID uint64)) *MockTokenRevocationService_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockTokenRevocationService_RevokeUserTokens_Call) Return(_a0 error) *MockTokenRevocationService_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTokenRevocationService_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, uint64) error) *MockTokenRevocationService_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRevocationService creates a new instance of MockTokenRevocationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRevocationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRevocationService {
+	mock := &MockTokenRevocationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
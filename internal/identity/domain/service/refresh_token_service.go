@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+)
+
+type RefreshTokenService interface {
+	// Generate returns a new refresh token for the user together with the model to be persisted.
+	// An empty familyID starts a new token family.
+	Generate(ctx context.Context, userID uint64, familyID string) (string, model.AuthTokenModel, error)
+	// Hash returns the value under which a refresh token is stored.
+	Hash(token string) string
+}
@@ -0,0 +1,8 @@
+package service
+
+type ResetPasswordTokenService interface {
+	// Generate returns a new reset password token together with the value under which it is stored.
+	Generate() (string, string, error)
+	// Hash returns the value under which a reset password token is stored.
+	Hash(token string) string
+}
@@ -0,0 +1,7 @@
+package service
+
+import "context"
+
+type SendResetPasswordEmailService interface {
+	Execute(ctx context.Context, userID uint64) error
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+type TokenRevocationService interface {
+	// RevokeToken denylists a single access token until it expires.
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// RevokeUserTokens denylists every access token issued to the user up to now.
+	RevokeUserTokens(ctx context.Context, userID uint64) error
+	IsRevoked(ctx context.Context, tokenID string, userID uint64, issuedAt time.Time) (bool, error)
+}
@@ -0,0 +1,62 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/fx"
+
+	domain_service "github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/service"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/outbox"
+	shared_queue "github.com/cristiano-pacheco/goflix/internal/shared/modules/queue"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+type SendEmailConsumerResult struct {
+	fx.Out
+
+	EmailConfirmation  shared_queue.Subscription `group:"queue_subscriptions"`
+	ResetPasswordEmail shared_queue.Subscription `group:"queue_subscriptions"`
+}
+
+// NewSendEmailConsumer sends the emails queued by the user repository, through the outbox, and
+// by the EmailQueueService. An email is sent once per message ID, even when it is redelivered.
+func NewSendEmailConsumer(
+	sendEmailConfirmationService domain_service.SendEmailConfirmationService,
+	sendResetPasswordEmailService domain_service.SendResetPasswordEmailService,
+	deduplicator outbox.Deduplicator,
+) SendEmailConsumerResult {
+	return SendEmailConsumerResult{
+		EmailConfirmation: shared_queue.Subscription{
+			Topic:   service.EmailConfirmationTopic,
+			Handler: sendEmailHandler(deduplicator, sendEmailConfirmationService.Execute),
+		},
+		ResetPasswordEmail: shared_queue.Subscription{
+			Topic:   service.ResetPasswordEmailTopic,
+			Handler: sendEmailHandler(deduplicator, sendResetPasswordEmailService.Execute),
+		},
+	}
+}
+
+func sendEmailHandler(
+	deduplicator outbox.Deduplicator,
+	send func(ctx context.Context, userID uint64) error,
+) queue.Handler {
+	return func(ctx context.Context, envelope queue.Envelope) error {
+		var message service.EmailMessage
+		if err := envelope.Decode(&message); err != nil {
+			return err
+		}
+
+		err := deduplicator.Once(ctx, envelope.Topic, envelope.ID, func(ctx context.Context) error {
+			return send(ctx, message.UserID)
+		})
+		// The user was deleted since, there is no one left to send the email to
+		if errors.Is(err, shared_errs.ErrNotFound) {
+			return queue.Permanent(err)
+		}
+		return err
+	}
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type CreateUserRequest struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
@@ -30,3 +32,25 @@ type UpdateUserRequest struct {
 type SendConfirmationEmailMessage struct {
 	UserID uint64 `json:"user_id"`
 }
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type AdminUserResponse struct {
+	UserID      uint64    `json:"user_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	IsActivated bool      `json:"is_activated"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role"`
+}
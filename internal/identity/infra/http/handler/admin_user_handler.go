@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/dto"
+	shared_errs "github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type AdminUserHandler struct {
+	errorMapper           shared_errs.ErrorMapper
+	userListUseCase       *usecase.UserListUseCase
+	userRoleUpdateUseCase *usecase.UserRoleUpdateUseCase
+}
+
+func NewAdminUserHandler(
+	errorMapper shared_errs.ErrorMapper,
+	userListUseCase *usecase.UserListUseCase,
+	userRoleUpdateUseCase *usecase.UserRoleUpdateUseCase,
+) *AdminUserHandler {
+	return &AdminUserHandler{errorMapper, userListUseCase, userRoleUpdateUseCase}
+}
+
+// @Summary		List users
+// @Description	Lists all users. Requires the admin role.
+// @Tags		Admin
+// @Produce		json
+// @Security 	BearerAuth
+// @Success		200	{object}	response.Envelope[[]dto.AdminUserResponse]	"Successfully listed users"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/admin/users [get]
+func (h *AdminUserHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AdminUserHandler.List")
+	defer span.End()
+
+	output, err := h.userListUseCase.Execute(ctx)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	resData := make([]dto.AdminUserResponse, len(output))
+	for i, user := range output {
+		resData[i] = dto.AdminUserResponse{
+			UserID:      user.UserID,
+			Name:        user.Name,
+			Email:       user.Email,
+			Role:        user.Role,
+			IsActivated: user.IsActivated,
+			CreatedAt:   user.CreatedAt,
+		}
+	}
+
+	envelope := response.NewEnvelope(resData)
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Update user role
+// @Description	Changes the role of a user and revokes the access tokens already issued to them.
+// @Description	Requires the admin role.
+// @Tags		Admin
+// @Accept		json
+// @Security 	BearerAuth
+// @Param		id		path	int							true	"User ID"
+// @Param		request	body	dto.UpdateUserRoleRequest	true	"New role (user or admin)"
+// @Success		204		"Successfully updated role"
+// @Failure		400	{object}	errs.Error	"Invalid role"
+// @Failure		401	{object}	errs.Error	"Invalid credentials"
+// @Failure		403	{object}	errs.Error	"Admin role required"
+// @Failure		404	{object}	errs.Error	"User not found"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/admin/users/{id}/role [put]
+func (h *AdminUserHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AdminUserHandler.UpdateRole")
+	defer span.End()
+
+	userID, err := strconv.ParseUint(request.Param(r, "id"), 10, 64)
+	if err != nil || userID == 0 {
+		response.Error(w, shared_errs.NewBadRequestError("invalid id"))
+		return
+	}
+
+	var req dto.UpdateUserRoleRequest
+	if err = request.ReadJSON(w, r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UserRoleUpdateInput{
+		ActorID: request.GetUserID(r),
+		UserID:  userID,
+		Role:    req.Role,
+	}
+
+	err = h.userRoleUpdateUseCase.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidRole) || errors.Is(err, errs.ErrCannotChangeOwnRole) {
+			rError := h.errorMapper.MapCustomError(http.StatusBadRequest, err.Error())
+			response.Error(w, rError)
+			return
+		}
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,29 +2,47 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	identity_errs "github.com/cristiano-pacheco/goflix/internal/identity/domain/errs"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/dto"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
 	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
 )
 
 type AuthHandler struct {
-	errorMapper          errs.ErrorMapper
-	tokenGenerateUseCase *usecase.TokenGenerateUseCase
+	errorMapper              errs.ErrorMapper
+	tokenGenerateUseCase     *usecase.TokenGenerateUseCase
+	tokenRefreshUseCase      *usecase.TokenRefreshUseCase
+	tokenRevokeUseCase       *usecase.TokenRevokeUseCase
+	accessTokenRevokeUseCase *usecase.AccessTokenRevokeUseCase
+	userTokensRevokeUseCase  *usecase.UserTokensRevokeUseCase
 }
 
 func NewAuthHandler(
 	errorMapper errs.ErrorMapper,
 	tokenGenerateUseCase *usecase.TokenGenerateUseCase,
+	tokenRefreshUseCase *usecase.TokenRefreshUseCase,
+	tokenRevokeUseCase *usecase.TokenRevokeUseCase,
+	accessTokenRevokeUseCase *usecase.AccessTokenRevokeUseCase,
+	userTokensRevokeUseCase *usecase.UserTokensRevokeUseCase,
 ) *AuthHandler {
-	return &AuthHandler{errorMapper, tokenGenerateUseCase}
+	return &AuthHandler{
+		errorMapper,
+		tokenGenerateUseCase,
+		tokenRefreshUseCase,
+		tokenRevokeUseCase,
+		accessTokenRevokeUseCase,
+		userTokensRevokeUseCase,
+	}
 }
 
 // @Summary		Generate authentication token
-// @Description	Authenticates user credentials and returns an access token
+// @Description	Authenticates user credentials and returns an access token and a refresh token
 // @Tags		Authentication
 // @Accept		json
 // @Produce		json
@@ -33,6 +51,7 @@ func NewAuthHandler(
 // @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
 // @Failure		401	{object}	errs.Error	"Invalid credentials"
 // @Failure		404	{object}	errs.Error	"User not found"
+// @Failure		429	{object}	errs.Error	"Too many requests, or account locked after failed logins"
 // @Failure		500	{object}	errs.Error	"Internal server error"
 // @Router		/api/v1/auth/token [post]
 func (h *AuthHandler) GenerateToken(w http.ResponseWriter, r *http.Request) {
@@ -53,12 +72,143 @@ func (h *AuthHandler) GenerateToken(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.tokenGenerateUseCase.Execute(ctx, input)
 	if err != nil {
+		var lockedErr *identity_errs.AccountLockedError
+		if errors.As(err, &lockedErr) {
+			response.SetRetryAfter(w, lockedErr.RetryAfter)
+		}
 		rError := h.errorMapper.Map(err)
 		response.Error(w, rError)
 		return
 	}
 
-	generateTokenResponse := dto.GenerateTokenResponse{Token: output.Token}
+	generateTokenResponse := dto.GenerateTokenResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	}
 	envelope := response.NewEnvelope(generateTokenResponse)
 	response.JSON(w, http.StatusOK, envelope, nil)
 }
+
+// @Summary		Refresh authentication token
+// @Description	Exchanges a refresh token for a new access token and a new refresh token.
+// @Description	The presented refresh token is rotated; presenting it again revokes the whole session.
+// @Tags		Authentication
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.RefreshTokenRequest	true	"Refresh token"
+// @Success		200	{object}	response.Envelope[dto.RefreshTokenResponse]	"Successfully refreshed token"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		401	{object}	errs.Error	"Invalid, expired or revoked refresh token"
+// @Failure		429	{object}	errs.Error	"Too many requests"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/refresh [post]
+func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AuthHandler.RefreshToken")
+	defer span.End()
+
+	var request dto.RefreshTokenRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.TokenRefreshInput{RefreshToken: request.RefreshToken}
+
+	output, err := h.tokenRefreshUseCase.Execute(ctx, input)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	refreshTokenResponse := dto.RefreshTokenResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	}
+	envelope := response.NewEnvelope(refreshTokenResponse)
+	response.JSON(w, http.StatusOK, envelope, nil)
+}
+
+// @Summary		Logout
+// @Description	Revokes the refresh token and every token issued from the same login
+// @Tags		Authentication
+// @Accept		json
+// @Param		request	body	dto.LogoutRequest	true	"Refresh token"
+// @Success		204	"Successfully logged out"
+// @Failure		400	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/logout [post]
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AuthHandler.Logout")
+	defer span.End()
+
+	var request dto.LogoutRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.TokenRevokeInput{RefreshToken: request.RefreshToken}
+
+	err = h.tokenRevokeUseCase.Execute(ctx, input)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary		Revoke current access token
+// @Description	Revokes the access token used to authenticate this request
+// @Tags		Authentication
+// @Security 	BearerAuth
+// @Success		204	"Successfully revoked token"
+// @Failure		401	{object}	errs.Error	"Invalid token"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/revoke [post]
+func (h *AuthHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AuthHandler.RevokeToken")
+	defer span.End()
+
+	input := usecase.AccessTokenRevokeInput{
+		TokenID:   request.GetTokenID(r),
+		ExpiresAt: request.GetTokenExpiresAt(r),
+	}
+
+	err := h.accessTokenRevokeUseCase.Execute(ctx, input)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary		Revoke all tokens
+// @Description	Revokes every access and refresh token issued to the authenticated user
+// @Tags		Authentication
+// @Security 	BearerAuth
+// @Success		204	"Successfully revoked tokens"
+// @Failure		401	{object}	errs.Error	"Invalid token"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/auth/revoke-all [post]
+func (h *AuthHandler) RevokeAllTokens(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "AuthHandler.RevokeAllTokens")
+	defer span.End()
+
+	input := usecase.UserTokensRevokeInput{UserID: request.GetUserID(r)}
+
+	err := h.userTokensRevokeUseCase.Execute(ctx, input)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
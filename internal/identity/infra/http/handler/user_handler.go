@@ -19,6 +19,9 @@ type UserHandler struct {
 	userUpdateUseCase   *usecase.UserUpdateUseCase
 	userFindUseCase     *usecase.UserFindUseCase
 	userActivateUseCase *usecase.UserActivateUseCase
+
+	userPasswordForgotUseCase *usecase.UserPasswordForgotUseCase
+	userPasswordResetUseCase  *usecase.UserPasswordResetUseCase
 }
 
 func NewUserHandler(
@@ -27,6 +30,8 @@ func NewUserHandler(
 	userUpdateUseCase *usecase.UserUpdateUseCase,
 	userFindUseCase *usecase.UserFindUseCase,
 	userActivateUseCase *usecase.UserActivateUseCase,
+	userPasswordForgotUseCase *usecase.UserPasswordForgotUseCase,
+	userPasswordResetUseCase *usecase.UserPasswordResetUseCase,
 ) *UserHandler {
 	return &UserHandler{
 		errorMapper,
@@ -34,6 +39,8 @@ func NewUserHandler(
 		userUpdateUseCase,
 		userFindUseCase,
 		userActivateUseCase,
+		userPasswordForgotUseCase,
+		userPasswordResetUseCase,
 	}
 }
 
@@ -43,8 +50,11 @@ func NewUserHandler(
 // @Accept		json
 // @Produce		json
 // @Param		request	body	dto.CreateUserRequest	true	"User data"
+// @Param		Idempotency-Key	header	string	false	"Replays the response to the first request with the key"
 // @Success		201	{object}	response.Envelope[dto.CreateUserResponse]	"Successfully created user"
-// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		409	{object}	errs.Error	"A request with the idempotency key is still being processed"
+// @Failure		422	{object}	errs.Error	"Invalid request format, validation error or idempotency key reused"
+// @Failure		429	{object}	errs.Error	"Too many requests"
 // @Failure		500	{object}	errs.Error	"Internal server error"
 // @Router		/api/v1/users [post]
 func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
@@ -86,7 +96,8 @@ func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary		Update user
-// @Description	Updates an existing user
+// @Description	Updates the authenticated user. The password changes, so every token issued to the user
+// @Description	is revoked and the user has to log in again
 // @Tags		Users
 // @Accept		json
 // @Produce		json
@@ -198,3 +209,86 @@ func (h *UserHandler) Activate(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// @Summary		Forgot password
+// @Description	Sends a reset password link to the user. The response is the same whether or not the email is registered.
+// @Tags		Users
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.ForgotPasswordRequest	true	"User email"
+// @Success		204		"Reset password link sent if the account exists"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		429	{object}	errs.Error	"Too many requests"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/users/password/forgot [post]
+func (h *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "UserHandler.ForgotPassword")
+	defer span.End()
+
+	var req dto.ForgotPasswordRequest
+	if err := request.ReadJSON(w, r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UserPasswordForgotInput{Email: req.Email}
+	err := h.userPasswordForgotUseCase.Execute(ctx, input)
+	if err != nil {
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary		Reset password
+// @Description	Sets a new password using the token received by email
+// @Tags		Users
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.ResetPasswordRequest	true	"Reset password data"
+// @Success		204		"Successfully reset password"
+// @Failure		400	{object}	errs.Error	"Invalid token or password"
+// @Failure		422	{object}	errs.Error	"Invalid request format or validation error"
+// @Failure		429	{object}	errs.Error	"Too many requests"
+// @Failure		500	{object}	errs.Error	"Internal server error"
+// @Router		/api/v1/users/password/reset [post]
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Trace().StartSpan(r.Context(), "UserHandler.ResetPassword")
+	defer span.End()
+
+	var req dto.ResetPasswordRequest
+	if err := request.ReadJSON(w, r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	input := usecase.UserPasswordResetInput{
+		Token:    req.Token,
+		Password: req.Password,
+	}
+
+	err := h.userPasswordResetUseCase.Execute(ctx, input)
+	if err != nil {
+		if isPasswordResetError(err) {
+			rError := h.errorMapper.MapCustomError(http.StatusBadRequest, err.Error())
+			response.Error(w, rError)
+			return
+		}
+		rError := h.errorMapper.Map(err)
+		response.Error(w, rError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func isPasswordResetError(err error) bool {
+	return errors.Is(err, errs.ErrInvalidResetPasswordToken) ||
+		errors.Is(err, errs.ErrPasswordTooShort) ||
+		errors.Is(err, errs.ErrPasswordNoUppercase) ||
+		errors.Is(err, errs.ErrPasswordNoLowercase) ||
+		errors.Is(err, errs.ErrPasswordNoNumber) ||
+		errors.Is(err, errs.ErrPasswordNoSpecialChar)
+}
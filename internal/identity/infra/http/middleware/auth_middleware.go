@@ -9,6 +9,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 	shared_jwt "github.com/cristiano-pacheco/goflix/internal/shared/modules/jwt"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/registry"
@@ -17,10 +18,11 @@ import (
 )
 
 type AuthMiddleware struct {
-	jwtParser          *jwt.Parser
-	errorMapper        errs.ErrorMapper
-	privateKeyRegistry registry.PrivateKeyRegistry
-	userRepository     repository.UserRepository
+	jwtParser              *jwt.Parser
+	errorMapper            errs.ErrorMapper
+	privateKeyRegistry     registry.PrivateKeyRegistry
+	userRepository         repository.UserRepository
+	tokenRevocationService service.TokenRevocationService
 }
 
 func NewAuthMiddleware(
@@ -28,8 +30,9 @@ func NewAuthMiddleware(
 	errorMapper errs.ErrorMapper,
 	privateKeyRegistry registry.PrivateKeyRegistry,
 	userRepository repository.UserRepository,
+	tokenRevocationService service.TokenRevocationService,
 ) *AuthMiddleware {
-	return &AuthMiddleware{jwtParser, errorMapper, privateKeyRegistry, userRepository}
+	return &AuthMiddleware{jwtParser, errorMapper, privateKeyRegistry, userRepository, tokenRevocationService}
 }
 
 // Middleware returns a Chi middleware function for authentication
@@ -67,7 +70,24 @@ func (m *AuthMiddleware) Middleware(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
+		// Tokens without an ID or timestamps cannot be revoked, so they are not accepted
+		if claims.ID == "" || claims.IssuedAt == nil || claims.ExpiresAt == nil {
+			m.handleError(w, errs.ErrInvalidToken)
+			return
+		}
+
 		ctx := r.Context()
+		isRevoked, err := m.tokenRevocationService.IsRevoked(ctx, claims.ID, userID, claims.IssuedAtTime())
+		if err != nil {
+			m.handleError(w, err)
+			return
+		}
+
+		if isRevoked {
+			m.handleError(w, errs.ErrInvalidToken)
+			return
+		}
+
 		isActivated, err := m.userRepository.IsActivated(ctx, userID)
 		if err != nil {
 			m.handleError(w, err)
@@ -81,6 +101,9 @@ func (m *AuthMiddleware) Middleware(next http.HandlerFunc) http.HandlerFunc {
 
 		// Store user ID in context
 		ctx = context.WithValue(ctx, request.UserIDKey, userID)
+		ctx = context.WithValue(ctx, request.UserRoleKey, claims.Role)
+		ctx = context.WithValue(ctx, request.TokenIDKey, claims.ID)
+		ctx = context.WithValue(ctx, request.TokenExpiresAtKey, claims.ExpiresAt.Time)
 
 		// Call next handler with updated context
 		next(w, r.WithContext(ctx))
@@ -0,0 +1,218 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/application/usecase"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	domain_service "github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	service_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/service/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	shared_jwt "github.com/cristiano-pacheco/goflix/internal/shared/modules/jwt"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	registry_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/registry/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const (
+	testUserID   = uint64(1)
+	passwordHash = "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("valid token is accepted", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+		token := sut.generateToken(t)
+
+		// Act
+		code := sut.do(token)
+
+		// Assert
+		require.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("invalid token returns 401", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+
+		// Act
+		code := sut.do("invalid-token")
+
+		// Assert
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("revoked token returns 401", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+		token := sut.generateToken(t)
+		var tokenID string
+		var expiresAt time.Time
+		sut.handler = func(_ http.ResponseWriter, r *http.Request) {
+			tokenID = request.GetTokenID(r)
+			expiresAt = request.GetTokenExpiresAt(r)
+		}
+		require.Equal(t, http.StatusOK, sut.do(token))
+		require.NoError(t, sut.revocationService.RevokeToken(context.Background(), tokenID, expiresAt))
+
+		// Act
+		code := sut.do(token)
+
+		// Assert
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("token issued before a revoke-all returns 401", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+		token := sut.generateToken(t)
+		require.NoError(t, sut.revocationService.RevokeUserTokens(context.Background(), testUserID))
+
+		// Act
+		code := sut.do(token)
+
+		// Assert
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("token issued before a password change returns 401", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+		token := sut.generateToken(t)
+		userUpdateUseCase := newUserUpdateUseCase(t, sut.revocationService)
+		input := usecase.UserUpdateInput{UserID: testUserID, Name: "John Doe", Password: "new-password"}
+		require.NoError(t, userUpdateUseCase.Execute(context.Background(), input))
+
+		// Act
+		code := sut.do(token)
+
+		// Assert
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("token issued after a revoke-all is accepted", func(t *testing.T) {
+		// Arrange
+		sut := newAuthMiddlewareSUT(t, privateKey)
+		require.NoError(t, sut.revocationService.RevokeUserTokens(context.Background(), testUserID))
+		time.Sleep(2 * time.Millisecond)
+		token := sut.generateToken(t)
+
+		// Act
+		code := sut.do(token)
+
+		// Assert
+		require.Equal(t, http.StatusOK, code)
+	})
+}
+
+type authMiddlewareSUT struct {
+	authMiddleware    *middleware.AuthMiddleware
+	tokenService      service.TokenService
+	revocationService domain_service.TokenRevocationService
+	handler           http.HandlerFunc
+}
+
+func newAuthMiddlewareSUT(t *testing.T, privateKey *rsa.PrivateKey) *authMiddlewareSUT {
+	t.Helper()
+
+	conf := config.Config{JWT: config.JWT{Issuer: "goflix", ExpirationInSeconds: 3600}}
+	server := miniredis.RunT(t)
+
+	privateKeyRegistry := registry_mocks.NewMockPrivateKeyRegistry(t)
+	privateKeyRegistry.EXPECT().Get().Return(privateKey).Maybe()
+
+	userRepository := repository_mocks.NewMockUserRepository(t)
+	userRepository.EXPECT().IsActivated(mock.Anything, testUserID).Return(true, nil).Maybe()
+
+	revocationService := service.NewTokenRevocationService(redis.NewRedis(server.Addr(), "", 0), conf)
+
+	return &authMiddlewareSUT{
+		authMiddleware: middleware.NewAuthMiddleware(
+			shared_jwt.NewParser(),
+			errs.New(nil, nil),
+			privateKeyRegistry,
+			userRepository,
+			revocationService,
+		),
+		tokenService:      service.NewTokenService(conf, privateKeyRegistry, logger_mocks.NewMockLogger(t)),
+		revocationService: revocationService,
+		handler:           func(http.ResponseWriter, *http.Request) {},
+	}
+}
+
+func newUserUpdateUseCase(
+	t *testing.T,
+	revocationService domain_service.TokenRevocationService,
+) *usecase.UserUpdateUseCase {
+	t.Helper()
+
+	user, err := model.RestoreUserModel(
+		testUserID, "John Doe", "john.doe@example.com", passwordHash, true, enum.EnumRoleUser,
+		nil, nil, nil, nil, nil,
+		time.Now().UTC(), time.Now().UTC(),
+	)
+	require.NoError(t, err)
+
+	userRepository := repository_mocks.NewMockUserRepository(t)
+	userRepository.EXPECT().FindByID(mock.Anything, testUserID).Return(user, nil).Once()
+	userRepository.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+
+	authTokenRepository := repository_mocks.NewMockAuthTokenRepository(t)
+	authTokenRepository.EXPECT().RevokeAllByUserID(mock.Anything, testUserID).Return(nil).Once()
+
+	hashService := service_mocks.NewMockHashService(t)
+	hashService.EXPECT().GenerateFromPassword([]byte("new-password")).Return([]byte(passwordHash), nil).Once()
+
+	return usecase.NewUserUpdateUseCase(
+		validator.New(),
+		userRepository,
+		authTokenRepository,
+		logger_mocks.NewMockLogger(t),
+		hashService,
+		revocationService,
+	)
+}
+
+func (s *authMiddlewareSUT) generateToken(t *testing.T) string {
+	t.Helper()
+
+	user, err := model.RestoreUserModel(
+		testUserID, "John Doe", "john.doe@example.com", passwordHash, true, enum.EnumRoleUser,
+		nil, nil, nil, nil, nil,
+		time.Now().UTC(), time.Now().UTC(),
+	)
+	require.NoError(t, err)
+
+	token, err := s.tokenService.Generate(context.Background(), user)
+	require.NoError(t, err)
+	return token
+}
+
+func (s *authMiddlewareSUT) do(token string) int {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	s.authMiddleware.Middleware(s.handler)(w, r)
+	return w.Code
+}
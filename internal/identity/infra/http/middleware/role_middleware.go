@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+)
+
+type RoleMiddleware struct {
+	errorMapper errs.ErrorMapper
+}
+
+func NewRoleMiddleware(errorMapper errs.ErrorMapper) *RoleMiddleware {
+	return &RoleMiddleware{errorMapper}
+}
+
+// RequireRole only lets the request through when the authenticated user has one of the given roles.
+// It relies on the role stored in the context by AuthMiddleware, so it must be wrapped by it.
+func (m *RoleMiddleware) RequireRole(next http.HandlerFunc, roles ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		role := request.GetUserRole(r)
+		if !slices.Contains(roles, role) {
+			rError := m.errorMapper.Map(errs.ErrForbidden)
+			response.Error(w, rError)
+			return
+		}
+
+		next(w, r)
+	}
+}
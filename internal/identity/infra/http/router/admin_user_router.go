@@ -0,0 +1,28 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+)
+
+func SetupAdminUserRoutes(
+	r *Router,
+	adminUserHandler *handler.AdminUserHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) {
+	router := r.Router()
+	router.HandlerFunc(
+		http.MethodGet,
+		"/api/v1/admin/users",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(adminUserHandler.List, enum.EnumRoleAdmin)),
+	)
+	router.HandlerFunc(
+		http.MethodPut,
+		"/api/v1/admin/users/:id/role",
+		authMiddleware.Middleware(roleMiddleware.RequireRole(adminUserHandler.UpdateRole, enum.EnumRoleAdmin)),
+	)
+}
@@ -4,9 +4,29 @@ import (
 	"net/http"
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/handler"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	shared_middleware "github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/middleware"
 )
 
-func SetupAuthRoutes(r *Router, authHandler *handler.AuthHandler) {
+func SetupAuthRoutes(
+	r *Router,
+	authHandler *handler.AuthHandler,
+	authMiddleware *middleware.AuthMiddleware,
+	rateLimitMiddleware *shared_middleware.RateLimitMiddleware,
+) {
 	router := r.Router()
-	router.HandlerFunc(http.MethodPost, "/api/v1/auth/token", authHandler.GenerateToken)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/auth/token",
+		rateLimitMiddleware.Middleware(config.RateLimitPolicyAuthToken, authHandler.GenerateToken),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/auth/refresh",
+		rateLimitMiddleware.Middleware(config.RateLimitPolicyAuthRefresh, authHandler.RefreshToken),
+	)
+	router.HandlerFunc(http.MethodPost, "/api/v1/auth/logout", authHandler.Logout)
+	router.HandlerFunc(http.MethodPost, "/api/v1/auth/revoke", authMiddleware.Middleware(authHandler.RevokeToken))
+	router.HandlerFunc(http.MethodPost, "/api/v1/auth/revoke-all", authMiddleware.Middleware(authHandler.RevokeAllTokens))
 }
@@ -5,16 +5,37 @@ import (
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/handler"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	shared_middleware "github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/middleware"
 )
 
 func SetupUserRoutes(
 	r *Router,
 	userHandler *handler.UserHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	idempotencyMiddleware *shared_middleware.IdempotencyMiddleware,
+	rateLimitMiddleware *shared_middleware.RateLimitMiddleware,
 ) {
 	router := r.Router()
-	router.HandlerFunc(http.MethodPost, "/api/v1/users", userHandler.Create)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/users",
+		rateLimitMiddleware.Middleware(
+			config.RateLimitPolicyUsersCreate,
+			idempotencyMiddleware.Middleware(userHandler.Create),
+		),
+	)
 	router.HandlerFunc(http.MethodPost, "/api/v1/users/activate", userHandler.Activate)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/users/password/forgot",
+		rateLimitMiddleware.Middleware(config.RateLimitPolicyPasswordForgot, userHandler.ForgotPassword),
+	)
+	router.HandlerFunc(
+		http.MethodPost,
+		"/api/v1/users/password/reset",
+		rateLimitMiddleware.Middleware(config.RateLimitPolicyPasswordReset, userHandler.ResetPassword),
+	)
 	router.HandlerFunc(http.MethodGet, "/api/v1/users/me", authMiddleware.Middleware(userHandler.FindByID))
 	router.HandlerFunc(http.MethodPut, "/api/v1/users/me", authMiddleware.Middleware(userHandler.Update))
 }
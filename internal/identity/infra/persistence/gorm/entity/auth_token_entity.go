@@ -3,14 +3,17 @@ package entity
 import "time"
 
 type AuthTokenEntity struct {
-	ID        uint64    `gorm:"primarykey;autoIncrement;column:id"`
-	UserID    uint64    `gorm:"type:bigint;not null;column:user_id"`
-	Token     string    `gorm:"type:varchar;not null;unique;column:token"`
-	ExpiresAt time.Time `gorm:"type:timestamptz;not null;column:expires_at"`
-	CreatedAt time.Time `gorm:"type:timestamptz;default:now();column:created_at"`
-	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();column:updated_at"`
+	ID        uint64     `gorm:"primarykey;autoIncrement;column:id"`
+	UserID    uint64     `gorm:"type:bigint;not null;column:user_id"`
+	Token     string     `gorm:"type:varchar;not null;unique;column:token"`
+	FamilyID  string     `gorm:"type:varchar;not null;column:family_id"`
+	ExpiresAt time.Time  `gorm:"type:timestamptz;not null;column:expires_at"`
+	RotatedAt *time.Time `gorm:"type:timestamptz;column:rotated_at"`
+	RevokedAt *time.Time `gorm:"type:timestamptz;column:revoked_at"`
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();column:created_at"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();column:updated_at"`
 }
 
 func (*AuthTokenEntity) TableName() string {
-	return "login_token"
+	return "auth_token"
 }
@@ -8,6 +8,7 @@ type UserEntity struct {
 	Email                  string     `gorm:"type:varchar;not null;unique;column:email"`
 	PasswordHash           string     `gorm:"type:varchar;not null;column:password_hash"`
 	IsActivated            bool       `gorm:"type:boolean;not null;default:false;column:is_activated"`
+	Role                   string     `gorm:"type:varchar;not null;default:user;column:role"`
 	ConfirmationToken      *string    `gorm:"type:varchar;column:confirmation_token"`
 	ConfirmationExpiresAt  *time.Time `gorm:"type:timestamptz;column:confirmation_expires_at"`
 	ConfirmedAt            *time.Time `gorm:"type:timestamptz;column:confirmed_at"`
@@ -22,7 +22,10 @@ func (m *authTokenMapper) ToModel(entity entity.AuthTokenEntity) (model.AuthToke
 		entity.ID,
 		entity.UserID,
 		entity.Token,
+		entity.FamilyID,
 		entity.ExpiresAt,
+		entity.RotatedAt,
+		entity.RevokedAt,
 		entity.CreatedAt,
 		entity.UpdatedAt,
 	)
@@ -37,7 +40,10 @@ func (m *authTokenMapper) ToEntity(model model.AuthTokenModel) entity.AuthTokenE
 		ID:        model.ID(),
 		UserID:    model.UserID(),
 		Token:     model.Token(),
+		FamilyID:  model.FamilyID(),
 		ExpiresAt: model.ExpiresAt(),
+		RotatedAt: model.RotatedAt(),
+		RevokedAt: model.RevokedAt(),
 		CreatedAt: model.CreatedAt(),
 		UpdatedAt: model.UpdatedAt(),
 	}
@@ -23,6 +23,7 @@ func TestAuthTokenMapper_ToModel(t *testing.T) {
 		ID:        123,
 		UserID:    456,
 		Token:     "token123",
+		FamilyID:  "family123",
 		ExpiresAt: expiresAt,
 		CreatedAt: now,
 		UpdatedAt: now,
@@ -59,6 +60,7 @@ func TestLoginTokenMapper_ToModel_WithNilConsumedAt(t *testing.T) {
 		ID:        123,
 		UserID:    456,
 		Token:     "token123",
+		FamilyID:  "family123",
 		ExpiresAt: expiresAt,
 		CreatedAt: now,
 		UpdatedAt: now,
@@ -94,7 +96,10 @@ func TestLoginTokenMapper_ToEntity(t *testing.T) {
 		123,
 		456,
 		"token123",
+		"family123",
 		expiresAt,
+		nil,
+		nil,
 		now,
 		now,
 	)
@@ -110,6 +115,9 @@ func TestLoginTokenMapper_ToEntity(t *testing.T) {
 	assert.Equal(t, uint64(123), authTokenEntity.ID)
 	assert.Equal(t, uint64(456), authTokenEntity.UserID)
 	assert.Equal(t, "token123", authTokenEntity.Token)
+	assert.Equal(t, "family123", authTokenEntity.FamilyID)
+	assert.Nil(t, authTokenEntity.RotatedAt)
+	assert.Nil(t, authTokenEntity.RevokedAt)
 	assert.Equal(t, expiresAt.Unix(), authTokenEntity.ExpiresAt.Unix())
 
 	assert.Equal(t, now.Unix(), authTokenEntity.CreatedAt.Unix())
@@ -132,6 +140,7 @@ func TestLoginTokenMapper_InvalidData(t *testing.T) {
 				ID:        0, // Invalid ID
 				UserID:    456,
 				Token:     "token123",
+				FamilyID:  "family123",
 				ExpiresAt: now.Add(24 * time.Hour),
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -144,18 +153,33 @@ func TestLoginTokenMapper_InvalidData(t *testing.T) {
 				ID:        123,
 				UserID:    0, // Invalid UserID
 				Token:     "token123",
+				FamilyID:  "family123",
 				ExpiresAt: now.Add(24 * time.Hour),
 				CreatedAt: now,
 				UpdatedAt: now,
 			},
 			errorString: "user ID is required",
 		},
+		{
+			name: "Empty FamilyID",
+			entity: entity.AuthTokenEntity{
+				ID:        123,
+				UserID:    456,
+				Token:     "token123",
+				FamilyID:  "", // Invalid FamilyID
+				ExpiresAt: now.Add(24 * time.Hour),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			errorString: "family ID is required",
+		},
 		{
 			name: "Empty Token",
 			entity: entity.AuthTokenEntity{
 				ID:        123,
 				UserID:    456,
 				Token:     "", // Invalid Token
+				FamilyID:  "family123",
 				ExpiresAt: now.Add(24 * time.Hour),
 				CreatedAt: now,
 				UpdatedAt: now,
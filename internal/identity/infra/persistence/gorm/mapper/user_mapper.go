@@ -24,6 +24,7 @@ func (u *userMapper) ToModel(entity entity.UserEntity) (model.UserModel, error)
 		entity.Email,
 		entity.PasswordHash,
 		entity.IsActivated,
+		entity.Role,
 		entity.ConfirmationToken,
 		entity.ConfirmationExpiresAt,
 		entity.ConfirmedAt,
@@ -45,6 +46,7 @@ func (u *userMapper) ToEntity(model model.UserModel) entity.UserEntity {
 		Email:                  model.Email(),
 		PasswordHash:           model.PasswordHash(),
 		IsActivated:            model.IsActivated(),
+		Role:                   model.Role(),
 		ConfirmationToken:      model.ConfirmationToken(),
 		ConfirmationExpiresAt:  model.ConfirmationExpiresAt(),
 		ConfirmedAt:            model.ConfirmedAt(),
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/persistence/gorm/entity"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/persistence/gorm/mapper"
@@ -29,6 +30,7 @@ func TestUserMapper_ToModel(t *testing.T) {
 		Email:                  "john@example.com",
 		PasswordHash:           "$2a$10$abcdefghijklmnopqrstuvwxyz123456789",
 		IsActivated:            true,
+		Role:                   enum.EnumRoleAdmin,
 		ConfirmationToken:      &confirmToken,
 		ConfirmationExpiresAt:  &confirmExpiry,
 		ConfirmedAt:            &confirmedAt,
@@ -51,6 +53,7 @@ func TestUserMapper_ToModel(t *testing.T) {
 	assert.Equal(t, "john@example.com", userModel.Email())
 	assert.Equal(t, "$2a$10$abcdefghijklmnopqrstuvwxyz123456789", userModel.PasswordHash())
 	assert.True(t, userModel.IsActivated())
+	assert.Equal(t, enum.EnumRoleAdmin, userModel.Role())
 
 	require.NotNil(t, userModel.ConfirmationToken())
 	assert.Equal(t, confirmToken, *userModel.ConfirmationToken())
@@ -87,6 +90,7 @@ func TestUserMapper_ToEntity(t *testing.T) {
 		"john@example.com",
 		"$2a$10$abcdefghijklmnopqrstuvwxyz123456789",
 		true,
+		enum.EnumRoleAdmin,
 		lo.ToPtr(confirmToken),
 		lo.ToPtr(confirmExpiry),
 		lo.ToPtr(confirmedAt),
@@ -109,6 +113,7 @@ func TestUserMapper_ToEntity(t *testing.T) {
 	assert.Equal(t, "john@example.com", userEntity.Email)
 	assert.Equal(t, "$2a$10$abcdefghijklmnopqrstuvwxyz123456789", userEntity.PasswordHash)
 	assert.True(t, userEntity.IsActivated)
+	assert.Equal(t, enum.EnumRoleAdmin, userEntity.Role)
 
 	require.NotNil(t, userEntity.ConfirmationToken)
 	assert.Equal(t, confirmToken, *userEntity.ConfirmationToken)
@@ -140,6 +145,7 @@ func TestUserMapper_ToModel_WithNilPointers(t *testing.T) {
 		Email:                  "john@example.com",
 		PasswordHash:           "$2a$10$abcdefghijklmnopqrstuvwxyz123456789",
 		IsActivated:            true,
+		Role:                   enum.EnumRoleAdmin,
 		ConfirmationToken:      nil,
 		ConfirmationExpiresAt:  nil,
 		ConfirmedAt:            nil,
@@ -162,6 +168,7 @@ func TestUserMapper_ToModel_WithNilPointers(t *testing.T) {
 	assert.Equal(t, "john@example.com", userModel.Email())
 	assert.Equal(t, "$2a$10$abcdefghijklmnopqrstuvwxyz123456789", userModel.PasswordHash())
 	assert.True(t, userModel.IsActivated())
+	assert.Equal(t, enum.EnumRoleAdmin, userModel.Role())
 
 	assert.Nil(t, userModel.ConfirmationToken())
 	assert.Nil(t, userModel.ConfirmationExpiresAt())
@@ -184,6 +191,7 @@ func TestUserMapper_ToEntity_WithNilPointers(t *testing.T) {
 		"john@example.com",
 		"$2a$10$abcdefghijklmnopqrstuvwxyz123456789",
 		true,
+		enum.EnumRoleAdmin,
 		nil,
 		nil,
 		nil,
@@ -206,6 +214,7 @@ func TestUserMapper_ToEntity_WithNilPointers(t *testing.T) {
 	assert.Equal(t, "john@example.com", userEntity.Email)
 	assert.Equal(t, "$2a$10$abcdefghijklmnopqrstuvwxyz123456789", userEntity.PasswordHash)
 	assert.True(t, userEntity.IsActivated)
+	assert.Equal(t, enum.EnumRoleAdmin, userEntity.Role)
 
 	assert.Nil(t, userEntity.ConfirmationToken)
 	assert.Nil(t, userEntity.ConfirmationExpiresAt)
@@ -228,6 +237,7 @@ func TestUserMapper_InvalidModel(t *testing.T) {
 		Email:                  "invalid-email", // Invalid email
 		PasswordHash:           "$2a$10$abcdefghijklmnopqrstuvwxyz123456789",
 		IsActivated:            true,
+		Role:                   enum.EnumRoleAdmin,
 		ConfirmationToken:      nil,
 		ConfirmationExpiresAt:  nil,
 		ConfirmedAt:            nil,
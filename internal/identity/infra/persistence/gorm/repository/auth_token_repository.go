@@ -2,6 +2,10 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
@@ -33,7 +37,7 @@ func (r *authTokenRepository) Create(
 	defer span.End()
 
 	authTokenEntity := r.mapper.ToEntity(authTokenModel)
-	result := r.db.WithContext(ctx).Create(&authTokenEntity)
+	result := r.db.FromContext(ctx).Create(&authTokenEntity)
 	if result.Error != nil {
 		return model.AuthTokenModel{}, result.Error
 	}
@@ -51,7 +55,7 @@ func (r *authTokenRepository) Update(ctx context.Context, authTokenModel model.A
 	defer span.End()
 
 	authTokenEntity := r.mapper.ToEntity(authTokenModel)
-	result := r.db.WithContext(ctx).Save(&authTokenEntity)
+	result := r.db.FromContext(ctx).Save(&authTokenEntity)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -63,7 +67,7 @@ func (r *authTokenRepository) Delete(ctx context.Context, id uint64) error {
 	ctx, span := otel.Trace().StartSpan(ctx, "AuthTokenRepository.Delete")
 	defer span.End()
 
-	result := r.db.WithContext(ctx).Delete(&entity.AuthTokenEntity{}, id)
+	result := r.db.FromContext(ctx).Delete(&entity.AuthTokenEntity{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -80,9 +84,12 @@ func (r *authTokenRepository) FindByToken(ctx context.Context, token string) (mo
 	defer span.End()
 
 	var authTokenEntity entity.AuthTokenEntity
-	result := r.db.WithContext(ctx).Where("token = ?", token).First(&authTokenEntity)
+	result := r.db.FromContext(ctx).Where("token = ?", token).First(&authTokenEntity)
 
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.AuthTokenModel{}, errs.ErrNotFound
+		}
 		return model.AuthTokenModel{}, result.Error
 	}
 
@@ -97,3 +104,57 @@ func (r *authTokenRepository) FindByToken(ctx context.Context, token string) (mo
 
 	return authTokenModel, nil
 }
+
+// Rotate marks the token as used. It only succeeds for a token that has not been rotated or revoked yet,
+// so two concurrent refreshes with the same token cannot both win.
+func (r *authTokenRepository) Rotate(ctx context.Context, id uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "AuthTokenRepository.Rotate")
+	defer span.End()
+
+	now := time.Now().UTC()
+	result := r.db.FromContext(ctx).
+		Model(&entity.AuthTokenEntity{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", id).
+		Updates(map[string]any{"rotated_at": now, "updated_at": now})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *authTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "AuthTokenRepository.RevokeFamily")
+	defer span.End()
+
+	now := time.Now().UTC()
+	result := r.db.FromContext(ctx).
+		Model(&entity.AuthTokenEntity{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Updates(map[string]any{"revoked_at": now, "updated_at": now})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *authTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "AuthTokenRepository.RevokeAllByUserID")
+	defer span.End()
+
+	now := time.Now().UTC()
+	result := r.db.FromContext(ctx).
+		Model(&entity.AuthTokenEntity{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]any{"revoked_at": now, "updated_at": now})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
@@ -30,7 +30,7 @@ func (r *userRepository) Create(ctx context.Context, userModel model.UserModel)
 	defer span.End()
 
 	userEntity := r.mapper.ToEntity(userModel)
-	result := r.db.WithContext(ctx).Create(&userEntity)
+	result := r.db.FromContext(ctx).Create(&userEntity)
 	if result.Error != nil {
 		return model.UserModel{}, result.Error
 	}
@@ -46,7 +46,7 @@ func (r *userRepository) Update(ctx context.Context, model model.UserModel) erro
 	defer span.End()
 
 	userEntity := r.mapper.ToEntity(model)
-	result := r.db.WithContext(ctx).Save(&userEntity)
+	result := r.db.FromContext(ctx).Save(&userEntity)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -58,7 +58,7 @@ func (r *userRepository) FindByID(ctx context.Context, id uint64) (model.UserMod
 	defer span.End()
 
 	var userEntity entity.UserEntity
-	r.db.WithContext(ctx).First(&userEntity, id)
+	r.db.FromContext(ctx).First(&userEntity, id)
 	if userEntity.ID == 0 {
 		return model.UserModel{}, errs.ErrNotFound
 	}
@@ -71,11 +71,30 @@ func (r *userRepository) FindByID(ctx context.Context, id uint64) (model.UserMod
 	return userModel, nil
 }
 
+func (r *userRepository) FindAll(ctx context.Context) ([]model.UserModel, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "UserRepository.FindAll")
+	defer span.End()
+	var userEntities []entity.UserEntity
+	result := r.db.FromContext(ctx).Order("id").Find(&userEntities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	userModels := make([]model.UserModel, 0, len(userEntities))
+	for _, userEntity := range userEntities {
+		userModel, err := r.mapper.ToModel(userEntity)
+		if err != nil {
+			return nil, err
+		}
+		userModels = append(userModels, userModel)
+	}
+	return userModels, nil
+}
+
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (model.UserModel, error) {
 	ctx, span := otel.Trace().StartSpan(ctx, "UserRepository.FindByEmail")
 	defer span.End()
 	var userEntity entity.UserEntity
-	r.db.WithContext(ctx).Where("email = ?", email).First(&userEntity)
+	r.db.FromContext(ctx).Where("email = ?", email).First(&userEntity)
 	if userEntity.ID == 0 {
 		return model.UserModel{}, errs.ErrNotFound
 	}
@@ -90,7 +109,7 @@ func (r *userRepository) FindByConfirmationToken(ctx context.Context, token stri
 	ctx, span := otel.Trace().StartSpan(ctx, "UserRepository.FindByConfirmationToken")
 	defer span.End()
 	var userEntity entity.UserEntity
-	r.db.WithContext(ctx).Where("confirmation_token = ?", token).First(&userEntity)
+	r.db.FromContext(ctx).Where("confirmation_token = ?", token).First(&userEntity)
 	if userEntity.ID == 0 {
 		return model.UserModel{}, errs.ErrNotFound
 	}
@@ -105,7 +124,7 @@ func (r *userRepository) FindByResetPasswordToken(ctx context.Context, token str
 	ctx, span := otel.Trace().StartSpan(ctx, "UserRepository.FindByResetPasswordToken")
 	defer span.End()
 	var userEntity entity.UserEntity
-	r.db.WithContext(ctx).Where("reset_password_token = ?", token).First(&userEntity)
+	r.db.FromContext(ctx).Where("reset_password_token = ?", token).First(&userEntity)
 	if userEntity.ID == 0 {
 		return model.UserModel{}, errs.ErrNotFound
 	}
@@ -120,7 +139,7 @@ func (r *userRepository) IsActivated(ctx context.Context, userID uint64) (bool,
 	ctx, span := otel.Trace().StartSpan(ctx, "UserRepository.IsActivated")
 	defer span.End()
 	var userEntity entity.UserEntity
-	r.db.WithContext(ctx).Where("id = ?", userID).First(&userEntity)
+	r.db.FromContext(ctx).Where("id = ?", userID).First(&userEntity)
 	if userEntity.ID == 0 {
 		return false, errs.ErrNotFound
 	}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const (
+	EmailConfirmationTopic  = "identity.email_confirmation"
+	ResetPasswordEmailTopic = "identity.reset_password_email"
+)
+
+// EmailMessage is the payload of the email topics.
+type EmailMessage struct {
+	UserID uint64 `json:"user_id"`
+}
+
+type EmailQueueService interface {
+	service.EmailQueueService
+}
+
+type emailQueueService struct {
+	publisher    queue.Publisher
+	outboxWriter outbox.Writer
+}
+
+func NewEmailQueueService(publisher queue.Publisher, outboxWriter outbox.Writer) EmailQueueService {
+	return &emailQueueService{publisher, outboxWriter}
+}
+
+func (s *emailQueueService) EnqueueEmailConfirmation(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "emailQueueService.EnqueueEmailConfirmation")
+	defer span.End()
+
+	return s.outboxWriter.Write(ctx, outbox.Message{
+		Topic:          EmailConfirmationTopic,
+		IdempotencyKey: EmailConfirmationTopic + ":" + strconv.FormatUint(userID, 10),
+		Payload:        EmailMessage{UserID: userID},
+	})
+}
+
+func (s *emailQueueService) EnqueueResetPasswordEmail(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "emailQueueService.EnqueueResetPasswordEmail")
+	defer span.End()
+
+	return s.publisher.Publish(ctx, ResetPasswordEmailTopic, EmailMessage{UserID: userID})
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const (
+	loginFailuresKeyPrefix = "identity:login_failures:"
+	loginLockKeyPrefix     = "identity:login_lock:"
+)
+
+type LoginAttemptService interface {
+	service.LoginAttemptService
+}
+
+type loginAttemptService struct {
+	redis  redis.Redis
+	conf   config.Config
+	policy model.LoginLockoutPolicyModel
+}
+
+func NewLoginAttemptService(redis redis.Redis, conf config.Config) (LoginAttemptService, error) {
+	policy, err := model.CreateLoginLockoutPolicyModel(
+		conf.LoginLockout.GetThreshold(),
+		conf.LoginLockout.GetBaseDuration(),
+		conf.LoginLockout.GetMaxDuration(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &loginAttemptService{redis, conf, policy}, nil
+}
+
+func (s *loginAttemptService) LockedFor(ctx context.Context, email string) (time.Duration, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "LoginAttemptService.LockedFor")
+	defer span.End()
+
+	ttl, err := s.redis.Client().PTTL(ctx, loginLockKeyPrefix+s.hash(email)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// A missing key has a negative ttl
+	return max(ttl, 0), nil
+}
+
+// RecordFailure counts the failure within the failure window, every failure extending it, and
+// locks the email out for as long as the policy says.
+func (s *loginAttemptService) RecordFailure(ctx context.Context, email string) (time.Duration, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "LoginAttemptService.RecordFailure")
+	defer span.End()
+
+	client := s.redis.Client()
+	hash := s.hash(email)
+	failuresKey := loginFailuresKeyPrefix + hash
+
+	pipe := client.TxPipeline()
+	incr := pipe.Incr(ctx, failuresKey)
+	pipe.PExpire(ctx, failuresKey, s.conf.LoginLockout.GetFailureWindow())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	lockDuration := s.policy.LockDuration(uint(max(incr.Val(), 0)))
+	if lockDuration == 0 {
+		return 0, nil
+	}
+
+	err := client.Set(ctx, loginLockKeyPrefix+hash, 1, lockDuration).Err()
+	if err != nil {
+		return 0, err
+	}
+
+	return lockDuration, nil
+}
+
+func (s *loginAttemptService) Reset(ctx context.Context, email string) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "LoginAttemptService.Reset")
+	defer span.End()
+
+	hash := s.hash(email)
+	return s.redis.Client().Del(ctx, loginFailuresKeyPrefix+hash, loginLockKeyPrefix+hash).Err()
+}
+
+// hash keeps the emails out of Redis, and counts them regardless of case.
+func (s *loginAttemptService) hash(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
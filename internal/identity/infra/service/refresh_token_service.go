@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+const (
+	refreshTokenSize = 32
+	familyIDSize     = 16
+)
+
+type RefreshTokenService interface {
+	service.RefreshTokenService
+}
+
+type refreshTokenService struct {
+	conf config.Config
+}
+
+func NewRefreshTokenService(conf config.Config) RefreshTokenService {
+	return &refreshTokenService{conf}
+}
+
+func (s *refreshTokenService) Generate(
+	ctx context.Context,
+	userID uint64,
+	familyID string,
+) (string, model.AuthTokenModel, error) {
+	_, span := otel.Trace().StartSpan(ctx, "RefreshTokenService.Generate")
+	defer span.End()
+
+	tokenBytes, err := randomBytes(refreshTokenSize)
+	if err != nil {
+		return "", model.AuthTokenModel{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	if familyID == "" {
+		familyBytes, err := randomBytes(familyIDSize)
+		if err != nil {
+			return "", model.AuthTokenModel{}, err
+		}
+		familyID = hex.EncodeToString(familyBytes)
+	}
+
+	duration := time.Duration(s.conf.JWT.RefreshExpirationInSeconds) * time.Second
+	expiresAt := time.Now().UTC().Add(duration)
+
+	authToken, err := model.CreateAuthTokenModel(userID, s.Hash(token), familyID, expiresAt)
+	if err != nil {
+		return "", model.AuthTokenModel{}, err
+	}
+
+	return token, authToken, nil
+}
+
+func (s *refreshTokenService) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(size int) ([]byte, error) {
+	buffer := make([]byte, size)
+	_, err := rand.Read(buffer)
+	if err != nil {
+		return nil, err
+	}
+	return buffer, nil
+}
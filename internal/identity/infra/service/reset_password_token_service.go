@@ -0,0 +1,38 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+)
+
+const resetPasswordTokenSize = 32
+
+type ResetPasswordTokenService interface {
+	service.ResetPasswordTokenService
+}
+
+type resetPasswordTokenService struct{}
+
+func NewResetPasswordTokenService() ResetPasswordTokenService {
+	return &resetPasswordTokenService{}
+}
+
+// Generate returns the token sent to the user and its hash. Only the hash is stored, so the
+// token cannot be read back from the database to take over the account.
+func (s *resetPasswordTokenService) Generate() (string, string, error) {
+	tokenBytes, err := randomBytes(resetPasswordTokenSize)
+	if err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	return token, s.Hash(token), nil
+}
+
+func (s *resetPasswordTokenService) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
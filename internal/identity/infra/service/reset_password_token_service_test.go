@@ -0,0 +1,38 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/service"
+)
+
+func TestResetPasswordTokenService_Generate(t *testing.T) {
+	t.Run("token is stored as its hash", func(t *testing.T) {
+		// Arrange
+		resetPasswordTokenService := service.NewResetPasswordTokenService()
+
+		// Act
+		token, tokenHash, err := resetPasswordTokenService.Generate()
+
+		// Assert
+		require.NoError(t, err)
+		require.NotEqual(t, token, tokenHash)
+		require.Equal(t, resetPasswordTokenService.Hash(token), tokenHash)
+	})
+
+	t.Run("every token is different", func(t *testing.T) {
+		// Arrange
+		resetPasswordTokenService := service.NewResetPasswordTokenService()
+
+		// Act
+		token1, _, err1 := resetPasswordTokenService.Generate()
+		token2, _, err2 := resetPasswordTokenService.Generate()
+
+		// Assert
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		require.NotEqual(t, token1, token2)
+	})
+}
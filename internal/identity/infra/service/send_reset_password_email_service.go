@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/mailer"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+)
+
+const sendResetPasswordEmailTemplate = "reset_password.gohtml"
+const sendResetPasswordEmailSubject = "Reset Password"
+const resetPasswordTokenExpiryHours = 1
+
+type SendResetPasswordEmailService interface {
+	service.SendResetPasswordEmailService
+}
+
+type sendResetPasswordEmailService struct {
+	mailerTemplate            mailer.Template
+	mailer                    mailer.SMTPMailer
+	userRepository            repository.UserRepository
+	resetPasswordTokenService service.ResetPasswordTokenService
+	logger                    logger.Logger
+	cfg                       config.Config
+}
+
+func NewSendResetPasswordEmailService(
+	mailerTemplate mailer.Template,
+	smtpMailer mailer.SMTPMailer,
+	userRepository repository.UserRepository,
+	resetPasswordTokenService service.ResetPasswordTokenService,
+	logger logger.Logger,
+	cfg config.Config,
+) SendResetPasswordEmailService {
+	return &sendResetPasswordEmailService{
+		mailerTemplate,
+		smtpMailer,
+		userRepository,
+		resetPasswordTokenService,
+		logger,
+		cfg,
+	}
+}
+
+// Execute issues a reset password token and emails the link to the user. The token is issued
+// here rather than when it is requested, since only its hash is stored and this is the only
+// place it is needed in clear. A new token replaces the one of a previous request.
+func (s *sendResetPasswordEmailService) Execute(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "sendResetPasswordEmailService.Execute")
+	defer span.End()
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		message := "error finding user"
+		s.logger.Error(message, "error", err)
+		return err
+	}
+
+	token, tokenHash, err := s.resetPasswordTokenService.Generate()
+	if err != nil {
+		message := "error generating reset password token"
+		s.logger.Error(message, "error", err)
+		return err
+	}
+
+	resetPasswordExpiresAt := time.Now().UTC().Add(time.Hour * resetPasswordTokenExpiryHours)
+	err = user.SetResetPasswordDetails(tokenHash, resetPasswordExpiresAt)
+	if err != nil {
+		message := "error setting reset password details"
+		s.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	err = s.userRepository.Update(ctx, user)
+	if err != nil {
+		message := "error updating user"
+		s.logger.Error(message, "error", err, "userID", user.ID())
+		return err
+	}
+
+	// generate the reset password link
+	resetPasswordLink := fmt.Sprintf(
+		"%s/user/password/reset?token=%s",
+		s.cfg.App.BaseURL,
+		url.QueryEscape(token),
+	)
+
+	// compile the template
+	tplData := struct {
+		Name              string
+		ResetPasswordLink string
+	}{
+		Name:              user.Name(),
+		ResetPasswordLink: resetPasswordLink,
+	}
+
+	content, err := s.mailerTemplate.CompileTemplate(sendResetPasswordEmailTemplate, tplData)
+	if err != nil {
+		message := "error compiling template"
+		s.logger.Error(message, "error", err)
+		return err
+	}
+
+	md := mailer.MailData{
+		Sender:  s.cfg.MAIL.Sender,
+		ToName:  user.Name(),
+		ToEmail: user.Email(),
+		Subject: sendResetPasswordEmailSubject,
+		Content: content,
+	}
+
+	err = s.mailer.Send(ctx, md)
+	if err != nil {
+		message := "error sending email"
+		s.logger.Error(message, "error", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
+	repository_mocks "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/mailer"
+	mailer_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/mailer/mocks"
+)
+
+func TestSendResetPasswordEmailService_Execute(t *testing.T) {
+	t.Run("only the hash of the emailed token is stored", func(t *testing.T) {
+		// Arrange
+		resetPasswordTokenService := service.NewResetPasswordTokenService()
+		user, err := model.RestoreUserModel(
+			1, "John Doe", "john.doe@example.com", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy",
+			true, enum.EnumRoleUser,
+			nil, nil, nil, nil, nil,
+			time.Now().UTC(), time.Now().UTC(),
+		)
+		require.NoError(t, err)
+
+		var storedUser model.UserModel
+		userRepository := repository_mocks.NewMockUserRepository(t)
+		userRepository.EXPECT().FindByID(mock.Anything, uint64(1)).Return(user, nil).Once()
+		userRepository.EXPECT().Update(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, user model.UserModel) error {
+				storedUser = user
+				return nil
+			}).Once()
+
+		var resetPasswordLink string
+		mailerTemplate := mailer_mocks.NewMockMailerTemplate(t)
+		mailerTemplate.EXPECT().CompileTemplate("reset_password.gohtml", mock.Anything).
+			RunAndReturn(func(_ string, data any) (string, error) {
+				resetPasswordLink = fmt.Sprint(data)
+				return "content", nil
+			}).Once()
+
+		smtpMailer := mailer_mocks.NewMockSmtpMailer(t)
+		smtpMailer.EXPECT().Send(mock.Anything, mock.MatchedBy(func(md mailer.MailData) bool {
+			return md.ToEmail == "john.doe@example.com"
+		})).Return(nil).Once()
+
+		sendResetPasswordEmailService := service.NewSendResetPasswordEmailService(
+			mailerTemplate,
+			smtpMailer,
+			userRepository,
+			resetPasswordTokenService,
+			logger_mocks.NewMockLogger(t),
+			config.Config{App: config.App{BaseURL: "https://goflix.test"}},
+		)
+
+		// Act
+		err = sendResetPasswordEmailService.Execute(context.Background(), 1)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, storedUser.ResetPasswordToken())
+		require.NotNil(t, storedUser.ResetPasswordExpiresAt())
+		require.True(t, storedUser.ResetPasswordExpiresAt().After(time.Now().UTC()))
+
+		// The template data prints as {name link}
+		_, query, found := strings.Cut(strings.TrimSuffix(resetPasswordLink, "}"), "/user/password/reset?")
+		require.True(t, found)
+		values, err := url.ParseQuery(query)
+		require.NoError(t, err)
+		token := values.Get("token")
+		require.NotEmpty(t, token)
+		require.NotEqual(t, token, *storedUser.ResetPasswordToken())
+		require.Equal(t, resetPasswordTokenService.Hash(token), *storedUser.ResetPasswordToken())
+	})
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	redis_client "github.com/redis/go-redis/v9"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const (
+	revokedTokenKeyPrefix      = "identity:revoked_token:"
+	revokedUserTokensKeyPrefix = "identity:revoked_user_tokens:"
+)
+
+type TokenRevocationService interface {
+	service.TokenRevocationService
+}
+
+type tokenRevocationService struct {
+	redis redis.Redis
+	conf  config.Config
+}
+
+func NewTokenRevocationService(redis redis.Redis, conf config.Config) TokenRevocationService {
+	return &tokenRevocationService{redis, conf}
+}
+
+func (s *tokenRevocationService) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TokenRevocationService.RevokeToken")
+	defer span.End()
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	return s.redis.Client().Set(ctx, revokedTokenKeyPrefix+tokenID, 1, ttl).Err()
+}
+
+// RevokeUserTokens stores the revocation time for the user, in milliseconds. Tokens issued up to
+// it are rejected, and the entry lives as long as the longest-lived access token issued before it.
+func (s *tokenRevocationService) RevokeUserTokens(ctx context.Context, userID uint64) error {
+	ctx, span := otel.Trace().StartSpan(ctx, "TokenRevocationService.RevokeUserTokens")
+	defer span.End()
+
+	key := revokedUserTokensKeyPrefix + strconv.FormatUint(userID, 10)
+	revokedAt := time.Now().UnixMilli()
+	ttl := time.Duration(s.conf.JWT.ExpirationInSeconds) * time.Second
+
+	return s.redis.Client().Set(ctx, key, revokedAt, ttl).Err()
+}
+
+func (s *tokenRevocationService) IsRevoked(
+	ctx context.Context,
+	tokenID string,
+	userID uint64,
+	issuedAt time.Time,
+) (bool, error) {
+	ctx, span := otel.Trace().StartSpan(ctx, "TokenRevocationService.IsRevoked")
+	defer span.End()
+
+	client := s.redis.Client()
+
+	exists, err := client.Exists(ctx, revokedTokenKeyPrefix+tokenID).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if exists > 0 {
+		return true, nil
+	}
+
+	key := revokedUserTokensKeyPrefix + strconv.FormatUint(userID, 10)
+	revokedAt, err := client.Get(ctx, key).Int64()
+	if err != nil {
+		if errors.Is(err, redis_client.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Issue times are compared in milliseconds, so the login that follows a revocation is kept
+	// while every token issued up to it, in the same second included, is rejected
+	return issuedAt.UnixMilli() <= revokedAt, nil
+}
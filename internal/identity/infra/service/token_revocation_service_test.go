@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/service"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+func TestTokenRevocationService_RevokeToken(t *testing.T) {
+	t.Run("revoked token is rejected", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		err := revocationService.RevokeToken(ctx, "token-1", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-1", 1, time.Now())
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, isRevoked)
+	})
+
+	t.Run("other tokens of the user are kept", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		err := revocationService.RevokeToken(ctx, "token-1", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-2", 1, time.Now())
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, isRevoked)
+	})
+
+	t.Run("expired token is not stored", func(t *testing.T) {
+		// Arrange
+		server := miniredis.RunT(t)
+		revocationService := service.NewTokenRevocationService(redis.NewRedis(server.Addr(), "", 0), newJWTConfig())
+
+		// Act
+		err := revocationService.RevokeToken(context.Background(), "token-1", time.Now().Add(-time.Second))
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, server.Keys())
+	})
+}
+
+func TestTokenRevocationService_RevokeUserTokens(t *testing.T) {
+	t.Run("token issued before the revocation is rejected", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		issuedAt := time.Now().Add(-time.Minute)
+		err := revocationService.RevokeUserTokens(ctx, 1)
+		require.NoError(t, err)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-1", 1, issuedAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, isRevoked)
+	})
+
+	t.Run("token issued in the second of the revocation is rejected", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		// A token carrying only iat is read as issued at the start of its second
+		issuedAt := time.Now().Truncate(time.Second)
+		err := revocationService.RevokeUserTokens(ctx, 1)
+		require.NoError(t, err)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-1", 1, issuedAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, isRevoked)
+	})
+
+	t.Run("token issued after the revocation is kept", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		err := revocationService.RevokeUserTokens(ctx, 1)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Millisecond)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-1", 1, time.Now())
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, isRevoked)
+	})
+
+	t.Run("tokens of other users are kept", func(t *testing.T) {
+		// Arrange
+		revocationService := newTokenRevocationService(t)
+		ctx := context.Background()
+		issuedAt := time.Now().Add(-time.Minute)
+		err := revocationService.RevokeUserTokens(ctx, 1)
+		require.NoError(t, err)
+
+		// Act
+		isRevoked, err := revocationService.IsRevoked(ctx, "token-1", 2, issuedAt)
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, isRevoked)
+	})
+}
+
+func newTokenRevocationService(t *testing.T) service.TokenRevocationService {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	return service.NewTokenRevocationService(redis.NewRedis(server.Addr(), "", 0), newJWTConfig())
+}
+
+func newJWTConfig() config.Config {
+	return config.Config{JWT: config.JWT{ExpirationInSeconds: 3600}}
+}
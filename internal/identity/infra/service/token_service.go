@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/model"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	shared_jwt "github.com/cristiano-pacheco/goflix/internal/shared/modules/jwt"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/otel"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/registry"
@@ -40,12 +42,17 @@ func (s *tokenService) Generate(ctx context.Context, user model.UserModel) (stri
 	now := time.Now()
 	duration := time.Duration(s.conf.JWT.ExpirationInSeconds) * time.Second
 	expires := now.Add(duration)
-	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(expires),
-		IssuedAt:  jwt.NewNumericDate(now),
-		NotBefore: jwt.NewNumericDate(now),
-		Issuer:    s.conf.JWT.Issuer,
-		Subject:   strconv.FormatUint(user.ID(), 10),
+	claims := shared_jwt.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expires),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.conf.JWT.Issuer,
+			Subject:   strconv.FormatUint(user.ID(), 10),
+			ID:        uuid.NewString(),
+		},
+		Role:          user.Role(),
+		IssuedAtMilli: now.UnixMilli(),
 	}
 
 	signingMethod := "RS256"
@@ -7,6 +7,7 @@ import (
 	domain_repository "github.com/cristiano-pacheco/goflix/internal/identity/domain/repository"
 	domain_service "github.com/cristiano-pacheco/goflix/internal/identity/domain/service"
 	"github.com/cristiano-pacheco/goflix/internal/identity/domain/validator"
+	"github.com/cristiano-pacheco/goflix/internal/identity/infra/consumer"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/handler"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/middleware"
 	"github.com/cristiano-pacheco/goflix/internal/identity/infra/http/router"
@@ -25,6 +26,14 @@ var Module = fx.Module(
 		usecase.NewUserUpdateUseCase,
 		usecase.NewUserFindUseCase,
 		usecase.NewTokenGenerateUseCase,
+		usecase.NewTokenRefreshUseCase,
+		usecase.NewTokenRevokeUseCase,
+		usecase.NewAccessTokenRevokeUseCase,
+		usecase.NewUserTokensRevokeUseCase,
+		usecase.NewUserPasswordForgotUseCase,
+		usecase.NewUserPasswordResetUseCase,
+		usecase.NewUserListUseCase,
+		usecase.NewUserRoleUpdateUseCase,
 
 		// #################### DOMAIN #########################################
 		domain_service.NewHashService,
@@ -36,9 +45,11 @@ var Module = fx.Module(
 		// handlers
 		handler.NewAuthHandler,
 		handler.NewUserHandler,
+		handler.NewAdminUserHandler,
 
 		// middlewares
 		middleware.NewAuthMiddleware,
+		middleware.NewRoleMiddleware,
 
 		// mappers
 		mapper.NewUserMapper,
@@ -61,13 +72,47 @@ var Module = fx.Module(
 			fx.As(new(domain_service.SendEmailConfirmationService)),
 		),
 
+		fx.Annotate(
+			service.NewSendResetPasswordEmailService,
+			fx.As(new(domain_service.SendResetPasswordEmailService)),
+		),
+
+		fx.Annotate(
+			service.NewEmailQueueService,
+			fx.As(new(domain_service.EmailQueueService)),
+		),
+
 		fx.Annotate(
 			service.NewTokenService,
 			fx.As(new(domain_service.TokenService)),
 		),
+
+		fx.Annotate(
+			service.NewRefreshTokenService,
+			fx.As(new(domain_service.RefreshTokenService)),
+		),
+
+		fx.Annotate(
+			service.NewResetPasswordTokenService,
+			fx.As(new(domain_service.ResetPasswordTokenService)),
+		),
+
+		fx.Annotate(
+			service.NewTokenRevocationService,
+			fx.As(new(domain_service.TokenRevocationService)),
+		),
+
+		fx.Annotate(
+			service.NewLoginAttemptService,
+			fx.As(new(domain_service.LoginAttemptService)),
+		),
+
+		// consumers
+		consumer.NewSendEmailConsumer,
 	),
 	fx.Invoke(
 		router.SetupUserRoutes,
 		router.SetupAuthRoutes,
+		router.SetupAdminUserRoutes,
 	),
 )
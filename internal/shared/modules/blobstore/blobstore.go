@@ -0,0 +1,33 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/pkg/blobstore"
+)
+
+const defaultLocalPath = "storage"
+
+func NewBlobStore(cfg config.Config) (blobstore.BlobStore, error) {
+	switch cfg.BlobStore.Driver {
+	case config.BlobStoreDriverLocal, "":
+		localPath := cfg.BlobStore.LocalPath
+		if localPath == "" {
+			localPath = defaultLocalPath
+		}
+		return blobstore.NewLocalBlobStore(localPath)
+	case config.BlobStoreDriverS3:
+		return blobstore.NewS3BlobStore(context.Background(), blobstore.S3Config{
+			Endpoint:  cfg.BlobStore.S3Endpoint,
+			AccessKey: cfg.BlobStore.S3AccessKey,
+			SecretKey: cfg.BlobStore.S3SecretKey,
+			Bucket:    cfg.BlobStore.S3Bucket,
+			Region:    cfg.BlobStore.S3Region,
+			UseSSL:    cfg.BlobStore.S3UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob store driver %q", cfg.BlobStore.Driver)
+	}
+}
@@ -0,0 +1,5 @@
+package blobstore
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("blobstore", fx.Provide(NewBlobStore))
@@ -0,0 +1,9 @@
+package config
+
+type Billing struct {
+	RenewalIntervalInSeconds      int64 `mapstructure:"BILLING_RENEWAL_INTERVAL_IN_SECONDS"`
+	RenewalLeadTimeInSeconds      int64 `mapstructure:"BILLING_RENEWAL_LEAD_TIME_IN_SECONDS"`
+	RetryIntervalInSeconds        int64 `mapstructure:"BILLING_RETRY_INTERVAL_IN_SECONDS"`
+	GracePeriodInSeconds          int64 `mapstructure:"BILLING_GRACE_PERIOD_IN_SECONDS"`
+	SubscriptionCacheTTLInSeconds int64 `mapstructure:"BILLING_SUBSCRIPTION_CACHE_TTL_IN_SECONDS"`
+}
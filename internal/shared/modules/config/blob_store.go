@@ -0,0 +1,17 @@
+package config
+
+const (
+	BlobStoreDriverLocal = "local"
+	BlobStoreDriverS3    = "s3"
+)
+
+type BlobStore struct {
+	Driver      string `mapstructure:"BLOB_STORE_DRIVER"`
+	LocalPath   string `mapstructure:"BLOB_STORE_LOCAL_PATH"`
+	S3Endpoint  string `mapstructure:"BLOB_STORE_S3_ENDPOINT"`
+	S3AccessKey string `mapstructure:"BLOB_STORE_S3_ACCESS_KEY"`
+	S3SecretKey string `mapstructure:"BLOB_STORE_S3_SECRET_KEY"`
+	S3Bucket    string `mapstructure:"BLOB_STORE_S3_BUCKET"`
+	S3Region    string `mapstructure:"BLOB_STORE_S3_REGION"`
+	S3UseSSL    bool   `mapstructure:"BLOB_STORE_S3_USE_SSL"`
+}
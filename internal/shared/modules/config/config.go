@@ -8,17 +8,27 @@ import (
 )
 
 type Config struct {
-	Environment string    `mapstructure:"ENVIRONMENT"`
-	HTTPPort    uint      `mapstructure:"HTTP_PORT"`
-	CORS        CORS      `mapstructure:",squash"`
-	JWT         JWT       `mapstructure:",squash"`
-	DB          DB        `mapstructure:",squash"`
-	MAIL        MAIL      `mapstructure:",squash"`
-	Telemetry   Telemetry `mapstructure:",squash"`
-	App         App       `mapstructure:",squash"`
-	Log         Log       `mapstructure:",squash"`
-	RabbitMQ    RabbitMQ  `mapstructure:",squash"`
-	Redis       Redis     `mapstructure:",squash"`
+	Environment  string       `mapstructure:"ENVIRONMENT"`
+	HTTPPort     uint         `mapstructure:"HTTP_PORT"`
+	CORS         CORS         `mapstructure:",squash"`
+	JWT          JWT          `mapstructure:",squash"`
+	DB           DB           `mapstructure:",squash"`
+	MAIL         MAIL         `mapstructure:",squash"`
+	Telemetry    Telemetry    `mapstructure:",squash"`
+	App          App          `mapstructure:",squash"`
+	Log          Log          `mapstructure:",squash"`
+	RabbitMQ     RabbitMQ     `mapstructure:",squash"`
+	Redis        Redis        `mapstructure:",squash"`
+	Scheduler    Scheduler    `mapstructure:",squash"`
+	Billing      Billing      `mapstructure:",squash"`
+	Playback     Playback     `mapstructure:",squash"`
+	BlobStore    BlobStore    `mapstructure:",squash"`
+	Transcoder   Transcoder   `mapstructure:",squash"`
+	Queue        Queue        `mapstructure:",squash"`
+	Outbox       Outbox       `mapstructure:",squash"`
+	Idempotency  Idempotency  `mapstructure:",squash"`
+	RateLimit    RateLimit    `mapstructure:",squash"`
+	LoginLockout LoginLockout `mapstructure:",squash"`
 }
 
 const EnvProduction = "production"
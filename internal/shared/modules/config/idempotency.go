@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+type Idempotency struct {
+	TTLInSeconds         int64 `mapstructure:"IDEMPOTENCY_TTL_IN_SECONDS"`
+	LockTimeoutInSeconds int64 `mapstructure:"IDEMPOTENCY_LOCK_TIMEOUT_IN_SECONDS"`
+}
+
+const (
+	defaultIdempotencyTTL         = 24 * time.Hour
+	defaultIdempotencyLockTimeout = time.Minute
+)
+
+func (i *Idempotency) GetTTL() time.Duration {
+	if i.TTLInSeconds <= 0 {
+		return defaultIdempotencyTTL
+	}
+	return time.Duration(i.TTLInSeconds) * time.Second
+}
+
+func (i *Idempotency) GetLockTimeout() time.Duration {
+	if i.LockTimeoutInSeconds <= 0 {
+		return defaultIdempotencyLockTimeout
+	}
+	return time.Duration(i.LockTimeoutInSeconds) * time.Second
+}
@@ -0,0 +1,46 @@
+package config
+
+import "time"
+
+type LoginLockout struct {
+	Threshold              uint  `mapstructure:"LOGIN_LOCKOUT_THRESHOLD"`
+	BaseDurationInSeconds  int64 `mapstructure:"LOGIN_LOCKOUT_BASE_DURATION_IN_SECONDS"`
+	MaxDurationInSeconds   int64 `mapstructure:"LOGIN_LOCKOUT_MAX_DURATION_IN_SECONDS"`
+	FailureWindowInSeconds int64 `mapstructure:"LOGIN_LOCKOUT_FAILURE_WINDOW_IN_SECONDS"`
+}
+
+const (
+	defaultLoginLockoutThreshold     = 5
+	defaultLoginLockoutBaseDuration  = time.Minute
+	defaultLoginLockoutMaxDuration   = time.Hour
+	defaultLoginLockoutFailureWindow = time.Hour
+)
+
+func (l *LoginLockout) GetThreshold() uint {
+	if l.Threshold == 0 {
+		return defaultLoginLockoutThreshold
+	}
+	return l.Threshold
+}
+
+func (l *LoginLockout) GetBaseDuration() time.Duration {
+	if l.BaseDurationInSeconds <= 0 {
+		return defaultLoginLockoutBaseDuration
+	}
+	return time.Duration(l.BaseDurationInSeconds) * time.Second
+}
+
+func (l *LoginLockout) GetMaxDuration() time.Duration {
+	if l.MaxDurationInSeconds <= 0 {
+		return defaultLoginLockoutMaxDuration
+	}
+	return time.Duration(l.MaxDurationInSeconds) * time.Second
+}
+
+// GetFailureWindow returns how long a failed login is counted, every new failure extends it.
+func (l *LoginLockout) GetFailureWindow() time.Duration {
+	if l.FailureWindowInSeconds <= 0 {
+		return defaultLoginLockoutFailureWindow
+	}
+	return time.Duration(l.FailureWindowInSeconds) * time.Second
+}
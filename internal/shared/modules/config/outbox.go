@@ -0,0 +1,7 @@
+package config
+
+type Outbox struct {
+	RelayIntervalInMilliseconds int64 `mapstructure:"OUTBOX_RELAY_INTERVAL_IN_MILLISECONDS"`
+	BatchSize                   int   `mapstructure:"OUTBOX_BATCH_SIZE"`
+	RetentionInSeconds          int64 `mapstructure:"OUTBOX_RETENTION_IN_SECONDS"`
+}
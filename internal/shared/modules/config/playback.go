@@ -0,0 +1,34 @@
+package config
+
+import "time"
+
+const (
+	PlaybackURLSigningHMAC = "HS256"
+	PlaybackURLSigningRSA  = "RS256"
+)
+
+type Playback struct {
+	SessionTTLInSeconds int64  `mapstructure:"PLAYBACK_SESSION_TTL_IN_SECONDS"`
+	URLSigningAlgorithm string `mapstructure:"PLAYBACK_URL_SIGNING_ALGORITHM"`
+	URLTTLInSeconds     int64  `mapstructure:"PLAYBACK_URL_TTL_IN_SECONDS"`
+	URLBindClientIP     bool   `mapstructure:"PLAYBACK_URL_BIND_CLIENT_IP"`
+}
+
+const (
+	defaultPlaybackSessionTTL = 90 * time.Second
+	defaultPlaybackURLTTL     = 4 * time.Hour
+)
+
+func (p *Playback) GetSessionTTL() time.Duration {
+	if p.SessionTTLInSeconds <= 0 {
+		return defaultPlaybackSessionTTL
+	}
+	return time.Duration(p.SessionTTLInSeconds) * time.Second
+}
+
+func (p *Playback) GetURLTTL() time.Duration {
+	if p.URLTTLInSeconds <= 0 {
+		return defaultPlaybackURLTTL
+	}
+	return time.Duration(p.URLTTLInSeconds) * time.Second
+}
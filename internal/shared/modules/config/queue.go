@@ -0,0 +1,16 @@
+package config
+
+const (
+	QueueDriverRabbitMQ = "rabbitmq"
+	QueueDriverMemory   = "memory"
+)
+
+type Queue struct {
+	Driver                  string `mapstructure:"QUEUE_DRIVER"`
+	Exchange                string `mapstructure:"QUEUE_EXCHANGE"`
+	Prefetch                int    `mapstructure:"QUEUE_PREFETCH"`
+	MaxRetries              int    `mapstructure:"QUEUE_MAX_RETRIES"`
+	RetryBaseDelayInSeconds int64  `mapstructure:"QUEUE_RETRY_BASE_DELAY_IN_SECONDS"`
+	RetryMaxDelayInSeconds  int64  `mapstructure:"QUEUE_RETRY_MAX_DELAY_IN_SECONDS"`
+	MemoryBufferSize        int    `mapstructure:"QUEUE_MEMORY_BUFFER_SIZE"`
+}
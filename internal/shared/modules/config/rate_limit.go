@@ -0,0 +1,119 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RateLimitAlgorithmSlidingWindow = "sliding_window"
+	RateLimitAlgorithmTokenBucket   = "token_bucket"
+)
+
+// The keys a rate limit rule counts the requests by.
+const (
+	RateLimitKeyIP    = "ip"
+	RateLimitKeyEmail = "email"
+	RateLimitKeyUser  = "user"
+)
+
+// The policies applied to the routes. A policy missing from RATE_LIMIT_POLICIES does not limit
+// its route.
+const (
+	RateLimitPolicyAuthToken           = "auth_token"
+	RateLimitPolicyAuthRefresh         = "auth_refresh"
+	RateLimitPolicyUsersCreate         = "users_create"
+	RateLimitPolicyPasswordForgot      = "password_forgot"
+	RateLimitPolicyPasswordReset       = "password_reset"
+	RateLimitPolicySubscriptionsCreate = "subscriptions_create"
+)
+
+const defaultRateLimitPolicies = "auth_token=ip:20/1m,email:10/15m;" +
+	"auth_refresh=ip:60/1m;" +
+	"users_create=ip:10/1h;" +
+	"password_forgot=ip:10/1h,email:3/1h;" +
+	"password_reset=ip:10/1h;" +
+	"subscriptions_create=user:10/1h"
+
+var ErrInvalidRateLimitPolicies = errors.New("invalid RATE_LIMIT_POLICIES")
+
+type RateLimit struct {
+	Enabled   bool   `mapstructure:"RATE_LIMIT_ENABLED"`
+	Algorithm string `mapstructure:"RATE_LIMIT_ALGORITHM"`
+	// Policies is a semicolon-separated list of name=rules, where rules is a comma-separated
+	// list of key:requests/period, e.g. auth_token=ip:20/1m,email:10/15m
+	Policies string `mapstructure:"RATE_LIMIT_POLICIES"`
+}
+
+// RateLimitRule allows Requests per Period for each value of Key.
+type RateLimitRule struct {
+	Key      string
+	Requests int
+	Period   time.Duration
+}
+
+func (r *RateLimit) GetAlgorithm() string {
+	if r.Algorithm == "" {
+		return RateLimitAlgorithmSlidingWindow
+	}
+	return r.Algorithm
+}
+
+// GetPolicies returns the rules of every policy by its name.
+func (r *RateLimit) GetPolicies() (map[string][]RateLimitRule, error) {
+	policies := r.Policies
+	if policies == "" {
+		policies = defaultRateLimitPolicies
+	}
+
+	result := make(map[string][]RateLimitRule)
+	for policy := range strings.SplitSeq(policies, ";") {
+		policy = strings.TrimSpace(policy)
+		if policy == "" {
+			continue
+		}
+
+		name, rules, ok := strings.Cut(policy, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: policy %q has no name", ErrInvalidRateLimitPolicies, policy)
+		}
+
+		for rule := range strings.SplitSeq(rules, ",") {
+			parsed, err := parseRateLimitRule(strings.TrimSpace(rule))
+			if err != nil {
+				return nil, fmt.Errorf("%w: policy %s: %w", ErrInvalidRateLimitPolicies, name, err)
+			}
+			result[name] = append(result[name], parsed)
+		}
+	}
+
+	return result, nil
+}
+
+func parseRateLimitRule(rule string) (RateLimitRule, error) {
+	key, limit, ok := strings.Cut(rule, ":")
+	if !ok {
+		return RateLimitRule{}, fmt.Errorf("rule %q is not key:requests/period", rule)
+	}
+
+	requests, period, ok := strings.Cut(limit, "/")
+	if !ok {
+		return RateLimitRule{}, fmt.Errorf("rule %q is not key:requests/period", rule)
+	}
+
+	count, err := strconv.Atoi(requests)
+	if err != nil || count <= 0 {
+		return RateLimitRule{}, fmt.Errorf("rule %q must allow a positive number of requests", rule)
+	}
+
+	duration, err := time.ParseDuration(period)
+	if err != nil || duration < time.Second {
+		return RateLimitRule{}, fmt.Errorf("rule %q must have a period of at least 1s", rule)
+	}
+
+	return RateLimitRule{Key: key, Requests: count, Period: duration}, nil
+}
@@ -0,0 +1,5 @@
+package config
+
+type Scheduler struct {
+	IsEnabled bool `mapstructure:"SCHEDULER_ENABLED"`
+}
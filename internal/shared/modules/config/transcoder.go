@@ -0,0 +1,38 @@
+package config
+
+import "time"
+
+const (
+	TranscoderDriverFFmpeg = "ffmpeg"
+	TranscoderDriverFake   = "fake"
+)
+
+type Transcoder struct {
+	Driver                   string `mapstructure:"TRANSCODER_DRIVER"`
+	FFmpegPath               string `mapstructure:"TRANSCODER_FFMPEG_PATH"`
+	FFprobePath              string `mapstructure:"TRANSCODER_FFPROBE_PATH"`
+	WorkDir                  string `mapstructure:"TRANSCODER_WORK_DIR"`
+	SegmentDurationInSeconds int64  `mapstructure:"TRANSCODER_SEGMENT_DURATION_IN_SECONDS"`
+	SweepIntervalInSeconds   int64  `mapstructure:"TRANSCODER_SWEEP_INTERVAL_IN_SECONDS"`
+	JobTimeoutInSeconds      int64  `mapstructure:"TRANSCODER_JOB_TIMEOUT_IN_SECONDS"`
+	MaxAttempts              uint   `mapstructure:"TRANSCODER_MAX_ATTEMPTS"`
+}
+
+const (
+	defaultTranscoderJobTimeout  = 2 * time.Hour
+	defaultTranscoderMaxAttempts = 3
+)
+
+func (t *Transcoder) GetJobTimeout() time.Duration {
+	if t.JobTimeoutInSeconds <= 0 {
+		return defaultTranscoderJobTimeout
+	}
+	return time.Duration(t.JobTimeoutInSeconds) * time.Second
+}
+
+func (t *Transcoder) GetMaxAttempts() uint {
+	if t.MaxAttempts == 0 {
+		return defaultTranscoderMaxAttempts
+	}
+	return t.MaxAttempts
+}
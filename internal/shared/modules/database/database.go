@@ -1,7 +1,10 @@
 package database
 
 import (
+	"context"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/goflix/pkg/database"
@@ -33,3 +36,20 @@ func New(cfg config.Config) *GoflixDB {
 func NewFromGorm(db *gorm.DB) *GoflixDB {
 	return &GoflixDB{db}
 }
+
+// FromContext returns the transaction carried by ctx, or the connection pool when there is
+// none. The returned session can run several queries.
+func (db *GoflixDB) FromContext(ctx context.Context) *gorm.DB {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return db.DB.WithContext(ctx)
+	}
+
+	tx := state.tx.WithContext(ctx)
+	if lock, hasLock := ctx.Value(rowLockKey{}).(RowLock); hasLock {
+		tx = tx.Clauses(clause.Locking{Strength: string(lock.Strength), Options: string(lock.Wait)}).
+			Session(&gorm.Session{})
+	}
+
+	return tx
+}
@@ -2,4 +2,4 @@ package database
 
 import "go.uber.org/fx"
 
-var Module = fx.Module("kernel/database", fx.Provide(New))
+var Module = fx.Module("kernel/database", fx.Provide(New, NewTxManager))
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/cristiano-pacheco/goflix/pkg/database"
+)
+
+const defaultTxAttempts = 3
+
+// LockStrength is the row lock taken by the queries of a transaction, see WithRowLock.
+type LockStrength string
+
+const (
+	LockForUpdate      LockStrength = clause.LockingStrengthUpdate
+	LockForNoKeyUpdate LockStrength = "NO KEY UPDATE"
+	LockForShare       LockStrength = clause.LockingStrengthShare
+	LockForKeyShare    LockStrength = "KEY SHARE"
+)
+
+// LockWait is what a query does with the rows already locked by another transaction. The
+// zero value waits for them.
+type LockWait string
+
+const (
+	LockWaitDefault LockWait = ""
+	LockSkipLocked  LockWait = clause.LockingOptionsSkipLocked
+	LockNoWait      LockWait = clause.LockingOptionsNoWait
+)
+
+type RowLock struct {
+	Strength LockStrength
+	Wait     LockWait
+}
+
+type txKey struct{}
+
+// txState is the transaction carried by the context of a unit of work.
+type txState struct {
+	tx          *gorm.DB
+	afterCommit []func(ctx context.Context) error
+}
+
+type rowLockKey struct{}
+
+type txOptions struct {
+	sql.TxOptions
+
+	attempts int
+}
+
+type TxOption func(*txOptions)
+
+// WithIsolation sets the isolation level of the transaction, Postgres defaults to read committed.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *txOptions) {
+		o.Isolation = level
+	}
+}
+
+func WithReadOnly() TxOption {
+	return func(o *txOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// WithAttempts sets how many times the transaction runs when it aborts on a serialization
+// failure or a deadlock. It defaults to 3, 1 disables the retries.
+func WithAttempts(attempts int) TxOption {
+	return func(o *txOptions) {
+		o.attempts = max(attempts, 1)
+	}
+}
+
+// TxManager runs a unit of work in a single transaction. The transaction travels in the
+// context, and the repositories use it whenever the context they get carries one.
+type TxManager interface {
+	// Transaction commits when fn returns nil and rolls back otherwise. A call made within
+	// another transaction joins it, its options are ignored. fn runs again when the
+	// transaction aborts on a serialization failure or a deadlock, so it must not have side
+	// effects outside the database.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error
+}
+
+type txManager struct {
+	db *GoflixDB
+}
+
+func NewTxManager(db *GoflixDB) TxManager {
+	return &txManager{db}
+}
+
+func (m *txManager) Transaction(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	options := txOptions{attempts: defaultTxAttempts}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var err error
+	for range options.attempts {
+		// The hooks of an attempt that rolled back are dropped with it
+		state := &txState{}
+		err = m.db.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			state.tx = tx
+			return fn(context.WithValue(ctx, txKey{}, state))
+		}, &options.TxOptions)
+		if err == nil {
+			return runAfterCommit(context.WithoutCancel(ctx), state.afterCommit)
+		}
+		if !database.IsRetryable(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// AfterCommit runs fn once the transaction carried by ctx commits, and not at all when it rolls
+// back. Outside a transaction fn runs right away. Side effects outside the database, like
+// invalidating a cache, must not be seen before the data they depend on is committed. The
+// transaction is committed even when fn fails, its error is returned by Transaction.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context) error) error {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return fn(ctx)
+	}
+
+	state.afterCommit = append(state.afterCommit, fn)
+	return nil
+}
+
+func runAfterCommit(ctx context.Context, hooks []func(ctx context.Context) error) error {
+	var errs []error
+	for _, hook := range hooks {
+		errs = append(errs, hook(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// WithRowLock makes the queries run with ctx lock the rows they select, until the transaction
+// ends. It has no effect outside a transaction.
+func WithRowLock(ctx context.Context, lock RowLock) context.Context {
+	return context.WithValue(ctx, rowLockKey{}, lock)
+}
@@ -0,0 +1,287 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/test/dbtest"
+)
+
+const updateQuery = `UPDATE "user" SET name = \$1`
+
+func TestTxManager_Transaction(t *testing.T) {
+	t.Run("commits when fn succeeds", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectCommit()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), sut.update)
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when fn fails", func(t *testing.T) {
+		// Arrange
+		errFn := errors.New("email already taken")
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectRollback()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, sut.update(ctx))
+			return errFn
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errFn)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("nested call joins the outer transaction", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectCommit()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			err := sut.update(ctx)
+			if err != nil {
+				return err
+			}
+			return sut.txManager.Transaction(ctx, sut.update, database.WithReadOnly())
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("failed nested call rolls back the outer transaction", func(t *testing.T) {
+		// Arrange
+		errFn := errors.New("email already taken")
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectRollback()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			err := sut.update(ctx)
+			if err != nil {
+				return err
+			}
+			return sut.txManager.Transaction(ctx, func(context.Context) error { return errFn })
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errFn)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("retries on a serialization failure", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnError(&pgconn.PgError{Code: "40001"})
+		sut.sqlMock.ExpectRollback()
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectCommit()
+		attempts := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return sut.update(ctx)
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("stops retrying after the last attempt", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnError(&pgconn.PgError{Code: "40P01"})
+		sut.sqlMock.ExpectRollback()
+		attempts := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return sut.update(ctx)
+		}, database.WithAttempts(1))
+
+		// Assert
+		var pgErr *pgconn.PgError
+		require.ErrorAs(t, err, &pgErr)
+		require.Equal(t, 1, attempts)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		// Arrange
+		errDB := errors.New("connection refused")
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnError(errDB)
+		sut.sqlMock.ExpectRollback()
+		attempts := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return sut.update(ctx)
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errDB)
+		require.Equal(t, 1, attempts)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestAfterCommit(t *testing.T) {
+	t.Run("runs once the transaction commits", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectCommit()
+		runs := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			err := database.AfterCommit(ctx, func(context.Context) error {
+				runs++
+				return nil
+			})
+			require.Zero(t, runs)
+			return err
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 1, runs)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("does not run when the transaction rolls back", func(t *testing.T) {
+		// Arrange
+		errFn := errors.New("email already taken")
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectRollback()
+		runs := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, database.AfterCommit(ctx, func(context.Context) error {
+				runs++
+				return nil
+			}))
+			return errFn
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errFn)
+		require.Zero(t, runs)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("hooks of a retried attempt are dropped", func(t *testing.T) {
+		// Arrange
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnError(&pgconn.PgError{Code: "40001"})
+		sut.sqlMock.ExpectRollback()
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		sut.sqlMock.ExpectCommit()
+		runs := 0
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			require.NoError(t, database.AfterCommit(ctx, func(context.Context) error {
+				runs++
+				return nil
+			}))
+			return sut.update(ctx)
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 1, runs)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("failed hook is returned after the commit", func(t *testing.T) {
+		// Arrange
+		errHook := errors.New("redis is down")
+		sut := newTxManagerSUT(t)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectCommit()
+
+		// Act
+		err := sut.txManager.Transaction(context.Background(), func(ctx context.Context) error {
+			return database.AfterCommit(ctx, func(context.Context) error { return errHook })
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errHook)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("runs right away outside a transaction", func(t *testing.T) {
+		// Arrange
+		runs := 0
+
+		// Act
+		err := database.AfterCommit(context.Background(), func(context.Context) error {
+			runs++
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 1, runs)
+	})
+}
+
+type txManagerSUT struct {
+	txManager database.TxManager
+	db        *database.GoflixDB
+	sqlMock   sqlmock.Sqlmock
+}
+
+func newTxManagerSUT(t *testing.T) *txManagerSUT {
+	t.Helper()
+
+	sqlDB, db, sqlMock := dbtest.NewDBMock(t)
+	t.Cleanup(func() { dbtest.CloseWithErrorCheck(sqlDB) })
+
+	return &txManagerSUT{txManager: database.NewTxManager(db), db: db, sqlMock: sqlMock}
+}
+
+// update runs a statement in the transaction carried by ctx, if any.
+func (s *txManagerSUT) update(ctx context.Context) error {
+	return s.db.FromContext(ctx).Exec(`UPDATE "user" SET name = ?`, "John Doe").Error
+}
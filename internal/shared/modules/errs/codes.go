@@ -14,11 +14,15 @@ const (
 	codeNotFound      = "NOT_FOUND"      // Resource doesn't exist
 	codeAlreadyExists = "ALREADY_EXISTS" // Duplicate resource
 	codeConflict      = "CONFLICT"       // Resource state conflict
+	codeInProgress    = "IN_PROGRESS"    // Same request is still being processed
 	codeGone          = "GONE"           // Resource no longer available
 
 	// Business Logic.
-	codeEmailInUse  = "EMAIL_IN_USE"
-	codeRateLimited = "RATE_LIMITED"
+	codeEmailInUse           = "EMAIL_IN_USE"
+	codeRateLimited          = "RATE_LIMITED"
+	codeAccountLocked        = "ACCOUNT_LOCKED"
+	codeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	codeSubscriptionRequired = "SUBSCRIPTION_REQUIRED"
 
 	// External Services.
 	codeExternalService = "EXTERNAL_SERVICE_ERROR"
@@ -13,6 +13,15 @@ var (
 
 	ErrNotFound     = errors.New("not found")
 	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+
+	ErrSubscriptionRequired = errors.New("an active subscription is required")
+
+	ErrIdempotencyKeyReused     = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("idempotency key is in progress")
+
+	ErrRateLimited   = errors.New("rate limited")
+	ErrAccountLocked = errors.New("account temporarily locked")
 
 	ErrKeyMustBePEMEncoded = errors.New("invalid key: Key must be a PEM encoded PKCS1 or PKCS8 key")
 	ErrNotRSAPrivateKey    = errors.New("key is not a valid RSA private key")
@@ -64,6 +64,27 @@ func (em *errorMapper) mapError(err error) error {
 		errors.Is(err, ErrInvalidAccountConfirmationToken):
 		status = http.StatusUnauthorized
 		code = codeUnauthorized
+	// Authorization
+	case errors.Is(err, ErrForbidden):
+		status = http.StatusForbidden
+		code = codeForbidden
+	case errors.Is(err, ErrSubscriptionRequired):
+		status = http.StatusForbidden
+		code = codeSubscriptionRequired
+	// Idempotency
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		status = http.StatusUnprocessableEntity
+		code = codeIdempotencyKeyReused
+	case errors.Is(err, ErrIdempotencyKeyInProgress):
+		status = http.StatusConflict
+		code = codeInProgress
+	// Rate limiting
+	case errors.Is(err, ErrRateLimited):
+		status = http.StatusTooManyRequests
+		code = codeRateLimited
+	case errors.Is(err, ErrAccountLocked):
+		status = http.StatusTooManyRequests
+		code = codeAccountLocked
 	// Bad Request
 	case errors.Is(err, ErrBadRequest):
 		status = http.StatusBadRequest
@@ -14,11 +14,15 @@ var mapping = map[string]string{
 	codeNotFound:      "Resource not found",
 	codeAlreadyExists: "Resource already exists",
 	codeConflict:      "Resource state conflict",
+	codeInProgress:    "A request with this idempotency key is still being processed",
 	codeGone:          "Resource is no longer available",
 
 	// Business Logic
-	codeEmailInUse:  "Email address is already in use",
-	codeRateLimited: "Too many requests, please try again later",
+	codeEmailInUse:           "Email address is already in use",
+	codeRateLimited:          "Too many requests, please try again later",
+	codeAccountLocked:        "Too many failed login attempts, please try again later",
+	codeIdempotencyKeyReused: "Idempotency key was already used with a different request",
+	codeSubscriptionRequired: "An active subscription is required",
 
 	// External Services
 	codeExternalService: "External service error",
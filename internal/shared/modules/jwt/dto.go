@@ -1,7 +1,23 @@
 package jwt
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
 
 type Claims struct {
 	jwt.RegisteredClaims
+	Role string `json:"role,omitempty"`
+	// IssuedAtMilli is the issue time in milliseconds, iat only has whole seconds. Revocations
+	// compare it, so a token issued right after a revocation is told apart from the ones before.
+	IssuedAtMilli int64 `json:"iat_ms,omitempty"`
+}
+
+// IssuedAtTime returns the most precise issue time the claims carry.
+func (c *Claims) IssuedAtTime() time.Time {
+	if c.IssuedAtMilli > 0 {
+		return time.UnixMilli(c.IssuedAtMilli)
+	}
+	return c.IssuedAt.Time
 }
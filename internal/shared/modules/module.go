@@ -3,6 +3,7 @@ package modules
 import (
 	"go.uber.org/fx"
 
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/blobstore"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
@@ -10,10 +11,15 @@ import (
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/jwt"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/mailer"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/queue"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/ratelimit"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/redis"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/registry"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/scheduler"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/translator"
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/validator"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/middleware"
 )
 
 var Module = fx.Module(
@@ -29,4 +35,10 @@ var Module = fx.Module(
 	mailer.Module,
 	errs.Module,
 	redis.Module,
+	scheduler.Module,
+	blobstore.Module,
+	queue.Module,
+	outbox.Module,
+	ratelimit.Module,
+	middleware.Module,
 )
@@ -0,0 +1,58 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+)
+
+// Deduplicator lets a consumer skip the idempotency keys it already handled, even though
+// messages are delivered at least once.
+type Deduplicator interface {
+	// Once runs fn unless consumer already handled key. The key is recorded once fn succeeds,
+	// so a message whose handler failed or crashed runs again when it is redelivered. Two
+	// deliveries of the same message running at the same time can both run fn: a rare
+	// duplicate is preferred to a message that is never handled.
+	Once(ctx context.Context, consumer, key string, fn func(ctx context.Context) error) error
+}
+
+type deduplicator struct {
+	db *database.GoflixDB
+}
+
+func NewDeduplicator(db *database.GoflixDB) Deduplicator {
+	return &deduplicator{db}
+}
+
+func (d *deduplicator) Once(ctx context.Context, consumer, key string, fn func(ctx context.Context) error) error {
+	var count int64
+	err := d.db.FromContext(ctx).
+		Model(&processedMessageEntity{}).
+		Where("consumer = ? AND idempotency_key = ?", consumer, key).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	err = fn(ctx)
+	if err != nil {
+		return err
+	}
+
+	processed := processedMessageEntity{
+		Consumer:       consumer,
+		IdempotencyKey: key,
+		ProcessedAt:    time.Now().UTC(),
+	}
+
+	// fn has run already, the key must be recorded even when the delivery is being cancelled
+	return d.db.FromContext(context.WithoutCancel(ctx)).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&processed).Error
+}
@@ -0,0 +1,92 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/test/dbtest"
+)
+
+const (
+	countProcessedQuery  = `SELECT count\(\*\) FROM "processed_message" WHERE consumer = \$1 AND idempotency_key = \$2`
+	insertProcessedQuery = `INSERT INTO "processed_message" .+ ON CONFLICT DO NOTHING`
+)
+
+func TestDeduplicator_Once(t *testing.T) {
+	t.Run("new key runs fn and is recorded", func(t *testing.T) {
+		// Arrange
+		deduplicator, sqlMock := newDeduplicator(t)
+		sqlMock.ExpectQuery(countProcessedQuery).
+			WithArgs("billing.invoice_paid", "key-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectExec(insertProcessedQuery).
+			WithArgs("billing.invoice_paid", "key-1", pastTime{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		sqlMock.ExpectCommit()
+		runs := 0
+
+		// Act
+		err := deduplicator.Once(context.Background(), "billing.invoice_paid", "key-1", func(context.Context) error {
+			runs++
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 1, runs)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("handled key skips fn", func(t *testing.T) {
+		// Arrange
+		deduplicator, sqlMock := newDeduplicator(t)
+		sqlMock.ExpectQuery(countProcessedQuery).
+			WithArgs("billing.invoice_paid", "key-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		runs := 0
+
+		// Act
+		err := deduplicator.Once(context.Background(), "billing.invoice_paid", "key-1", func(context.Context) error {
+			runs++
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Zero(t, runs)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("failed fn is not recorded", func(t *testing.T) {
+		// Arrange
+		errHandler := errors.New("mail server is down")
+		deduplicator, sqlMock := newDeduplicator(t)
+		sqlMock.ExpectQuery(countProcessedQuery).
+			WithArgs("billing.invoice_paid", "key-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		// Act
+		err := deduplicator.Once(context.Background(), "billing.invoice_paid", "key-1", func(context.Context) error {
+			return errHandler
+		})
+
+		// Assert
+		require.ErrorIs(t, err, errHandler)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newDeduplicator(t *testing.T) (outbox.Deduplicator, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, db, sqlMock := dbtest.NewDBMock(t)
+	t.Cleanup(func() { dbtest.CloseWithErrorCheck(sqlDB) })
+
+	return outbox.NewDeduplicator(db), sqlMock
+}
@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type messageEntity struct {
+	ID             uint64            `gorm:"primarykey;column:id"`
+	IdempotencyKey string            `gorm:"type:text;not null;column:idempotency_key"`
+	Topic          string            `gorm:"type:text;not null;column:topic"`
+	Payload        json.RawMessage   `gorm:"type:jsonb;serializer:json;not null;column:payload"`
+	Metadata       map[string]string `gorm:"type:jsonb;serializer:json;not null;column:metadata"`
+	Attempts       uint              `gorm:"type:int;not null;column:attempts"`
+	LastError      string            `gorm:"type:text;not null;column:last_error"`
+	AvailableAt    time.Time         `gorm:"type:timestamptz;not null;column:available_at"`
+	PublishedAt    *time.Time        `gorm:"type:timestamptz;column:published_at"`
+	CreatedAt      time.Time         `gorm:"type:timestamptz;default:now();column:created_at"`
+}
+
+func (*messageEntity) TableName() string {
+	return "outbox_message"
+}
+
+type processedMessageEntity struct {
+	Consumer       string    `gorm:"type:text;primarykey;column:consumer"`
+	IdempotencyKey string    `gorm:"type:text;primarykey;column:idempotency_key"`
+	ProcessedAt    time.Time `gorm:"type:timestamptz;not null;column:processed_at"`
+}
+
+func (*processedMessageEntity) TableName() string {
+	return "processed_message"
+}
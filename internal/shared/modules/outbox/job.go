@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/scheduler"
+)
+
+const defaultRelayInterval = time.Second
+
+type RelayJobResult struct {
+	fx.Out
+
+	Job scheduler.Job `group:"scheduler_jobs"`
+}
+
+// NewRelayJob runs the relay on the interval set by OUTBOX_RELAY_INTERVAL_IN_MILLISECONDS.
+func NewRelayJob(relay *Relay, conf config.Config, logger logger.Logger) RelayJobResult {
+	interval := time.Duration(conf.Outbox.RelayIntervalInMilliseconds) * time.Millisecond
+	if interval <= 0 {
+		interval = defaultRelayInterval
+	}
+
+	job := scheduler.Job{
+		Name:     "outbox:relay",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			output, err := relay.Run(ctx)
+			if output.Published > 0 || output.Failed > 0 {
+				logger.Debug("outbox messages relayed", "published", output.Published, "failed", output.Failed)
+			}
+			return err
+		},
+	}
+
+	return RelayJobResult{Job: job}
+}
@@ -0,0 +1,11 @@
+package outbox
+
+import "go.uber.org/fx"
+
+var Module = fx.Module(
+	"outbox",
+	fx.Provide(NewWriter),
+	fx.Provide(NewDeduplicator),
+	fx.Provide(NewRelay),
+	fx.Provide(NewRelayJob),
+)
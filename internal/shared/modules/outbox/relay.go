@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultRetention     = 7 * 24 * time.Hour
+	defaultClaimDuration = 5 * time.Minute
+)
+
+// Handler handles the messages of a topic in-process instead of publishing them to the queue.
+// Modules register their handlers by providing them in the "outbox_handlers" group.
+type Handler struct {
+	Topic  string
+	Handle func(ctx context.Context, delivery Delivery) error
+}
+
+// Delivery is a message handed over to an in-process handler.
+type Delivery struct {
+	IdempotencyKey string
+	Topic          string
+	Payload        json.RawMessage
+}
+
+// Decode unmarshals the payload into v.
+func (d Delivery) Decode(v any) error {
+	return json.Unmarshal(d.Payload, v)
+}
+
+type RelayParams struct {
+	fx.In
+
+	DB        *database.GoflixDB
+	Publisher queue.Publisher
+	Config    config.Config
+	Logger    logger.Logger
+	Handlers  []Handler `group:"outbox_handlers"`
+}
+
+type RelayOutput struct {
+	Published int
+	Failed    int
+}
+
+// Relay publishes the committed outbox messages, oldest first. Every message is published at
+// least once: it is marked as published only after the queue or its handler accepted it, and a
+// failed one is retried with backoff. Several instances can relay at the same time, a message
+// is claimed by a single one.
+type Relay struct {
+	db            *database.GoflixDB
+	publisher     queue.Publisher
+	handlers      map[string]Handler
+	batchSize     int
+	retention     time.Duration
+	claimDuration time.Duration
+	backoff       queue.RetryPolicy
+	logger        logger.Logger
+}
+
+func NewRelay(p RelayParams) *Relay {
+	handlers := make(map[string]Handler, len(p.Handlers))
+	for _, handler := range p.Handlers {
+		handlers[handler.Topic] = handler
+	}
+
+	batchSize := p.Config.Outbox.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	retention := time.Duration(p.Config.Outbox.RetentionInSeconds) * time.Second
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+
+	return &Relay{
+		db:            p.DB,
+		publisher:     p.Publisher,
+		handlers:      handlers,
+		batchSize:     batchSize,
+		retention:     retention,
+		claimDuration: defaultClaimDuration,
+		backoff:       queue.RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Minute},
+		logger:        p.Logger,
+	}
+}
+
+// Run publishes the messages due until none is left, then deletes the ones published before
+// the retention period.
+func (r *Relay) Run(ctx context.Context) (RelayOutput, error) {
+	output := RelayOutput{}
+	for ctx.Err() == nil {
+		batch, err := r.relayBatch(ctx)
+		output.Published += batch.Published
+		output.Failed += batch.Failed
+		if err != nil {
+			return output, err
+		}
+
+		if batch.Published+batch.Failed < r.batchSize {
+			break
+		}
+	}
+
+	return output, r.deleteExpired(ctx)
+}
+
+// relayBatch claims a batch of due messages, then dispatches them outside of any transaction:
+// a slow queue or handler holds no lock, and every message is marked on its own as soon as it
+// is dispatched, so a failure cannot undo the mark of a message already delivered.
+func (r *Relay) relayBatch(ctx context.Context) (RelayOutput, error) {
+	output := RelayOutput{}
+
+	entities, err := r.claimBatch(ctx)
+	if err != nil {
+		return output, err
+	}
+
+	for _, entity := range entities {
+		now := time.Now().UTC()
+
+		if err = r.dispatch(ctx, entity); err != nil {
+			entity.Attempts++
+			entity.LastError = truncateError(err)
+			entity.AvailableAt = now.Add(r.backoff.Delay(int(entity.Attempts)))
+			output.Failed++
+			r.logger.Warn(
+				"outbox message not published, retrying",
+				"topic", entity.Topic,
+				"idempotencyKey", entity.IdempotencyKey,
+				"attempts", entity.Attempts,
+				"error", err,
+			)
+		} else {
+			entity.PublishedAt = &now
+			output.Published++
+		}
+
+		// A message dispatched but not marked is dispatched again once its claim expires
+		err = r.db.FromContext(context.WithoutCancel(ctx)).
+			Model(&entity).
+			Select("attempts", "last_error", "available_at", "published_at").
+			Updates(&entity).Error
+		if err != nil {
+			return output, err
+		}
+	}
+
+	return output, nil
+}
+
+// claimBatch locks the due messages only long enough to push their available_at past the claim
+// duration, so the other relays skip them while they are dispatched. The messages of a relay
+// that stopped before marking them are due again once their claim expires.
+func (r *Relay) claimBatch(ctx context.Context) ([]messageEntity, error) {
+	var entities []messageEntity
+	err := r.db.FromContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		result := tx.
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("published_at IS NULL AND available_at <= ?", now).
+			Order("available_at ASC, id ASC").
+			Limit(r.batchSize).
+			Find(&entities)
+		if result.Error != nil || len(entities) == 0 {
+			return result.Error
+		}
+
+		ids := make([]uint64, 0, len(entities))
+		for _, entity := range entities {
+			ids = append(ids, entity.ID)
+		}
+
+		return tx.Model(&messageEntity{}).
+			Where("id IN ?", ids).
+			Update("available_at", now.Add(r.claimDuration)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *Relay) dispatch(ctx context.Context, entity messageEntity) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(entity.Metadata))
+
+	if handler, ok := r.handlers[entity.Topic]; ok {
+		return handler.Handle(ctx, Delivery{
+			IdempotencyKey: entity.IdempotencyKey,
+			Topic:          entity.Topic,
+			Payload:        entity.Payload,
+		})
+	}
+
+	return r.publisher.Publish(
+		ctx,
+		entity.Topic,
+		entity.Payload,
+		queue.WithMessageID(entity.IdempotencyKey),
+	)
+}
+
+func (r *Relay) deleteExpired(ctx context.Context) error {
+	expiredBefore := time.Now().UTC().Add(-r.retention)
+
+	result := r.db.FromContext(ctx).Where("published_at < ?", expiredBefore).Delete(&messageEntity{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return r.db.FromContext(ctx).Where("processed_at < ?", expiredBefore).Delete(&processedMessageEntity{}).Error
+}
+
+// truncateError keeps the recorded error of a message to a reasonable size.
+func truncateError(err error) string {
+	const maxErrorLength = 1000
+	msg := err.Error()
+	if len(msg) > maxErrorLength {
+		msg = msg[:maxErrorLength]
+	}
+	return msg
+}
@@ -0,0 +1,198 @@
+package outbox_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	logger_mocks "github.com/cristiano-pacheco/goflix/internal/shared/modules/logger/mocks"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/outbox"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/test/dbtest"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const (
+	selectDueMessagesQuery = `SELECT \* FROM "outbox_message" WHERE published_at IS NULL AND available_at <= .+ ` +
+		`ORDER BY available_at ASC, id ASC LIMIT .+ FOR UPDATE SKIP LOCKED`
+	claimMessagesQuery = `UPDATE "outbox_message" SET "available_at"=\$1 WHERE id IN \(\$2,\$3\)`
+	markMessageQuery   = `UPDATE "outbox_message" SET "attempts"=\$1,"last_error"=\$2,"available_at"=\$3,` +
+		`"published_at"=\$4 WHERE "id" = \$5`
+	deleteExpiredQuery   = `DELETE FROM "outbox_message" WHERE published_at < \$1`
+	deleteProcessedQuery = `DELETE FROM "processed_message" WHERE processed_at < \$1`
+)
+
+func TestRelay_Run(t *testing.T) {
+	t.Run("published message is marked and failed one is retried with backoff", func(t *testing.T) {
+		// Arrange
+		sut := newRelaySUT(t)
+		sut.publisher.errs["billing.invoice_paid"] = errors.New("broker is down")
+		sut.logger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+		sut.expectClaim()
+		sut.expectMark(0, "", pastTime{}, pastTime{}, 1)
+		sut.expectMark(1, "broker is down", futureTime{within: 2 * time.Second}, nilTime{}, 2)
+		sut.expectDeleteExpired()
+
+		// Act
+		output, err := sut.relay.Run(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, outbox.RelayOutput{Published: 1, Failed: 1}, output)
+		require.Equal(t, []string{"identity.email_confirmation", "billing.invoice_paid"}, sut.publisher.topics)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("failed mark keeps the messages marked before", func(t *testing.T) {
+		// Arrange
+		errDB := errors.New("database is down")
+		sut := newRelaySUT(t)
+		sut.expectClaim()
+		sut.expectMark(0, "", pastTime{}, pastTime{}, 1)
+		sut.sqlMock.ExpectBegin()
+		sut.sqlMock.ExpectExec(markMessageQuery).WillReturnError(errDB)
+		sut.sqlMock.ExpectRollback()
+
+		// Act
+		output, err := sut.relay.Run(context.Background())
+
+		// Assert
+		require.ErrorIs(t, err, errDB)
+		require.Equal(t, outbox.RelayOutput{Published: 2}, output)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("message of a topic with a handler is handled in-process", func(t *testing.T) {
+		// Arrange
+		var deliveries []outbox.Delivery
+		sut := newRelaySUT(t, outbox.Handler{
+			Topic: "billing.invoice_paid",
+			Handle: func(_ context.Context, delivery outbox.Delivery) error {
+				deliveries = append(deliveries, delivery)
+				return nil
+			},
+		})
+		sut.expectClaim()
+		sut.expectMark(0, "", pastTime{}, pastTime{}, 1)
+		sut.expectMark(0, "", pastTime{}, pastTime{}, 2)
+		sut.expectDeleteExpired()
+
+		// Act
+		output, err := sut.relay.Run(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, outbox.RelayOutput{Published: 2}, output)
+		require.Equal(t, []string{"identity.email_confirmation"}, sut.publisher.topics)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, "billing.invoice_paid:1", deliveries[0].IdempotencyKey)
+		require.NoError(t, sut.sqlMock.ExpectationsWereMet())
+	})
+}
+
+type relaySUT struct {
+	relay     *outbox.Relay
+	publisher *fakePublisher
+	logger    *logger_mocks.MockLogger
+	sqlMock   sqlmock.Sqlmock
+}
+
+func newRelaySUT(t *testing.T, handlers ...outbox.Handler) *relaySUT {
+	t.Helper()
+
+	sqlDB, db, sqlMock := dbtest.NewDBMock(t)
+	t.Cleanup(func() { dbtest.CloseWithErrorCheck(sqlDB) })
+
+	sut := &relaySUT{
+		publisher: &fakePublisher{errs: map[string]error{}},
+		logger:    logger_mocks.NewMockLogger(t),
+		sqlMock:   sqlMock,
+	}
+	sut.relay = outbox.NewRelay(outbox.RelayParams{
+		DB:        db,
+		Publisher: sut.publisher,
+		Config:    config.Config{},
+		Logger:    sut.logger,
+		Handlers:  handlers,
+	})
+	return sut
+}
+
+// expectClaim expects the claim of two due messages, in a transaction of its own.
+func (s *relaySUT) expectClaim() {
+	now := time.Now().UTC()
+	rows := sqlmock.NewRows([]string{
+		"id", "idempotency_key", "topic", "payload", "metadata", "attempts", "last_error",
+		"available_at", "published_at", "created_at",
+	}).
+		AddRow(1, "identity.email_confirmation:1", "identity.email_confirmation", `{"user_id":1}`, `{}`, 0, "",
+			now, nil, now).
+		AddRow(2, "billing.invoice_paid:1", "billing.invoice_paid", `{"invoice_id":1}`, `{}`, 0, "",
+			now, nil, now)
+
+	s.sqlMock.ExpectBegin()
+	s.sqlMock.ExpectQuery(selectDueMessagesQuery).WillReturnRows(rows)
+	s.sqlMock.ExpectExec(claimMessagesQuery).
+		WithArgs(futureTime{within: 10 * time.Minute}, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.sqlMock.ExpectCommit()
+}
+
+func (s *relaySUT) expectMark(attempts int, lastError string, availableAt, publishedAt sqlmock.Argument, id int) {
+	s.sqlMock.ExpectBegin()
+	s.sqlMock.ExpectExec(markMessageQuery).
+		WithArgs(attempts, lastError, availableAt, publishedAt, id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.sqlMock.ExpectCommit()
+}
+
+func (s *relaySUT) expectDeleteExpired() {
+	s.sqlMock.ExpectBegin()
+	s.sqlMock.ExpectExec(deleteExpiredQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.sqlMock.ExpectCommit()
+	s.sqlMock.ExpectBegin()
+	s.sqlMock.ExpectExec(deleteProcessedQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.sqlMock.ExpectCommit()
+}
+
+type fakePublisher struct {
+	topics []string
+	errs   map[string]error
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, _ any, _ ...queue.PublishOption) error {
+	p.topics = append(p.topics, topic)
+	return p.errs[topic]
+}
+
+// futureTime matches a time after now, and no further than within when it is set.
+type futureTime struct {
+	within time.Duration
+}
+
+func (a futureTime) Match(v driver.Value) bool {
+	value, ok := v.(time.Time)
+	now := time.Now()
+	return ok && value.After(now) && (a.within == 0 || value.Before(now.Add(a.within)))
+}
+
+// pastTime matches a time up to now.
+type pastTime struct{}
+
+func (pastTime) Match(v driver.Value) bool {
+	value, ok := v.(time.Time)
+	return ok && !value.After(time.Now())
+}
+
+type nilTime struct{}
+
+func (nilTime) Match(v driver.Value) bool {
+	return v == nil
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm/clause"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+)
+
+var ErrInvalidMessage = errors.New("outbox message requires a topic and an idempotency key")
+
+// Message is published to Topic once the transaction it was written in commits. IdempotencyKey
+// identifies it across redeliveries, writing a key twice keeps the first message only.
+type Message struct {
+	Topic          string
+	IdempotencyKey string
+	Payload        any
+}
+
+type Writer interface {
+	// Write saves the messages in the transaction carried by ctx, the one of the domain changes
+	// they announce. Without a transaction they are saved on their own.
+	Write(ctx context.Context, messages ...Message) error
+}
+
+type writer struct {
+	db *database.GoflixDB
+}
+
+func NewWriter(db *database.GoflixDB) Writer {
+	return &writer{db}
+}
+
+func (w *writer) Write(ctx context.Context, messages ...Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	// The relay continues the trace of the request that wrote the message
+	metadata := map[string]string{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(metadata))
+
+	now := time.Now().UTC()
+	entities := make([]messageEntity, 0, len(messages))
+	for _, message := range messages {
+		if message.Topic == "" || message.IdempotencyKey == "" {
+			return ErrInvalidMessage
+		}
+
+		payload, err := json.Marshal(message.Payload)
+		if err != nil {
+			return fmt.Errorf("encode %s outbox payload: %w", message.Topic, err)
+		}
+
+		entities = append(entities, messageEntity{
+			IdempotencyKey: message.IdempotencyKey,
+			Topic:          message.Topic,
+			Payload:        payload,
+			Metadata:       metadata,
+			AvailableAt:    now,
+			CreatedAt:      now,
+		})
+	}
+
+	return w.db.FromContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "idempotency_key"}}, DoNothing: true}).
+		Create(&entities).Error
+}
@@ -0,0 +1,5 @@
+package queue
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("queue", fx.Provide(NewQueue))
@@ -0,0 +1,114 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const (
+	defaultMaxRetries     = 5
+	defaultRetryBaseDelay = 5 * time.Second
+	defaultRetryMaxDelay  = 10 * time.Minute
+)
+
+// Subscription is the handler of a topic. Modules register their consumers by providing
+// them in the "queue_subscriptions" group.
+type Subscription struct {
+	Topic   string
+	Handler queue.Handler
+}
+
+type Params struct {
+	fx.In
+
+	Lifecycle     fx.Lifecycle
+	Config        config.Config
+	Logger        logger.Logger
+	Subscriptions []Subscription `group:"queue_subscriptions"`
+}
+
+type Result struct {
+	fx.Out
+
+	Publisher queue.Publisher
+	Consumer  queue.Consumer
+}
+
+// NewQueue connects the configured driver and starts consuming the subscribed topics with the app.
+func NewQueue(p Params) (Result, error) {
+	driver, err := newDriver(p.Config, p.Logger)
+	if err != nil {
+		return Result{}, err
+	}
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			for _, subscription := range p.Subscriptions {
+				if err := driver.Subscribe(subscription.Topic, subscription.Handler); err != nil {
+					return fmt.Errorf("subscribe to %s: %w", subscription.Topic, err)
+				}
+			}
+			return driver.Start(ctx)
+		},
+		OnStop: driver.Stop,
+	})
+
+	return Result{Publisher: driver, Consumer: driver}, nil
+}
+
+type driver interface {
+	queue.Publisher
+	queue.Consumer
+}
+
+func newDriver(cfg config.Config, logger logger.Logger) (driver, error) {
+	policy := retryPolicy(cfg.Queue)
+
+	switch cfg.Queue.Driver {
+	case config.QueueDriverMemory, "":
+		return queue.NewMemoryQueue(policy, logger, cfg.Queue.MemoryBufferSize), nil
+	case config.QueueDriverRabbitMQ:
+		port, err := strconv.Atoi(cfg.RabbitMQ.Port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rabbitmq port %q: %w", cfg.RabbitMQ.Port, err)
+		}
+		return queue.NewRabbitMQQueue(queue.RabbitMQConfig{
+			Host:     cfg.RabbitMQ.Host,
+			Port:     port,
+			Username: cfg.RabbitMQ.Username,
+			Password: cfg.RabbitMQ.Password,
+			VHost:    cfg.RabbitMQ.VHost,
+			Exchange: cfg.Queue.Exchange,
+			Prefetch: cfg.Queue.Prefetch,
+			Policy:   policy,
+		}, logger)
+	default:
+		return nil, fmt.Errorf("unknown queue driver %q", cfg.Queue.Driver)
+	}
+}
+
+func retryPolicy(cfg config.Queue) queue.RetryPolicy {
+	policy := queue.RetryPolicy{
+		MaxRetries: cfg.MaxRetries,
+		BaseDelay:  time.Duration(cfg.RetryBaseDelayInSeconds) * time.Second,
+		MaxDelay:   time.Duration(cfg.RetryMaxDelayInSeconds) * time.Second,
+	}
+	if policy.MaxRetries <= 0 {
+		policy.MaxRetries = defaultMaxRetries
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = defaultRetryBaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = defaultRetryMaxDelay
+	}
+	return policy
+}
@@ -0,0 +1,5 @@
+package ratelimit
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("ratelimit", fx.Provide(NewLimiter))
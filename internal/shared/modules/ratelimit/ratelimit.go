@@ -0,0 +1,13 @@
+package ratelimit
+
+import (
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/pkg/ratelimit"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const keyPrefix = "http:rate_limit:"
+
+func NewLimiter(redis redis.Redis, conf config.Config) (ratelimit.Limiter, error) {
+	return ratelimit.New(redis.Client(), conf.RateLimit.GetAlgorithm(), keyPrefix)
+}
@@ -0,0 +1,9 @@
+package scheduler
+
+import "go.uber.org/fx"
+
+var Module = fx.Module(
+	"scheduler",
+	fx.Provide(NewScheduler),
+	fx.Invoke(func(*Scheduler) {}),
+)
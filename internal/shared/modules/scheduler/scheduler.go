@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/logger"
+)
+
+// Job is a task run in the background at a fixed interval. Modules register their jobs
+// by providing them in the "scheduler_jobs" group.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Params struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    config.Config
+	Logger    logger.Logger
+	Jobs      []Job `group:"scheduler_jobs"`
+}
+
+// Scheduler runs every registered job in its own goroutine for the lifetime of the app.
+// A run that fails is logged and the job waits for its next tick.
+type Scheduler struct {
+	jobs   []Job
+	logger logger.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(p Params) *Scheduler {
+	s := &Scheduler{
+		jobs:   p.Jobs,
+		logger: p.Logger,
+	}
+
+	if !p.Config.Scheduler.IsEnabled {
+		return s
+	}
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: s.Stop,
+	})
+
+	return s
+}
+
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Warn("scheduler job disabled, interval must be positive", "job", job.Name)
+			continue
+		}
+
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return, or for ctx to be done.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	startedAt := time.Now()
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error("scheduler job failed", "job", job.Name, "error", err)
+		return
+	}
+	s.logger.Debug("scheduler job finished", "job", job.Name, "duration", time.Since(startedAt).String())
+}
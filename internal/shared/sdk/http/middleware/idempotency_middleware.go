@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	redis_client "github.com/redis/go-redis/v9"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyKeyPrefix      = "http:idempotency:"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBytes = 1_048_576 // 1MB, the limit of request.ReadJSON
+)
+
+// idempotencyRecord is stored under the key while its request runs, and with the response once
+// it is done.
+type idempotencyRecord struct {
+	Fingerprint string      `json:"fingerprint"`
+	Completed   bool        `json:"completed"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// IdempotencyMiddleware makes a request safe to retry when it carries an Idempotency-Key header.
+// The first request with a key runs, and its response is stored; a retry with the same key and
+// payload gets the stored response back, while a retry with another payload is rejected. Keys
+// are scoped to the user and the route. Server errors are not stored, so that a retry runs the
+// request again. Requests without the header run as usual.
+type IdempotencyMiddleware struct {
+	redis       redis.Redis
+	conf        config.Config
+	errorMapper errs.ErrorMapper
+}
+
+func NewIdempotencyMiddleware(
+	redis redis.Redis,
+	conf config.Config,
+	errorMapper errs.ErrorMapper,
+) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{redis, conf, errorMapper}
+}
+
+func (m *IdempotencyMiddleware) Middleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idempotencyKey := r.Header.Get(IdempotencyKeyHeader)
+		if idempotencyKey == "" {
+			next(w, r)
+			return
+		}
+
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			m.handleError(w, errs.NewBadRequestError(
+				"idempotency key must not be longer than "+strconv.Itoa(maxIdempotencyKeyLength)+" characters",
+			))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentRequestBytes))
+		if err != nil {
+			m.handleError(w, errs.NewBadRequestError(
+				"body must not be larger than "+strconv.Itoa(maxIdempotentRequestBytes)+" bytes",
+			))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		key := m.key(r, idempotencyKey)
+		fingerprint := m.fingerprint(r, body)
+
+		// The key is reserved while the request runs, concurrent retries must not run it as well
+		pending, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint})
+		if err != nil {
+			m.handleError(w, err)
+			return
+		}
+		reserved, err := m.redis.Client().SetNX(ctx, key, pending, m.conf.Idempotency.GetLockTimeout()).Result()
+		if err != nil {
+			m.handleError(w, err)
+			return
+		}
+
+		if !reserved {
+			m.replay(w, r, key, fingerprint)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		defer func() {
+			// A panic or a server error leaves nothing to replay
+			if !recorder.completed() {
+				m.release(r, key)
+			}
+		}()
+
+		next(recorder, r)
+
+		if !recorder.completed() {
+			return
+		}
+
+		m.store(r, key, idempotencyRecord{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			Status:      recorder.status,
+			Header:      recorder.header,
+			Body:        recorder.body.Bytes(),
+		})
+	}
+}
+
+func (m *IdempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, key, fingerprint string) {
+	stored, err := m.redis.Client().Get(r.Context(), key).Bytes()
+	if err != nil {
+		// The first request failed and released the key in the meantime, the client can retry
+		if errors.Is(err, redis_client.Nil) {
+			m.handleError(w, errs.ErrIdempotencyKeyInProgress)
+			return
+		}
+		m.handleError(w, err)
+		return
+	}
+
+	var record idempotencyRecord
+	err = json.Unmarshal(stored, &record)
+	if err != nil {
+		m.handleError(w, err)
+		return
+	}
+
+	if record.Fingerprint != fingerprint {
+		m.handleError(w, errs.ErrIdempotencyKeyReused)
+		return
+	}
+
+	if !record.Completed {
+		m.handleError(w, errs.ErrIdempotencyKeyInProgress)
+		return
+	}
+
+	for name, values := range record.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(record.Status)
+	if _, writeErr := w.Write(record.Body); writeErr != nil {
+		//nolint:sloglint // it's ok to have a global logger here
+		slog.Error("Failed to write replayed response", "error", writeErr)
+	}
+}
+
+// store keeps the response for the retries. The response has been sent already, so a failure
+// is only logged: a retry then runs into the reserved key until the lock times out. The client
+// may have given up on the request, which must not keep the response from being stored.
+func (m *IdempotencyMiddleware) store(r *http.Request, key string, record idempotencyRecord) {
+	ctx := context.WithoutCancel(r.Context())
+	value, err := json.Marshal(record)
+	if err == nil {
+		err = m.redis.Client().Set(ctx, key, value, m.conf.Idempotency.GetTTL()).Err()
+	}
+	if err != nil {
+		//nolint:sloglint // it's ok to have a global logger here
+		slog.ErrorContext(ctx, "Failed to store idempotent response", "error", err)
+	}
+}
+
+func (m *IdempotencyMiddleware) release(r *http.Request, key string) {
+	ctx := context.WithoutCancel(r.Context())
+	if err := m.redis.Client().Del(ctx, key).Err(); err != nil {
+		//nolint:sloglint // it's ok to have a global logger here
+		slog.ErrorContext(ctx, "Failed to release idempotency key", "error", err)
+	}
+}
+
+// key hashes the parts of the key, so that clients cannot choose what is stored in Redis.
+func (m *IdempotencyMiddleware) key(r *http.Request, idempotencyKey string) string {
+	hash := sha256.New()
+	hash.Write([]byte(strconv.FormatUint(request.GetUserID(r), 10)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.Method + " " + r.URL.Path))
+	hash.Write([]byte{0})
+	hash.Write([]byte(idempotencyKey))
+	return idempotencyKeyPrefix + hex.EncodeToString(hash.Sum(nil))
+}
+
+func (m *IdempotencyMiddleware) fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (m *IdempotencyMiddleware) handleError(w http.ResponseWriter, err error) {
+	rError := m.errorMapper.Map(err)
+	response.Error(w, rError)
+}
+
+// responseRecorder copies the response to the client into memory.
+type responseRecorder struct {
+	http.ResponseWriter
+
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.status != 0 {
+		return
+	}
+	rr.status = status
+	rr.header = rr.ResponseWriter.Header().Clone()
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	rr.WriteHeader(http.StatusOK)
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+// completed reports whether the response is worth replaying: a client error is as final as a
+// success, but a server error may not happen again.
+func (rr *responseRecorder) completed() bool {
+	return rr.status != 0 && rr.status < http.StatusInternalServerError
+}
@@ -0,0 +1,171 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/middleware"
+	"github.com/cristiano-pacheco/goflix/pkg/redis"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Run("request without key runs every time", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		calls := 0
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		// Act
+		handler(httptest.NewRecorder(), newIdempotentRequest("", `{"plan_id":1}`))
+		handler(httptest.NewRecorder(), newIdempotentRequest("", `{"plan_id":1}`))
+
+		// Assert
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("retry gets the stored response back", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		calls := 0
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			w.Header().Set("Location", "/api/v1/subscriptions/1")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+		})
+		handler(httptest.NewRecorder(), newIdempotentRequest("key-1", `{"plan_id":1}`))
+		retry := httptest.NewRecorder()
+
+		// Act
+		handler(retry, newIdempotentRequest("key-1", `{"plan_id":1}`))
+
+		// Assert
+		require.Equal(t, 1, calls)
+		require.Equal(t, http.StatusCreated, retry.Code)
+		require.JSONEq(t, `{"data":{"id":1}}`, retry.Body.String())
+		require.Equal(t, "/api/v1/subscriptions/1", retry.Header().Get("Location"))
+		require.Equal(t, "true", retry.Header().Get(middleware.IdempotentReplayedHeader))
+	})
+
+	t.Run("retry with another payload returns 422", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		calls := 0
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		})
+		handler(httptest.NewRecorder(), newIdempotentRequest("key-1", `{"plan_id":1}`))
+		retry := httptest.NewRecorder()
+
+		// Act
+		handler(retry, newIdempotentRequest("key-1", `{"plan_id":2}`))
+
+		// Assert
+		require.Equal(t, 1, calls)
+		require.Equal(t, http.StatusUnprocessableEntity, retry.Code)
+	})
+
+	t.Run("retry while the request runs returns 409", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusCreated)
+		})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			handler(httptest.NewRecorder(), newIdempotentRequest("key-1", `{"plan_id":1}`))
+		}()
+		<-started
+		retry := httptest.NewRecorder()
+
+		// Act
+		handler(retry, newIdempotentRequest("key-1", `{"plan_id":1}`))
+
+		// Assert
+		close(release)
+		<-done
+		require.Equal(t, http.StatusConflict, retry.Code)
+	})
+
+	t.Run("server error is not stored", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		calls := 0
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		})
+		handler(httptest.NewRecorder(), newIdempotentRequest("key-1", `{"plan_id":1}`))
+		retry := httptest.NewRecorder()
+
+		// Act
+		handler(retry, newIdempotentRequest("key-1", `{"plan_id":1}`))
+
+		// Assert
+		require.Equal(t, 2, calls)
+		require.Equal(t, http.StatusCreated, retry.Code)
+		require.Empty(t, retry.Header().Get(middleware.IdempotentReplayedHeader))
+	})
+
+	t.Run("response is stored after the client disconnects", func(t *testing.T) {
+		// Arrange
+		idempotency := newIdempotencyMiddleware(t)
+		calls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		handler := idempotency.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			cancel()
+			w.WriteHeader(http.StatusCreated)
+		})
+		handler(httptest.NewRecorder(), newIdempotentRequest("key-1", `{"plan_id":1}`).WithContext(ctx))
+		retry := httptest.NewRecorder()
+
+		// Act
+		handler(retry, newIdempotentRequest("key-1", `{"plan_id":1}`))
+
+		// Assert
+		require.Equal(t, 1, calls)
+		require.Equal(t, http.StatusCreated, retry.Code)
+		require.Equal(t, "true", retry.Header().Get(middleware.IdempotentReplayedHeader))
+	})
+}
+
+func newIdempotencyMiddleware(t *testing.T) *middleware.IdempotencyMiddleware {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	return middleware.NewIdempotencyMiddleware(
+		redis.NewRedis(server.Addr(), "", 0),
+		config.Config{},
+		errs.New(nil, nil),
+	)
+}
+
+func newIdempotentRequest(key, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/subscriptions", strings.NewReader(body))
+	if key != "" {
+		r.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	return r
+}
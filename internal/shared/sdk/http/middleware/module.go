@@ -0,0 +1,11 @@
+package middleware
+
+import "go.uber.org/fx"
+
+var Module = fx.Module(
+	"sdk/http/middleware",
+	fx.Provide(
+		NewIdempotencyMiddleware,
+		NewRateLimitMiddleware,
+	),
+)
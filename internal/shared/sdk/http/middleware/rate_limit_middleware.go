@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/request"
+	"github.com/cristiano-pacheco/goflix/internal/shared/sdk/http/response"
+	"github.com/cristiano-pacheco/goflix/pkg/ratelimit"
+)
+
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+	maxRateLimitedBodyBytes  = 1_048_576 // 1MB, the limit of request.ReadJSON
+)
+
+// RateLimitMiddleware limits the requests to a route with the rules of a policy of
+// RATE_LIMIT_POLICIES. Each rule counts the requests by the client IP, by the email of the
+// JSON body or by the authenticated user; a request is rejected with 429 as soon as one of them
+// is over its limit. The limits are shared by every instance through Redis, and a Redis failure
+// lets the requests through rather than taking the routes down with it.
+type RateLimitMiddleware struct {
+	limiter  ratelimit.Limiter
+	enabled  bool
+	policies map[string][]config.RateLimitRule
+
+	errorMapper errs.ErrorMapper
+}
+
+func NewRateLimitMiddleware(
+	limiter ratelimit.Limiter,
+	conf config.Config,
+	errorMapper errs.ErrorMapper,
+) (*RateLimitMiddleware, error) {
+	policies, err := conf.RateLimit.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	for name, rules := range policies {
+		for _, rule := range rules {
+			switch rule.Key {
+			case config.RateLimitKeyIP, config.RateLimitKeyEmail, config.RateLimitKeyUser:
+			default:
+				return nil, fmt.Errorf(
+					"%w: policy %s: unknown key %q",
+					config.ErrInvalidRateLimitPolicies, name, rule.Key,
+				)
+			}
+		}
+	}
+
+	return &RateLimitMiddleware{limiter, conf.RateLimit.Enabled, policies, errorMapper}, nil
+}
+
+// Middleware limits next with the rules of policy. A route whose policy is not configured is
+// not limited.
+func (m *RateLimitMiddleware) Middleware(policy string, next http.HandlerFunc) http.HandlerFunc {
+	rules, ok := m.policies[policy]
+	if !m.enabled || !ok {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		values, err := m.keyValues(w, r, rules)
+		if err != nil {
+			m.handleError(w, err)
+			return
+		}
+
+		ctx := r.Context()
+		var tightest *ratelimit.Result
+		var tightestRule config.RateLimitRule
+		for i, rule := range rules {
+			value := values[rule.Key]
+			if value == "" {
+				continue
+			}
+
+			limit := ratelimit.Limit{Requests: rule.Requests, Period: rule.Period}
+			result, allowErr := m.limiter.Allow(ctx, m.key(policy, i, rule.Key, value), limit)
+			if allowErr != nil {
+				//nolint:sloglint // it's ok to have a global logger here
+				slog.ErrorContext(ctx, "Failed to check the rate limit", "policy", policy, "error", allowErr)
+				continue
+			}
+
+			if !result.Allowed {
+				m.writeHeaders(w, result, rule)
+				response.SetRetryAfter(w, result.RetryAfter)
+				m.handleError(w, errs.ErrRateLimited)
+				return
+			}
+
+			if tightest == nil || result.Remaining < tightest.Remaining {
+				tightest = &result
+				tightestRule = rule
+			}
+		}
+
+		if tightest != nil {
+			m.writeHeaders(w, *tightest, tightestRule)
+		}
+
+		next(w, r)
+	}
+}
+
+// keyValues returns the value of every key the rules count the requests by. The body is only
+// read when a rule needs the email, and is put back for the handler.
+func (m *RateLimitMiddleware) keyValues(
+	w http.ResponseWriter,
+	r *http.Request,
+	rules []config.RateLimitRule,
+) (map[string]string, error) {
+	values := map[string]string{config.RateLimitKeyIP: request.ClientIP(r)}
+	if userID := request.GetUserID(r); userID != 0 {
+		values[config.RateLimitKeyUser] = strconv.FormatUint(userID, 10)
+	}
+
+	for _, rule := range rules {
+		if rule.Key != config.RateLimitKeyEmail {
+			continue
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRateLimitedBodyBytes))
+		if err != nil {
+			return nil, errs.NewBadRequestError(
+				"body must not be larger than " + strconv.Itoa(maxRateLimitedBodyBytes) + " bytes",
+			)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// A body that is not valid JSON is left for the handler to reject
+		var payload struct {
+			Email string `json:"email"`
+		}
+		if json.Unmarshal(body, &payload) == nil {
+			values[config.RateLimitKeyEmail] = strings.ToLower(strings.TrimSpace(payload.Email))
+		}
+		break
+	}
+
+	return values, nil
+}
+
+// key hashes the value, so that clients cannot choose what is stored in Redis.
+func (m *RateLimitMiddleware) key(policy string, rule int, key, value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return policy + ":" + strconv.Itoa(rule) + ":" + key + ":" + hex.EncodeToString(hash[:])
+}
+
+// writeHeaders reports the state of a rule with the RateLimit headers of the IETF draft.
+func (m *RateLimitMiddleware) writeHeaders(w http.ResponseWriter, result ratelimit.Result, rule config.RateLimitRule) {
+	header := w.Header()
+	header.Set(RateLimitLimitHeader, strconv.Itoa(result.Limit))
+	header.Set(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+	header.Set(RateLimitResetHeader, strconv.FormatInt(int64(math.Ceil(result.ResetAfter.Seconds())), 10))
+	header.Set(RateLimitPolicyHeader, strconv.Itoa(rule.Requests)+";w="+strconv.Itoa(int(rule.Period.Seconds())))
+}
+
+func (m *RateLimitMiddleware) handleError(w http.ResponseWriter, err error) {
+	rError := m.errorMapper.Map(err)
+	response.Error(w, rError)
+}
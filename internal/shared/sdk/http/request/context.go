@@ -2,11 +2,17 @@ package request
 
 import (
 	"net/http"
+	"time"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "user_id"
+const (
+	UserIDKey         contextKey = "user_id"
+	UserRoleKey       contextKey = "user_role"
+	TokenIDKey        contextKey = "token_id"
+	TokenExpiresAtKey contextKey = "token_expires_at"
+)
 
 func GetUserID(r *http.Request) uint64 {
 	userID, ok := r.Context().Value(UserIDKey).(uint64)
@@ -15,3 +21,27 @@ func GetUserID(r *http.Request) uint64 {
 	}
 	return userID
 }
+
+func GetUserRole(r *http.Request) string {
+	role, ok := r.Context().Value(UserRoleKey).(string)
+	if !ok {
+		return ""
+	}
+	return role
+}
+
+func GetTokenID(r *http.Request) string {
+	tokenID, ok := r.Context().Value(TokenIDKey).(string)
+	if !ok {
+		return ""
+	}
+	return tokenID
+}
+
+func GetTokenExpiresAt(r *http.Request) time.Time {
+	expiresAt, ok := r.Context().Value(TokenExpiresAtKey).(time.Time)
+	if !ok {
+		return time.Time{}
+	}
+	return expiresAt
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strings"
 
@@ -73,3 +74,13 @@ func Param(r *http.Request, name string) string {
 	params := httprouter.ParamsFromContext(r.Context())
 	return params.ByName(name)
 }
+
+// ClientIP returns the IP address of the peer of the request. Forwarding headers are not
+// trusted, so behind a proxy this is the address of the proxy.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
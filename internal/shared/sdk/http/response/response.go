@@ -5,7 +5,10 @@ import (
 	"errors"
 	"log/slog"
 	"maps"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/cristiano-pacheco/goflix/internal/shared/modules/errs"
 )
@@ -62,3 +65,8 @@ func JSON(w http.ResponseWriter, status int, envelope Envelope, headers http.Hea
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// SetRetryAfter tells the client how long to wait before it tries again, in whole seconds.
+func SetRetryAfter(w http.ResponseWriter, after time.Duration) {
+	w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(after.Seconds())), 10))
+}
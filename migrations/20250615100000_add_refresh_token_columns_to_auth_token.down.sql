@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_auth_token_user_id;
+DROP INDEX IF EXISTS idx_auth_token_family_id;
+
+ALTER TABLE auth_token
+    DROP COLUMN IF EXISTS revoked_at,
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS family_id;
@@ -0,0 +1,12 @@
+-- Refresh tokens rotate on every use. Tokens issued from the same login share a family_id
+-- so that replaying a rotated token can revoke the whole family.
+-- Tokens issued before this migration have no family and are discarded.
+DELETE FROM auth_token;
+
+ALTER TABLE auth_token
+    ADD COLUMN family_id VARCHAR(64) NOT NULL,
+    ADD COLUMN rotated_at TIMESTAMPTZ,
+    ADD COLUMN revoked_at TIMESTAMPTZ;
+
+CREATE INDEX idx_auth_token_family_id ON auth_token(family_id);
+CREATE INDEX idx_auth_token_user_id ON auth_token(user_id);
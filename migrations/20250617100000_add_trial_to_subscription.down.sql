@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_subscription_user_trial;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS trial_end_date;
+
+-- Postgres cannot drop a value from an enum type, so running trials fall back to Active
+-- and the 'Trialing' value is left in subscription_status_enum.
+UPDATE subscription SET status = 'Active' WHERE status = 'Trialing';
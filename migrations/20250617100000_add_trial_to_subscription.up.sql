@@ -0,0 +1,6 @@
+ALTER TYPE subscription_status_enum ADD VALUE IF NOT EXISTS 'Trialing';
+
+ALTER TABLE subscription ADD COLUMN trial_end_date TIMESTAMPTZ;
+
+-- A user can start a single trial, no matter how many times they re-subscribe.
+CREATE UNIQUE INDEX idx_subscription_user_trial ON subscription(user_id) WHERE trial_end_date IS NOT NULL;
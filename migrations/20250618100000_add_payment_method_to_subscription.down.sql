@@ -0,0 +1,3 @@
+ALTER TABLE subscription DROP COLUMN IF EXISTS payment_method_id;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS payment_customer_id;
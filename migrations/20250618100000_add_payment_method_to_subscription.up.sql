@@ -0,0 +1,3 @@
+ALTER TABLE subscription ADD COLUMN payment_customer_id VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE subscription ADD COLUMN payment_method_id VARCHAR(255) NOT NULL DEFAULT '';
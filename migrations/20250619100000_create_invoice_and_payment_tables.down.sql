@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_payment_invoice;
+DROP TABLE IF EXISTS payment;
+
+DROP INDEX IF EXISTS idx_invoice_line_invoice;
+DROP TABLE IF EXISTS invoice_line;
+
+DROP INDEX IF EXISTS idx_invoice_user;
+DROP TABLE IF EXISTS invoice;
+
+DROP TYPE IF EXISTS payment_status_enum;
+DROP TYPE IF EXISTS invoice_status_enum;
@@ -0,0 +1,61 @@
+--────────────────────────────────────
+-- 1. Enums
+--────────────────────────────────────
+
+CREATE TYPE invoice_status_enum AS ENUM ('Open', 'Paid', 'Void');
+CREATE TYPE payment_status_enum AS ENUM ('Succeeded', 'Failed', 'Refunded');
+
+--────────────────────────────────────
+-- Invoice table - One invoice per subscription period
+--────────────────────────────────────
+
+CREATE TABLE invoice (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    subscription_id BIGINT NOT NULL REFERENCES subscription(id) ON DELETE NO ACTION ON UPDATE NO ACTION,
+    status invoice_status_enum NOT NULL DEFAULT 'Open',
+    currency VARCHAR(3) NOT NULL,
+    total_cents INTEGER NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (subscription_id, period_start)
+);
+
+CREATE INDEX idx_invoice_user ON invoice(user_id);
+
+--────────────────────────────────────
+-- Invoice line table - Items billed on an invoice
+--────────────────────────────────────
+
+CREATE TABLE invoice_line (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoice(id) ON DELETE CASCADE ON UPDATE NO ACTION,
+    description VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    unit_amount_cents INTEGER NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_invoice_line_invoice ON invoice_line(invoice_id);
+
+--────────────────────────────────────
+-- Payment table - Every attempt to pay an invoice
+--────────────────────────────────────
+
+CREATE TABLE payment (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoice(id) ON DELETE NO ACTION ON UPDATE NO ACTION,
+    status payment_status_enum NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    provider_payment_id VARCHAR(255) NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_payment_invoice ON payment(invoice_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_subscription_status_end_date;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS next_retry_at;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS retry_count;
@@ -0,0 +1,5 @@
+ALTER TABLE subscription ADD COLUMN retry_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE subscription ADD COLUMN next_retry_at TIMESTAMPTZ;
+
+CREATE INDEX idx_subscription_status_end_date ON subscription(status, end_date);
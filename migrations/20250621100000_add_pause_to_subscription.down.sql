@@ -0,0 +1,6 @@
+ALTER TABLE subscription DROP COLUMN IF EXISTS paused_at;
+ALTER TABLE subscription DROP COLUMN IF EXISTS current_period_start;
+
+-- Postgres cannot drop a value from an enum type, so paused subscriptions fall back to
+-- Active and the 'Paused' value is left in subscription_status_enum.
+UPDATE subscription SET status = 'Active' WHERE status = 'Paused';
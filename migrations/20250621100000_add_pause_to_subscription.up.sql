@@ -0,0 +1,14 @@
+ALTER TYPE subscription_status_enum ADD VALUE IF NOT EXISTS 'Paused';
+
+ALTER TABLE subscription ADD COLUMN current_period_start TIMESTAMPTZ;
+ALTER TABLE subscription ADD COLUMN paused_at TIMESTAMPTZ;
+
+-- Existing subscriptions have never been renewed through the period tracking, so the
+-- converted trials start their period at the end of the trial and the rest at the start date.
+UPDATE subscription
+SET current_period_start = CASE
+    WHEN trial_end_date IS NOT NULL AND status <> 'Trialing' THEN trial_end_date
+    ELSE start_date
+END;
+
+ALTER TABLE subscription ALTER COLUMN current_period_start SET NOT NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE plan DROP CONSTRAINT IF EXISTS chk_plan_max_profiles;
+ALTER TABLE plan DROP CONSTRAINT IF EXISTS chk_plan_max_downloads;
+ALTER TABLE plan DROP CONSTRAINT IF EXISTS chk_plan_max_concurrent_streams;
+
+ALTER TABLE plan DROP COLUMN IF EXISTS max_profiles;
+ALTER TABLE plan DROP COLUMN IF EXISTS max_downloads;
+ALTER TABLE plan DROP COLUMN IF EXISTS max_resolution;
+ALTER TABLE plan DROP COLUMN IF EXISTS max_concurrent_streams;
+
+DROP TYPE IF EXISTS video_resolution_enum;
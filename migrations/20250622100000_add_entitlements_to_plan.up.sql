@@ -0,0 +1,11 @@
+CREATE TYPE video_resolution_enum AS ENUM ('480p', '720p', '1080p', '2160p');
+
+-- Existing plans get the entry tier, admins upgrade them afterwards.
+ALTER TABLE plan ADD COLUMN max_concurrent_streams INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE plan ADD COLUMN max_resolution video_resolution_enum NOT NULL DEFAULT '1080p';
+ALTER TABLE plan ADD COLUMN max_downloads INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE plan ADD COLUMN max_profiles INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE plan ADD CONSTRAINT chk_plan_max_concurrent_streams CHECK (max_concurrent_streams BETWEEN 1 AND 10);
+ALTER TABLE plan ADD CONSTRAINT chk_plan_max_downloads CHECK (max_downloads BETWEEN 0 AND 100);
+ALTER TABLE plan ADD CONSTRAINT chk_plan_max_profiles CHECK (max_profiles BETWEEN 1 AND 10);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS video_upload;
+
+DROP TYPE IF EXISTS video_upload_status_enum;
+
+ALTER TABLE video DROP COLUMN IF EXISTS checksum_sha256;
+ALTER TABLE video DROP COLUMN IF EXISTS content_type;
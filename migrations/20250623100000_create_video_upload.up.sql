@@ -0,0 +1,40 @@
+ALTER TABLE video ADD COLUMN content_type TEXT;
+ALTER TABLE video ADD COLUMN checksum_sha256 CHAR(64);
+
+CREATE TYPE video_upload_status_enum AS ENUM ('Pending', 'Completed', 'Aborted');
+
+--────────────────────────────────────
+-- Video Upload table
+--────────────────────────────────────
+
+-- A resumable upload of the video of a movie or of an episode. Chunks are stored as the parts
+-- of a multipart upload in the blob store, hash_state is the SHA-256 of the bytes received so far.
+CREATE TABLE video_upload (
+    id                  UUID PRIMARY KEY,
+    movie_id            BIGINT REFERENCES movie(id) ON DELETE CASCADE,
+    episode_id          BIGINT REFERENCES episode(id) ON DELETE CASCADE,
+    object_key          TEXT NOT NULL,
+    storage_upload_id   TEXT NOT NULL,
+    content_type        TEXT NOT NULL,
+    total_size_in_bytes BIGINT NOT NULL,
+    received_bytes      BIGINT NOT NULL DEFAULT 0,
+    parts               JSONB NOT NULL DEFAULT '[]',
+    checksum_sha256     CHAR(64) NOT NULL,
+    hash_state          BYTEA,
+    status              video_upload_status_enum NOT NULL DEFAULT 'Pending',
+    video_id            BIGINT REFERENCES video(id) ON DELETE SET NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_video_upload_received_bytes CHECK (received_bytes BETWEEN 0 AND total_size_in_bytes),
+    CONSTRAINT chk_video_upload_total_size CHECK (total_size_in_bytes > 0),
+    -- Same rule as the video the upload turns into
+    CONSTRAINT video_upload_belongs_to_one_content CHECK (
+        (movie_id IS NOT NULL AND episode_id IS NULL) OR
+        (movie_id IS NULL AND episode_id IS NOT NULL)
+    )
+);
+
+-- Indexes for video_upload table
+CREATE INDEX idx_video_upload_movie ON video_upload(movie_id);
+CREATE INDEX idx_video_upload_episode ON video_upload(episode_id);
+CREATE INDEX idx_video_upload_status ON video_upload(status);
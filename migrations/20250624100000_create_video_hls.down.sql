@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS video_segment;
+DROP TABLE IF EXISTS video_rendition;
+DROP TABLE IF EXISTS transcoding_job;
+DROP TYPE IF EXISTS transcoding_job_status_enum;
@@ -0,0 +1,65 @@
+CREATE TYPE transcoding_job_status_enum AS ENUM ('Pending', 'Running', 'Completed', 'Failed');
+
+--────────────────────────────────────
+-- Transcoding Job table
+--────────────────────────────────────
+
+-- Packages the file of a video into HLS renditions. attempts is incremented every time a worker
+-- claims the job, a worker only saves the job while attempts still matches its own claim.
+CREATE TABLE transcoding_job (
+    id          BIGSERIAL PRIMARY KEY,
+    video_id    BIGINT NOT NULL REFERENCES video(id) ON DELETE CASCADE,
+    status      transcoding_job_status_enum NOT NULL DEFAULT 'Pending',
+    source_key  TEXT NOT NULL,
+    attempts    INT NOT NULL DEFAULT 0,
+    last_error  TEXT NOT NULL DEFAULT '',
+    started_at  TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_transcoding_job_attempts CHECK (attempts >= 0)
+);
+
+-- Indexes for transcoding_job table
+CREATE INDEX idx_transcoding_job_video ON transcoding_job(video_id);
+-- Workers only look for the jobs still to do
+CREATE INDEX idx_transcoding_job_active ON transcoding_job(status, created_at)
+    WHERE status IN ('Pending', 'Running');
+
+--────────────────────────────────────
+-- Video Rendition table
+--────────────────────────────────────
+
+-- One quality of a video packaged for HLS. The bandwidths are in bits per second.
+CREATE TABLE video_rendition (
+    id                BIGSERIAL PRIMARY KEY,
+    video_id          BIGINT NOT NULL REFERENCES video(id) ON DELETE CASCADE,
+    name              VARCHAR(16) NOT NULL,
+    width             INT NOT NULL,
+    height            INT NOT NULL,
+    codecs            TEXT NOT NULL,
+    bandwidth         BIGINT NOT NULL,
+    average_bandwidth BIGINT NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_video_rendition_video_name UNIQUE (video_id, name),
+    CONSTRAINT chk_video_rendition_resolution CHECK (width > 0 AND height > 0),
+    CONSTRAINT chk_video_rendition_bandwidth CHECK (bandwidth > 0 AND average_bandwidth > 0)
+);
+
+--────────────────────────────────────
+-- Video Segment table
+--────────────────────────────────────
+
+-- The media segments of a rendition, numbered from 0 in playback order.
+CREATE TABLE video_segment (
+    id             BIGSERIAL PRIMARY KEY,
+    rendition_id   BIGINT NOT NULL REFERENCES video_rendition(id) ON DELETE CASCADE,
+    sequence       INT NOT NULL,
+    duration_in_ms INT NOT NULL,
+    object_key     TEXT NOT NULL,
+    size_in_bytes  BIGINT NOT NULL,
+    CONSTRAINT uq_video_segment_rendition_sequence UNIQUE (rendition_id, sequence),
+    CONSTRAINT chk_video_segment_sequence CHECK (sequence >= 0),
+    CONSTRAINT chk_video_segment_duration CHECK (duration_in_ms > 0),
+    CONSTRAINT chk_video_segment_size CHECK (size_in_bytes > 0)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS processed_message;
+
+DROP TABLE IF EXISTS outbox_message;
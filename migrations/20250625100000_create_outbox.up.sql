@@ -0,0 +1,42 @@
+--────────────────────────────────────
+-- Outbox Message table
+--────────────────────────────────────
+
+-- Messages written in the same transaction as the domain changes they announce, and relayed to
+-- the queue, or to in-process handlers, once it commits. A message is relayed at least once, the
+-- idempotency key lets consumers recognize a message they already handled.
+CREATE TABLE outbox_message (
+    id              BIGSERIAL PRIMARY KEY,
+    idempotency_key TEXT NOT NULL,
+    topic           TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    metadata        JSONB NOT NULL DEFAULT '{}',
+    attempts        INT NOT NULL DEFAULT 0,
+    last_error      TEXT NOT NULL DEFAULT '',
+    available_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_outbox_message_idempotency_key UNIQUE (idempotency_key),
+    CONSTRAINT chk_outbox_message_attempts CHECK (attempts >= 0)
+);
+
+-- Indexes for outbox_message table
+-- The relay only looks for the messages still to publish
+CREATE INDEX idx_outbox_message_pending ON outbox_message(available_at, id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_message_published ON outbox_message(published_at) WHERE published_at IS NOT NULL;
+
+--────────────────────────────────────
+-- Processed Message table
+--────────────────────────────────────
+
+-- The idempotency keys of the messages a consumer has handled, so that a redelivered message
+-- is skipped.
+CREATE TABLE processed_message (
+    consumer        TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    processed_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (consumer, idempotency_key)
+);
+
+-- Indexes for processed_message table
+CREATE INDEX idx_processed_message_processed_at ON processed_message(processed_at);
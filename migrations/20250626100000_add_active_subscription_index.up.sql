@@ -0,0 +1,4 @@
+-- A user can have a single subscription that is active, trialing or paused, even when two requests
+-- subscribe at the same time. A paused subscription is resumed rather than replaced.
+CREATE UNIQUE INDEX idx_subscription_user_active ON subscription(user_id)
+WHERE status IN ('Active', 'Trialing', 'Paused');
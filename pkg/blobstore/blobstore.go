@@ -0,0 +1,49 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	ErrUploadNotFound = errors.New("multipart upload not found")
+	ErrInvalidKey     = errors.New("invalid object key")
+)
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// Part is one uploaded part of a multipart upload, parts are numbered from 1.
+type Part struct {
+	Number int    `json:"number"`
+	ETag   string `json:"etag"`
+	Size   int64  `json:"size"`
+}
+
+// BlobStore stores opaque objects by key. Keys use forward slashes, e.g. "videos/movies/1/file.mp4".
+type BlobStore interface {
+	Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+	// Get reads length bytes of the object starting at offset, a negative length reads up to the end.
+	Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+
+	// CreateMultipartUpload starts an upload sent in parts and returns its id. Every part but
+	// the last one must be at least MinPartSize bytes, as S3 requires.
+	CreateMultipartUpload(ctx context.Context, key string, contentType string) (string, error)
+	UploadPart(ctx context.Context, key, uploadID string, number int, reader io.Reader, size int64) (Part, error)
+	// CompleteMultipartUpload assembles the parts, in the given order, into the object.
+	CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []Part) error
+	AbortMultipartUpload(ctx context.Context, key, uploadID string) error
+}
+
+// MinPartSize is the smallest part S3 accepts in a multipart upload, except for the last one.
+const MinPartSize = 5 * 1024 * 1024
@@ -0,0 +1,246 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // md5 only mimics the S3 part etag, it is not used for security
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	multipartDir  = ".multipart"
+	dirPermission = 0o750
+)
+
+type localBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore stores objects as files under root. It is meant for development and
+// single instance deployments.
+func NewLocalBlobStore(root string) (BlobStore, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(filepath.Join(root, multipartDir), dirPermission); err != nil {
+		return nil, err
+	}
+
+	return &localBlobStore{root: root}, nil
+}
+
+func (s *localBlobStore) Put(_ context.Context, key string, reader io.Reader, size int64, _ string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.writeFile(filePath, io.LimitReader(reader, size))
+	return err
+}
+
+func (s *localBlobStore) Get(_ context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if length < 0 {
+		return file, nil
+	}
+
+	return &limitedReadCloser{Reader: io.LimitReader(file, length), Closer: file}, nil
+}
+
+func (s *localBlobStore) Stat(_ context.Context, key string) (ObjectInfo, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+		return ObjectInfo{}, err
+	}
+
+	return ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		LastModified: info.ModTime().UTC(),
+	}, nil
+}
+
+func (s *localBlobStore) Delete(_ context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *localBlobStore) CreateMultipartUpload(_ context.Context, key string, _ string) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+
+	uploadID := uuid.NewString()
+	if err := os.MkdirAll(filepath.Join(s.root, multipartDir, uploadID), dirPermission); err != nil {
+		return "", err
+	}
+
+	return uploadID, nil
+}
+
+func (s *localBlobStore) UploadPart(
+	_ context.Context,
+	_ string,
+	uploadID string,
+	number int,
+	reader io.Reader,
+	size int64,
+) (Part, error) {
+	uploadDir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return Part{}, err
+	}
+
+	hash := md5.New() //nolint:gosec // see import
+	written, err := s.writeFile(
+		filepath.Join(uploadDir, strconv.Itoa(number)),
+		io.TeeReader(io.LimitReader(reader, size), hash),
+	)
+	if err != nil {
+		return Part{}, err
+	}
+
+	return Part{Number: number, ETag: hex.EncodeToString(hash.Sum(nil)), Size: written}, nil
+}
+
+func (s *localBlobStore) CompleteMultipartUpload(_ context.Context, key, uploadID string, parts []Part) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	uploadDir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		partFile, errOpen := os.Open(filepath.Join(uploadDir, strconv.Itoa(part.Number)))
+		if errOpen != nil {
+			return errOpen
+		}
+		defer partFile.Close()
+		readers = append(readers, partFile)
+	}
+
+	if _, err = s.writeFile(filePath, io.MultiReader(readers...)); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(uploadDir)
+}
+
+func (s *localBlobStore) AbortMultipartUpload(_ context.Context, _ string, uploadID string) error {
+	uploadDir, err := s.uploadDir(uploadID)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(uploadDir)
+}
+
+// writeFile writes to a temporary file first, so readers never see a partial file.
+func (s *localBlobStore) writeFile(filePath string, reader io.Reader) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(filePath), dirPermission); err != nil {
+		return 0, err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	written, err := io.Copy(tmpFile, reader)
+	if err != nil {
+		tmpFile.Close()
+		return 0, err
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return 0, err
+	}
+
+	return written, os.Rename(tmpFile.Name(), filePath)
+}
+
+// path maps a key to a file under the root, rejecting keys that would escape it.
+func (s *localBlobStore) path(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if key == "" || cleanKey == "/" || strings.HasPrefix(cleanKey, "/"+multipartDir) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidKey, key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(cleanKey)), nil
+}
+
+func (s *localBlobStore) uploadDir(uploadID string) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", ErrUploadNotFound
+	}
+
+	uploadDir := filepath.Join(s.root, multipartDir, uploadID)
+	if _, err := os.Stat(uploadDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrUploadNotFound
+		}
+		return "", err
+	}
+
+	return uploadDir, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
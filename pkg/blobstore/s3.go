@@ -0,0 +1,167 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+type s3BlobStore struct {
+	core   *minio.Core
+	bucket string
+}
+
+// NewS3BlobStore stores objects in a bucket of any S3 compatible service, e.g. AWS S3 or MinIO.
+// The bucket is created when it does not exist yet.
+func NewS3BlobStore(ctx context.Context, cfg S3Config) (BlobStore, error) {
+	core, err := minio.NewCore(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := core.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		err = core.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &s3BlobStore{core: core, bucket: cfg.Bucket}, nil
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	_, err := s.core.Client.PutObject(ctx, s.bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return s.mapError(err)
+}
+
+func (s *s3BlobStore) Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{}
+
+	var err error
+	switch {
+	case length == 0:
+		// An empty range cannot be expressed in a Range header
+		return io.NopCloser(&io.LimitedReader{}), nil
+	case length > 0:
+		err = opts.SetRange(offset, offset+length-1)
+	case offset > 0:
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, _, err := s.core.GetObject(ctx, s.bucket, key, opts)
+	if err != nil {
+		return nil, s.mapError(err)
+	}
+
+	return body, nil
+}
+
+func (s *s3BlobStore) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	info, err := s.core.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, s.mapError(err)
+	}
+
+	return ObjectInfo{
+		Key:          key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified.UTC(),
+	}, nil
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	err := s.core.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	return s.mapError(err)
+}
+
+func (s *s3BlobStore) CreateMultipartUpload(ctx context.Context, key string, contentType string) (string, error) {
+	uploadID, err := s.core.NewMultipartUpload(ctx, s.bucket, key, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", s.mapError(err)
+	}
+
+	return uploadID, nil
+}
+
+func (s *s3BlobStore) UploadPart(
+	ctx context.Context,
+	key, uploadID string,
+	number int,
+	reader io.Reader,
+	size int64,
+) (Part, error) {
+	objectPart, err := s.core.PutObjectPart(
+		ctx,
+		s.bucket,
+		key,
+		uploadID,
+		number,
+		reader,
+		size,
+		minio.PutObjectPartOptions{},
+	)
+	if err != nil {
+		return Part{}, s.mapError(err)
+	}
+
+	return Part{Number: objectPart.PartNumber, ETag: objectPart.ETag, Size: objectPart.Size}, nil
+}
+
+func (s *s3BlobStore) CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []Part) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.Number, ETag: part.ETag})
+	}
+
+	_, err := s.core.CompleteMultipartUpload(ctx, s.bucket, key, uploadID, completeParts, minio.PutObjectOptions{})
+	return s.mapError(err)
+}
+
+func (s *s3BlobStore) AbortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	err := s.core.AbortMultipartUpload(ctx, s.bucket, key, uploadID)
+	return s.mapError(err)
+}
+
+func (s *s3BlobStore) mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey":
+		return ErrObjectNotFound
+	case "NoSuchUpload":
+		return ErrUploadNotFound
+	default:
+		return err
+	}
+}
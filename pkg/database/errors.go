@@ -0,0 +1,76 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	sqlStateNotNullViolation     = "23502"
+	sqlStateForeignKeyViolation  = "23503"
+	sqlStateUniqueViolation      = "23505"
+	sqlStateCheckViolation       = "23514"
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+var (
+	ErrNotNullViolation    = errors.New("not null violation")
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	ErrUniqueViolation     = errors.New("unique violation")
+	ErrCheckViolation      = errors.New("check violation")
+)
+
+// ErrorTranslator turns the constraint violations reported by Postgres into domain errors, so
+// that the rules enforced by the schema surface like the ones checked in code.
+type ErrorTranslator struct {
+	constraints map[string]error
+}
+
+// NewErrorTranslator maps constraint and unique index names to the domain error returned when
+// they are violated.
+func NewErrorTranslator(constraints map[string]error) ErrorTranslator {
+	return ErrorTranslator{constraints}
+}
+
+// Translate returns the domain error of the constraint err violated. A violation of a constraint
+// without a domain error is wrapped in the matching Err...Violation, and any other error is
+// returned as it is.
+func (t ErrorTranslator) Translate(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	if domainErr, ok := t.constraints[pgErr.ConstraintName]; ok && pgErr.ConstraintName != "" {
+		return domainErr
+	}
+
+	var violation error
+	switch pgErr.Code {
+	case sqlStateNotNullViolation:
+		violation = ErrNotNullViolation
+	case sqlStateForeignKeyViolation:
+		violation = ErrForeignKeyViolation
+	case sqlStateUniqueViolation:
+		violation = ErrUniqueViolation
+	case sqlStateCheckViolation:
+		violation = ErrCheckViolation
+	default:
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", violation, err)
+}
+
+// IsRetryable reports whether err aborted a transaction that can succeed when run again,
+// because it lost a serialization check or a deadlock against a concurrent transaction.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == sqlStateSerializationFailure || pgErr.Code == sqlStateDeadlockDetected
+}
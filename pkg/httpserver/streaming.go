@@ -0,0 +1,51 @@
+package httpserver
+
+import (
+	"net/http"
+	"time"
+)
+
+// deadlineRefreshInterval avoids touching the connection deadline on every small write.
+const deadlineRefreshInterval = time.Second
+
+// StreamingResponseWriter replaces the server WriteTimeout, which bounds the whole response,
+// with an idle timeout: the write deadline is pushed back while the client keeps reading.
+// Long downloads then last as long as needed while stalled clients are still dropped.
+type StreamingResponseWriter struct {
+	http.ResponseWriter
+	controller        *http.ResponseController
+	idleTimeout       time.Duration
+	deadlineRefreshed time.Time
+}
+
+func NewStreamingResponseWriter(w http.ResponseWriter, idleTimeout time.Duration) *StreamingResponseWriter {
+	streamingWriter := &StreamingResponseWriter{
+		ResponseWriter: w,
+		controller:     http.NewResponseController(w),
+		idleTimeout:    idleTimeout,
+	}
+	streamingWriter.refreshDeadline()
+	return streamingWriter
+}
+
+func (w *StreamingResponseWriter) Write(b []byte) (int, error) {
+	if time.Since(w.deadlineRefreshed) >= deadlineRefreshInterval {
+		w.refreshDeadline()
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap gives http.ResponseController access to the underlying writer.
+func (w *StreamingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *StreamingResponseWriter) Flush() {
+	_ = w.controller.Flush()
+}
+
+func (w *StreamingResponseWriter) refreshDeadline() {
+	w.deadlineRefreshed = time.Now()
+	// Writers that do not support deadlines keep the server timeouts
+	_ = w.controller.SetWriteDeadline(w.deadlineRefreshed.Add(w.idleTimeout))
+}
@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cristiano-pacheco/goflix/pkg/logger"
+)
+
+const defaultMemoryBufferSize = 1024
+
+// MemoryQueue delivers messages between goroutines of the same process. It is meant for tests
+// and local runs: messages that are not handled yet are lost when the process exits.
+type MemoryQueue struct {
+	policy     RetryPolicy
+	logger     logger.Logger
+	bufferSize int
+
+	mu          sync.Mutex
+	topics      map[string]chan Envelope
+	handlers    map[string]Handler
+	deadLetters map[string][]Envelope
+	started     bool
+	closed      bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var (
+	_ Publisher = (*MemoryQueue)(nil)
+	_ Consumer  = (*MemoryQueue)(nil)
+)
+
+// NewMemoryQueue creates a queue that buffers up to bufferSize messages per topic, Publish
+// blocks while the buffer of the topic is full.
+func NewMemoryQueue(policy RetryPolicy, logger logger.Logger, bufferSize int) *MemoryQueue {
+	if bufferSize <= 0 {
+		bufferSize = defaultMemoryBufferSize
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &MemoryQueue{
+		policy:      policy,
+		logger:      logger,
+		bufferSize:  bufferSize,
+		topics:      make(map[string]chan Envelope),
+		handlers:    make(map[string]Handler),
+		deadLetters: make(map[string][]Envelope),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (q *MemoryQueue) Publish(ctx context.Context, topic string, payload any, opts ...PublishOption) error {
+	ctx, span := startPublishSpan(ctx, topic)
+	defer span.End()
+
+	envelope, err := newEnvelope(ctx, topic, payload, opts)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return q.enqueue(ctx, envelope)
+}
+
+func (q *MemoryQueue) Subscribe(topic string, handler Handler) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.started {
+		return ErrAlreadyStarted
+	}
+	q.handlers[topic] = handler
+	return nil
+}
+
+func (q *MemoryQueue) Start(context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+	if q.started {
+		return ErrAlreadyStarted
+	}
+	q.started = true
+
+	for topic, handler := range q.handlers {
+		q.wg.Add(1)
+		go q.consume(q.topic(topic), handler)
+	}
+	return nil
+}
+
+func (q *MemoryQueue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DeadLetters returns the messages of topic that were given up on.
+func (q *MemoryQueue) DeadLetters(topic string) []Envelope {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slices.Clone(q.deadLetters[topic])
+}
+
+// topic returns the buffer of a topic, creating it on first use. The caller must hold q.mu.
+func (q *MemoryQueue) topic(name string) chan Envelope {
+	messages, ok := q.topics[name]
+	if !ok {
+		messages = make(chan Envelope, q.bufferSize)
+		q.topics[name] = messages
+	}
+	return messages
+}
+
+func (q *MemoryQueue) enqueue(ctx context.Context, envelope Envelope) error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return ErrClosed
+	}
+	messages := q.topic(envelope.Topic)
+	q.mu.Unlock()
+
+	select {
+	case messages <- envelope:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-q.ctx.Done():
+		return ErrClosed
+	}
+}
+
+func (q *MemoryQueue) consume(messages <-chan Envelope, handler Handler) {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.ctx.Done():
+			return
+		case envelope := <-messages:
+			q.process(handler, envelope)
+		}
+	}
+}
+
+func (q *MemoryQueue) process(handler Handler, envelope Envelope) {
+	// Handlers run to completion on Stop, so their context is not tied to the queue
+	err := handle(context.Background(), handler, envelope)
+	if err == nil {
+		return
+	}
+
+	envelope.LastError = truncateError(err)
+	if !q.policy.CanRetry(envelope.Attempt, err) {
+		q.deadLetter(envelope)
+		return
+	}
+
+	delay := q.policy.Delay(envelope.Attempt)
+	q.logger.Warn(
+		"queue message failed, retrying",
+		"topic", envelope.Topic, "id", envelope.ID, "attempt", envelope.Attempt, "delay", delay.String(), "error", err,
+	)
+	envelope.Attempt++
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-q.ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if retryErr := q.enqueue(q.ctx, envelope); retryErr != nil {
+			q.logger.Error("queue message retry lost", "topic", envelope.Topic, "id", envelope.ID, "error", retryErr)
+		}
+	}()
+}
+
+func (q *MemoryQueue) deadLetter(envelope Envelope) {
+	q.logger.Error(
+		"queue message dead-lettered",
+		"topic", envelope.Topic, "id", envelope.ID, "attempt", envelope.Attempt, "error", envelope.LastError,
+	)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.deadLetters[envelope.Topic] = append(q.deadLetters[envelope.Topic], envelope)
+}
@@ -0,0 +1,136 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/pkg/logger"
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+const topic = "emails"
+
+func TestMemoryQueue(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("message is delivered to the handler of its topic", func(t *testing.T) {
+		// Arrange
+		q := newMemoryQueue(t, 2)
+		var received atomic.Value
+		startMemoryQueue(t, q, func(_ context.Context, envelope queue.Envelope) error {
+			var payload map[string]string
+			if err := envelope.Decode(&payload); err != nil {
+				return err
+			}
+			received.Store(payload["email"])
+			return nil
+		})
+
+		// Act
+		err := q.Publish(context.Background(), topic, map[string]string{"email": "john@doe.com"})
+
+		// Assert
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return received.Load() == "john@doe.com" }, time.Second, time.Millisecond)
+	})
+
+	t.Run("failed message is retried until it succeeds", func(t *testing.T) {
+		// Arrange
+		q := newMemoryQueue(t, 2)
+		var attempts atomic.Int32
+		var succeeded atomic.Bool
+		startMemoryQueue(t, q, func(_ context.Context, envelope queue.Envelope) error {
+			attempts.Add(1)
+			if envelope.Attempt < 3 {
+				return errFailed
+			}
+			succeeded.Store(true)
+			return nil
+		})
+
+		// Act
+		err := q.Publish(context.Background(), topic, "payload")
+
+		// Assert
+		require.NoError(t, err)
+		require.Eventually(t, succeeded.Load, time.Second, time.Millisecond)
+		require.Equal(t, int32(3), attempts.Load())
+		require.Empty(t, q.DeadLetters(topic))
+	})
+
+	t.Run("message out of retries is dead-lettered", func(t *testing.T) {
+		// Arrange
+		q := newMemoryQueue(t, 2)
+		var attempts atomic.Int32
+		startMemoryQueue(t, q, func(context.Context, queue.Envelope) error {
+			attempts.Add(1)
+			return errFailed
+		})
+
+		// Act
+		err := q.Publish(context.Background(), topic, "payload", queue.WithMessageID("message-1"))
+
+		// Assert
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(q.DeadLetters(topic)) == 1 }, time.Second, time.Millisecond)
+		deadLetter := q.DeadLetters(topic)[0]
+		require.Equal(t, "message-1", deadLetter.ID)
+		require.Equal(t, 3, deadLetter.Attempt)
+		require.Equal(t, "failed", deadLetter.LastError)
+		require.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("permanent error is dead-lettered without retries", func(t *testing.T) {
+		// Arrange
+		q := newMemoryQueue(t, 2)
+		var attempts atomic.Int32
+		startMemoryQueue(t, q, func(context.Context, queue.Envelope) error {
+			attempts.Add(1)
+			return queue.Permanent(errFailed)
+		})
+
+		// Act
+		err := q.Publish(context.Background(), topic, "payload")
+
+		// Assert
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(q.DeadLetters(topic)) == 1 }, time.Second, time.Millisecond)
+		require.Equal(t, 1, q.DeadLetters(topic)[0].Attempt)
+		require.Equal(t, int32(1), attempts.Load())
+	})
+
+	t.Run("publish after stop returns error", func(t *testing.T) {
+		// Arrange
+		q := newMemoryQueue(t, 2)
+		require.NoError(t, q.Stop(context.Background()))
+
+		// Act
+		err := q.Publish(context.Background(), topic, "payload")
+
+		// Assert
+		require.ErrorIs(t, err, queue.ErrClosed)
+	})
+}
+
+func newMemoryQueue(t *testing.T, maxRetries int) *queue.MemoryQueue {
+	t.Helper()
+
+	policy := queue.RetryPolicy{MaxRetries: maxRetries, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	q := queue.NewMemoryQueue(policy, logger.New(logger.Config{}), 0)
+	t.Cleanup(func() {
+		require.NoError(t, q.Stop(context.Background()))
+	})
+	return q
+}
+
+func startMemoryQueue(t *testing.T, q *queue.MemoryQueue, handler queue.Handler) {
+	t.Helper()
+
+	require.NoError(t, q.Subscribe(topic, handler))
+	require.NoError(t, q.Start(context.Background()))
+}
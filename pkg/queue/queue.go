@@ -0,0 +1,194 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/cristiano-pacheco/goflix/pkg/queue"
+
+var (
+	ErrAlreadyStarted = errors.New("queue consumer already started")
+	ErrNoHandler      = errors.New("queue topic has no handler")
+	ErrClosed         = errors.New("queue closed")
+)
+
+// Envelope is the JSON document every message travels in. Metadata carries the trace context
+// of the publisher, so that the consumer span joins its trace.
+type Envelope struct {
+	ID          string            `json:"id"`
+	Topic       string            `json:"topic"`
+	Payload     json.RawMessage   `json:"payload"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	Attempt     int               `json:"attempt"`
+	PublishedAt time.Time         `json:"published_at"`
+	LastError   string            `json:"last_error,omitempty"`
+}
+
+// Decode unmarshals the payload into v.
+func (e Envelope) Decode(v any) error {
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return Permanent(fmt.Errorf("decode %s payload: %w", e.Topic, err))
+	}
+	return nil
+}
+
+// Handler processes a message. A returned error sends the message back for another attempt,
+// unless it is wrapped with Permanent or the retries are used up, then it is dead-lettered.
+type Handler func(ctx context.Context, envelope Envelope) error
+
+type Publisher interface {
+	// Publish sends payload, encoded as JSON, to the consumers of topic.
+	Publish(ctx context.Context, topic string, payload any, opts ...PublishOption) error
+}
+
+type PublishOption func(*publishOptions)
+
+type publishOptions struct {
+	messageID string
+}
+
+// WithMessageID sets the ID of the envelope instead of a random one, e.g. to an idempotency key
+// the consumers can recognize a redelivered message with.
+func WithMessageID(id string) PublishOption {
+	return func(o *publishOptions) {
+		o.messageID = id
+	}
+}
+
+type Consumer interface {
+	// Subscribe registers the handler of a topic, it must be called before Start.
+	Subscribe(topic string, handler Handler) error
+	Start(ctx context.Context) error
+	// Stop stops delivering messages and waits for the running handlers to return, or for
+	// ctx to be done.
+	Stop(ctx context.Context) error
+}
+
+// RetryPolicy doubles the delay before every retry, from BaseDelay up to MaxDelay. A zero
+// MaxDelay does not cap it.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// Delay returns how long to wait before the given retry, retries are numbered from 1.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// CanRetry reports whether a message that failed on the given attempt, numbered from 1,
+// gets another one.
+func (p RetryPolicy) CanRetry(attempt int, err error) bool {
+	return attempt <= p.MaxRetries && !IsPermanent(err)
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as one a retry will not fix, the message goes straight to the dead-letter queue.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+func newEnvelope(ctx context.Context, topic string, payload any, opts []PublishOption) (Envelope, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("encode %s payload: %w", topic, err)
+	}
+
+	options := publishOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.messageID == "" {
+		options.messageID = uuid.NewString()
+	}
+
+	metadata := map[string]string{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(metadata))
+
+	return Envelope{
+		ID:          options.messageID,
+		Topic:       topic,
+		Payload:     data,
+		Metadata:    metadata,
+		Attempt:     1,
+		PublishedAt: time.Now().UTC(),
+	}, nil
+}
+
+// startPublishSpan starts the producer span of a message, it must be started before the envelope
+// is built so that the consumer span becomes its child.
+func startPublishSpan(ctx context.Context, topic string) (context.Context, oteltrace.Span) {
+	//nolint:spancheck // span is returned to caller who is responsible for ending it
+	return otel.Tracer(tracerName).Start(
+		ctx,
+		topic+" publish",
+		oteltrace.WithSpanKind(oteltrace.SpanKindProducer),
+		oteltrace.WithAttributes(attribute.String("messaging.destination.name", topic)),
+	)
+}
+
+// handle runs handler in a consumer span that continues the trace of the publisher.
+func handle(ctx context.Context, handler Handler, envelope Envelope) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(envelope.Metadata))
+	ctx, span := otel.Tracer(tracerName).Start(
+		ctx,
+		envelope.Topic+" process",
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer),
+		oteltrace.WithAttributes(
+			attribute.String("messaging.destination.name", envelope.Topic),
+			attribute.String("messaging.message.id", envelope.ID),
+			attribute.Int("messaging.message.attempt", envelope.Attempt),
+		),
+	)
+	defer span.End()
+
+	err := handler(ctx, envelope)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// truncateError keeps the recorded error of a message to a reasonable size.
+func truncateError(err error) string {
+	const maxErrorLength = 1000
+	msg := err.Error()
+	if len(msg) > maxErrorLength {
+		msg = msg[:maxErrorLength]
+	}
+	return msg
+}
@@ -0,0 +1,115 @@
+package queue_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cristiano-pacheco/goflix/pkg/queue"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := queue.RetryPolicy{MaxRetries: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	t.Run("first retry waits the base delay", func(t *testing.T) {
+		// Act
+		delay := policy.Delay(1)
+
+		// Assert
+		require.Equal(t, time.Second, delay)
+	})
+
+	t.Run("every retry doubles the delay", func(t *testing.T) {
+		// Act
+		delay := policy.Delay(3)
+
+		// Assert
+		require.Equal(t, 4*time.Second, delay)
+	})
+
+	t.Run("delay is capped at the max delay", func(t *testing.T) {
+		// Act
+		delay := policy.Delay(10)
+
+		// Assert
+		require.Equal(t, 10*time.Second, delay)
+	})
+
+	t.Run("policy without max delay keeps doubling", func(t *testing.T) {
+		// Arrange
+		unbounded := queue.RetryPolicy{MaxRetries: 5, BaseDelay: time.Second}
+
+		// Act
+		delay := unbounded.Delay(6)
+
+		// Assert
+		require.Equal(t, 32*time.Second, delay)
+	})
+}
+
+func TestRetryPolicy_CanRetry(t *testing.T) {
+	policy := queue.RetryPolicy{MaxRetries: 2, BaseDelay: time.Second, MaxDelay: time.Minute}
+	errFailed := errors.New("failed")
+
+	t.Run("attempt within the retries is retried", func(t *testing.T) {
+		// Act
+		canRetry := policy.CanRetry(2, errFailed)
+
+		// Assert
+		require.True(t, canRetry)
+	})
+
+	t.Run("attempt past the retries is not retried", func(t *testing.T) {
+		// Act
+		canRetry := policy.CanRetry(3, errFailed)
+
+		// Assert
+		require.False(t, canRetry)
+	})
+
+	t.Run("permanent error is not retried", func(t *testing.T) {
+		// Act
+		canRetry := policy.CanRetry(1, queue.Permanent(errFailed))
+
+		// Assert
+		require.False(t, canRetry)
+	})
+}
+
+func TestPermanent(t *testing.T) {
+	t.Run("wrapped error is permanent and keeps its cause", func(t *testing.T) {
+		// Arrange
+		errFailed := errors.New("failed")
+
+		// Act
+		err := queue.Permanent(errFailed)
+
+		// Assert
+		require.True(t, queue.IsPermanent(err))
+		require.ErrorIs(t, err, errFailed)
+	})
+
+	t.Run("nil stays nil", func(t *testing.T) {
+		// Act
+		err := queue.Permanent(nil)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("payload that cannot be decoded is permanent", func(t *testing.T) {
+		// Arrange
+		envelope := queue.Envelope{Topic: "emails", Payload: []byte(`{"user_id":"one"}`)}
+		var payload struct {
+			UserID uint64 `json:"user_id"`
+		}
+
+		// Act
+		err := envelope.Decode(&payload)
+
+		// Assert
+		require.True(t, queue.IsPermanent(err))
+	})
+}
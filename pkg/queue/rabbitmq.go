@@ -0,0 +1,499 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/cristiano-pacheco/goflix/pkg/logger"
+)
+
+const (
+	defaultExchange   = "goflix"
+	defaultPrefetch   = 10
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+	contentTypeJSON   = "application/json"
+)
+
+var ErrNotConnected = errors.New("rabbitmq not connected")
+
+type RabbitMQConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	VHost    string
+	// Exchange is the direct exchange messages are published to, with their topic as routing key.
+	Exchange string
+	// Prefetch is the number of messages of a topic handled at the same time.
+	Prefetch int
+	Policy   RetryPolicy
+}
+
+// RabbitMQQueue publishes every topic to its own durable queue, bound to the exchange with the
+// topic as routing key. A failed message is published to a retry queue whose TTL is the backoff
+// delay and which dead-letters back into the topic queue, or to the "<queue>.dead" queue once
+// it is out of retries. The connection is re-established, and the consumers restarted, when
+// the broker drops it.
+type RabbitMQQueue struct {
+	cfg    RabbitMQConfig
+	url    string
+	logger logger.Logger
+
+	mu          sync.Mutex
+	conn        *amqp.Connection
+	connClosed  chan *amqp.Error
+	publishCh   *amqp.Channel
+	declared    map[string]bool
+	handlers    map[string]Handler
+	started     bool
+	closed      bool
+	publishLock sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var (
+	_ Publisher = (*RabbitMQQueue)(nil)
+	_ Consumer  = (*RabbitMQQueue)(nil)
+)
+
+// NewRabbitMQQueue connects to the broker and declares the exchange.
+func NewRabbitMQQueue(cfg RabbitMQConfig, logger logger.Logger) (*RabbitMQQueue, error) {
+	if cfg.Exchange == "" {
+		cfg.Exchange = defaultExchange
+	}
+	if cfg.Prefetch <= 0 {
+		cfg.Prefetch = defaultPrefetch
+	}
+	if cfg.VHost == "" {
+		cfg.VHost = "/"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &RabbitMQQueue{
+		cfg: cfg,
+		url: amqp.URI{
+			Scheme:   "amqp",
+			Host:     cfg.Host,
+			Port:     cfg.Port,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			Vhost:    cfg.VHost,
+		}.String(),
+		logger:   logger,
+		handlers: make(map[string]Handler),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	if err := q.connect(); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	q.wg.Add(1)
+	go q.supervise()
+
+	return q, nil
+}
+
+func (q *RabbitMQQueue) Publish(ctx context.Context, topic string, payload any, opts ...PublishOption) error {
+	ctx, span := startPublishSpan(ctx, topic)
+	defer span.End()
+
+	envelope, err := newEnvelope(ctx, topic, payload, opts)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("encode %s envelope: %w", topic, err)
+	}
+
+	if err = q.publish(ctx, topic, q.cfg.Exchange, topic, envelope.ID, body); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+func (q *RabbitMQQueue) Subscribe(topic string, handler Handler) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.started {
+		return ErrAlreadyStarted
+	}
+	q.handlers[topic] = handler
+	return nil
+}
+
+func (q *RabbitMQQueue) Start(context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+	if q.started {
+		return ErrAlreadyStarted
+	}
+	q.started = true
+
+	return q.startConsumers()
+}
+
+func (q *RabbitMQQueue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.conn == nil || q.conn.IsClosed() {
+		return nil
+	}
+	return q.conn.Close()
+}
+
+func (q *RabbitMQQueue) connect() error {
+	conn, err := amqp.Dial(q.url)
+	if err != nil {
+		return fmt.Errorf("connect to rabbitmq: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("open rabbitmq channel: %w", err)
+	}
+	if err = ch.ExchangeDeclare(q.cfg.Exchange, amqp.ExchangeDirect, true, false, false, false, nil); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("declare exchange %s: %w", q.cfg.Exchange, err)
+	}
+	_ = ch.Close()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.conn = conn
+	q.connClosed = conn.NotifyClose(make(chan *amqp.Error, 1))
+	q.publishCh = nil
+	q.declared = make(map[string]bool)
+	return nil
+}
+
+// supervise reconnects when the broker drops the connection, until the queue is stopped.
+func (q *RabbitMQQueue) supervise() {
+	defer q.wg.Done()
+
+	for {
+		q.mu.Lock()
+		connClosed := q.connClosed
+		q.mu.Unlock()
+
+		select {
+		case <-q.ctx.Done():
+			return
+		case amqpErr := <-connClosed:
+			q.logger.Warn("rabbitmq connection lost, reconnecting", "error", amqpErr)
+		}
+
+		if !q.reconnect() {
+			return
+		}
+
+		q.mu.Lock()
+		if q.started {
+			if err := q.startConsumers(); err != nil {
+				q.logger.Error("failed to restart rabbitmq consumers", "error", err)
+			}
+		}
+		q.mu.Unlock()
+	}
+}
+
+// reconnect dials the broker until it succeeds, waiting longer after every failure. It
+// returns false when the queue is stopped first.
+func (q *RabbitMQQueue) reconnect() bool {
+	delay := minReconnectDelay
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-q.ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+
+		err := q.connect()
+		if err == nil {
+			q.logger.Info("rabbitmq connection re-established")
+			return true
+		}
+		q.logger.Error("failed to reconnect to rabbitmq", "error", err, "retry_in", delay.String())
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// startConsumers starts the consumers of every subscribed topic. The caller must hold q.mu.
+func (q *RabbitMQQueue) startConsumers() error {
+	for topic, handler := range q.handlers {
+		if err := q.startConsumer(topic, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *RabbitMQQueue) startConsumer(topic string, handler Handler) error {
+	if q.conn == nil || q.conn.IsClosed() {
+		return ErrNotConnected
+	}
+
+	ch, err := q.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("open rabbitmq channel: %w", err)
+	}
+	if err = q.declareTopic(ch, topic); err != nil {
+		_ = ch.Close()
+		return err
+	}
+	if err = ch.Qos(q.cfg.Prefetch, 0, false); err != nil {
+		_ = ch.Close()
+		return fmt.Errorf("set prefetch of %s: %w", topic, err)
+	}
+
+	deliveries, err := ch.Consume(q.queueName(topic), "", false, false, false, false, nil)
+	if err != nil {
+		_ = ch.Close()
+		return fmt.Errorf("consume %s: %w", topic, err)
+	}
+
+	// The channel is closed once every worker returned, so that none acknowledges on a closed channel
+	var workers sync.WaitGroup
+	for range q.cfg.Prefetch {
+		workers.Add(1)
+		go q.consume(&workers, topic, handler, deliveries)
+	}
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		workers.Wait()
+		_ = ch.Close()
+	}()
+	return nil
+}
+
+// consume handles deliveries until the queue is stopped or the channel is closed. Messages
+// that are not acknowledged yet go back to the queue when the channel closes.
+func (q *RabbitMQQueue) consume(
+	workers *sync.WaitGroup,
+	topic string,
+	handler Handler,
+	deliveries <-chan amqp.Delivery,
+) {
+	defer workers.Done()
+
+	for {
+		select {
+		case <-q.ctx.Done():
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			q.process(topic, handler, delivery)
+		}
+	}
+}
+
+func (q *RabbitMQQueue) process(topic string, handler Handler, delivery amqp.Delivery) {
+	// Handlers run to completion on Stop, so their context is not tied to the queue
+	ctx := context.Background()
+
+	var envelope Envelope
+	if err := json.Unmarshal(delivery.Body, &envelope); err != nil {
+		q.logger.Error("queue message is not an envelope, dead-lettering", "topic", topic, "error", err)
+		q.settle(delivery, q.publish(ctx, topic, "", q.deadQueueName(topic), delivery.MessageId, delivery.Body))
+		return
+	}
+
+	err := handle(ctx, handler, envelope)
+	if err == nil {
+		q.settle(delivery, nil)
+		return
+	}
+
+	envelope.LastError = truncateError(err)
+	target := q.deadQueueName(topic)
+	if q.cfg.Policy.CanRetry(envelope.Attempt, err) {
+		delay := q.cfg.Policy.Delay(envelope.Attempt)
+		target = q.retryQueueName(topic, delay)
+		q.logger.Warn(
+			"queue message failed, retrying",
+			"topic", topic, "id", envelope.ID, "attempt", envelope.Attempt, "delay", delay.String(), "error", err,
+		)
+		envelope.Attempt++
+	} else {
+		q.logger.Error(
+			"queue message dead-lettered",
+			"topic", topic, "id", envelope.ID, "attempt", envelope.Attempt, "error", err,
+		)
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		q.settle(delivery, err)
+		return
+	}
+	q.settle(delivery, q.publish(ctx, topic, "", target, envelope.ID, body))
+}
+
+// settle acknowledges a delivery once it is handled or moved to another queue. When moving it
+// failed, the delivery goes back to its queue instead, so that it is not lost.
+func (q *RabbitMQQueue) settle(delivery amqp.Delivery, err error) {
+	if err != nil {
+		q.logger.Error("failed to move queue message, requeueing", "id", delivery.MessageId, "error", err)
+		if nackErr := delivery.Nack(false, true); nackErr != nil {
+			q.logger.Error("failed to requeue queue message", "id", delivery.MessageId, "error", nackErr)
+		}
+		return
+	}
+	if ackErr := delivery.Ack(false); ackErr != nil {
+		q.logger.Error("failed to acknowledge queue message", "id", delivery.MessageId, "error", ackErr)
+	}
+}
+
+// publish sends body to the exchange, once the queues of topic are declared, and waits for the
+// broker to confirm it.
+func (q *RabbitMQQueue) publish(ctx context.Context, topic, exchange, key, messageID string, body []byte) error {
+	q.publishLock.Lock()
+	ch, err := q.publishChannel(topic)
+	if err != nil {
+		q.publishLock.Unlock()
+		return err
+	}
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, exchange, key, false, false, amqp.Publishing{
+		ContentType:  contentTypeJSON,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    messageID,
+		Timestamp:    time.Now().UTC(),
+		Type:         topic,
+		Body:         body,
+	})
+	q.publishLock.Unlock()
+	if err != nil {
+		return fmt.Errorf("publish %s: %w", topic, err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("confirm %s: %w", topic, err)
+	}
+	if !acked {
+		return fmt.Errorf("publish %s: rejected by the broker", topic)
+	}
+	return nil
+}
+
+// publishChannel returns the channel messages are published on, opening it in confirm mode
+// if needed, with the queues of topic declared. The caller must hold q.publishLock.
+func (q *RabbitMQQueue) publishChannel(topic string) (*amqp.Channel, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil, ErrClosed
+	}
+	if q.conn == nil || q.conn.IsClosed() {
+		return nil, ErrNotConnected
+	}
+
+	if q.publishCh == nil || q.publishCh.IsClosed() {
+		ch, err := q.conn.Channel()
+		if err != nil {
+			return nil, fmt.Errorf("open rabbitmq channel: %w", err)
+		}
+		if err = ch.Confirm(false); err != nil {
+			_ = ch.Close()
+			return nil, fmt.Errorf("enable publisher confirms: %w", err)
+		}
+		q.publishCh = ch
+	}
+
+	if !q.declared[topic] {
+		if err := q.declareTopic(q.publishCh, topic); err != nil {
+			return nil, err
+		}
+		q.declared[topic] = true
+	}
+	return q.publishCh, nil
+}
+
+// declareTopic declares the queue of topic, its retry queues and its dead-letter queue.
+func (q *RabbitMQQueue) declareTopic(ch *amqp.Channel, topic string) error {
+	queue := q.queueName(topic)
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare queue %s: %w", queue, err)
+	}
+	if err := ch.QueueBind(queue, topic, q.cfg.Exchange, false, nil); err != nil {
+		return fmt.Errorf("bind queue %s: %w", queue, err)
+	}
+
+	for retry := 1; retry <= q.cfg.Policy.MaxRetries; retry++ {
+		delay := q.cfg.Policy.Delay(retry)
+		retryQueue := q.retryQueueName(topic, delay)
+		_, err := ch.QueueDeclare(retryQueue, true, false, false, false, amqp.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": queue,
+		})
+		if err != nil {
+			return fmt.Errorf("declare queue %s: %w", retryQueue, err)
+		}
+	}
+
+	deadQueue := q.deadQueueName(topic)
+	if _, err := ch.QueueDeclare(deadQueue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare queue %s: %w", deadQueue, err)
+	}
+	return nil
+}
+
+func (q *RabbitMQQueue) queueName(topic string) string {
+	return q.cfg.Exchange + "." + topic
+}
+
+func (q *RabbitMQQueue) retryQueueName(topic string, delay time.Duration) string {
+	return q.queueName(topic) + ".retry." + strconv.FormatInt(delay.Milliseconds(), 10) + "ms"
+}
+
+func (q *RabbitMQQueue) deadQueueName(topic string) string {
+	return q.queueName(topic) + ".dead"
+}
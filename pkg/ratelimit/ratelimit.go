@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	AlgorithmSlidingWindow = "sliding_window"
+	AlgorithmTokenBucket   = "token_bucket"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown rate limit algorithm")
+	ErrInvalidLimit     = errors.New("rate limit needs at least one request per period")
+)
+
+// Limit allows Requests per Period.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (l Limit) validate() error {
+	if l.Requests <= 0 || l.Period < time.Millisecond {
+		return fmt.Errorf("%w: %d per %s", ErrInvalidLimit, l.Requests, l.Period)
+	}
+	return nil
+}
+
+// Result is the state of a key after a request. RetryAfter is only set when the request is
+// not allowed, ResetAfter is how long until the key is back to its full limit.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// Limiter counts the requests of a key in Redis, so that every instance of the application
+// shares the same limits. Redis keeps the clock, the instances may drift.
+type Limiter interface {
+	// Allow counts a request of key against limit, unless the limit is already reached.
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// New returns the limiter of algorithm. Its keys are stored under prefix.
+func New(client *redis.Client, algorithm, prefix string) (Limiter, error) {
+	switch algorithm {
+	case AlgorithmSlidingWindow:
+		return newSlidingWindowLimiter(client, prefix), nil
+	case AlgorithmTokenBucket:
+		return newTokenBucketLimiter(client, prefix), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, algorithm)
+	}
+}
+
+func toResult(limit Limit, reply []int64) Result {
+	return Result{
+		Allowed:    reply[0] == 1,
+		Limit:      limit.Requests,
+		Remaining:  int(reply[1]),
+		RetryAfter: time.Duration(reply[2]) * time.Millisecond,
+		ResetAfter: time.Duration(reply[3]) * time.Millisecond,
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript keeps the time of every allowed request of the last window in a sorted
+// set. A request is allowed while fewer than the limit remain in it.
+const slidingWindowScript = `
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[3])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+local newest = redis.call('ZRANGE', KEYS[1], -1, -1, 'WITHSCORES')
+local retry_after = 0
+if allowed == 0 then
+	retry_after = tonumber(oldest[2]) + window - now
+end
+local reset_after = 0
+if newest[2] then
+	reset_after = tonumber(newest[2]) + window - now
+end
+
+return {allowed, limit - count, retry_after, reset_after}
+`
+
+// slidingWindowLimiter allows at most the limit in any window of the period. It is exact, at
+// the cost of a sorted set entry per request.
+type slidingWindowLimiter struct {
+	client *redis.Client
+	prefix string
+	script *redis.Script
+}
+
+func newSlidingWindowLimiter(client *redis.Client, prefix string) *slidingWindowLimiter {
+	return &slidingWindowLimiter{client, prefix, redis.NewScript(slidingWindowScript)}
+}
+
+func (l *slidingWindowLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if err := limit.validate(); err != nil {
+		return Result{}, err
+	}
+
+	reply, err := l.script.Run(
+		ctx,
+		l.client,
+		[]string{l.prefix + key},
+		limit.Requests,
+		limit.Period.Milliseconds(),
+		uuid.NewString(),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+
+	return toResult(limit, reply), nil
+}
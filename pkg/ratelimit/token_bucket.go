@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript refills the bucket for the time since the last request, and takes a token
+// out of it when there is one.
+const tokenBucketScript = `
+local capacity = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local rate = capacity / period
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = tonumber(bucket[1]) or capacity
+local updated_at = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - updated_at) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', now)
+redis.call('PEXPIRE', KEYS[1], period)
+
+local retry_after = 0
+if allowed == 0 then
+	retry_after = math.ceil((1 - tokens) / rate)
+end
+local reset_after = math.ceil((capacity - tokens) / rate)
+
+return {allowed, math.floor(tokens), retry_after, reset_after}
+`
+
+// tokenBucketLimiter lets a key burst up to the limit, and refills it evenly over the period.
+type tokenBucketLimiter struct {
+	client *redis.Client
+	prefix string
+	script *redis.Script
+}
+
+func newTokenBucketLimiter(client *redis.Client, prefix string) *tokenBucketLimiter {
+	return &tokenBucketLimiter{client, prefix, redis.NewScript(tokenBucketScript)}
+}
+
+func (l *tokenBucketLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if err := limit.validate(); err != nil {
+		return Result{}, err
+	}
+
+	reply, err := l.script.Run(
+		ctx,
+		l.client,
+		[]string{l.prefix + key},
+		limit.Requests,
+		limit.Period.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+
+	return toResult(limit, reply), nil
+}
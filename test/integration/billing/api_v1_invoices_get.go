@@ -0,0 +1,82 @@
+package billing_test
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type GetInvoicesTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *GetInvoicesTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *GetInvoicesTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestGetInvoicesSuite(t *testing.T) {
+	suite.Run(t, new(GetInvoicesTestSuite))
+}
+
+func (s *GetInvoicesTestSuite) TestShouldListInvoicesRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/billing/invoices",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *GetInvoicesTestSuite) TestShouldFindInvoiceRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/billing/invoices/1",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
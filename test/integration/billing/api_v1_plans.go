@@ -0,0 +1,101 @@
+package billing_test
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type PlansTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *PlansTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *PlansTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestPlansSuite(t *testing.T) {
+	suite.Run(t, new(PlansTestSuite))
+}
+
+func (s *PlansTestSuite) TestShouldListPlansWithoutAuthenticationAndReturnStatus200() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/plans",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *PlansTestSuite) TestShouldCreatePlanRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/plans",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *PlansTestSuite) TestShouldDeletePlanRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodDelete,
+		"http://localhost:9000/api/v1/plans/1",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,120 @@
+package billing_test
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type SubscriptionActionsTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *SubscriptionActionsTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *SubscriptionActionsTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestSubscriptionActionsSuite(t *testing.T) {
+	suite.Run(t, new(SubscriptionActionsTestSuite))
+}
+
+func (s *SubscriptionActionsTestSuite) TestShouldCancelRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/subscriptions/1/cancel",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *SubscriptionActionsTestSuite) TestShouldPauseRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/subscriptions/1/pause",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *SubscriptionActionsTestSuite) TestShouldResumeRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/subscriptions/1/resume",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *SubscriptionActionsTestSuite) TestShouldChangePlanRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPut,
+		"http://localhost:9000/api/v1/subscriptions/1/plan",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,103 @@
+package billing_test
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type GetSubscriptionsTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *GetSubscriptionsTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *GetSubscriptionsTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestGetSubscriptionsSuite(t *testing.T) {
+	suite.Run(t, new(GetSubscriptionsTestSuite))
+}
+
+func (s *GetSubscriptionsTestSuite) TestShouldListSubscriptionsRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/subscriptions",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *GetSubscriptionsTestSuite) TestShouldActiveSubscriptionRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/subscriptions/active",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *GetSubscriptionsTestSuite) TestShouldCreateSubscriptionRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/subscriptions",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
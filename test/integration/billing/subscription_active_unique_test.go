@@ -0,0 +1,192 @@
+//go:build integration
+
+package billing_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/enum"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/errs"
+	"github.com/cristiano-pacheco/goflix/internal/billing/domain/model"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/entity"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/mapper"
+	"github.com/cristiano-pacheco/goflix/internal/billing/infra/persistence/gorm/repository"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+// accessCache stands in for Redis, the test is about the database only.
+type accessCache struct{}
+
+func (accessCache) Get(context.Context, uint64) (bool, bool, error) { return false, false, nil }
+
+func (accessCache) Set(context.Context, uint64, bool) error { return nil }
+
+func (accessCache) Invalidate(context.Context, uint64) error { return nil }
+
+type ActiveSubscriptionUniqueTestSuite struct {
+	suite.Suite
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+	db                     *database.GoflixDB
+	planRepository         repository.PlanRepository
+	subscriptionRepository repository.SubscriptionRepository
+	planID                 uint64
+	userID                 uint64
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	db, err := integration.OpenDatabase()
+	s.Require().NoError(err)
+	s.db = db
+
+	s.planRepository = repository.NewPlanRepository(db, mapper.NewPlanMapper())
+	s.subscriptionRepository = repository.NewSubscriptionRepository(db, mapper.NewSubscriptionMapper(), accessCache{})
+
+	entitlements, err := model.CreateEntitlementsModel(1, enum.EnumVideoResolutionHD, 0, 1)
+	s.Require().NoError(err)
+	plan, err := model.CreatePlanModel("Concurrency", "", "USD", enum.EnumPlanIntervalMonth, 999, nil, entitlements)
+	s.Require().NoError(err)
+	plan, err = s.planRepository.Create(s.ctx, plan)
+	s.Require().NoError(err)
+
+	s.planID = plan.ID()
+	// A user id no other test uses, there is no foreign key to the users
+	s.userID = uint64(time.Now().UnixNano())
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TearDownTest() {
+	if s.db != nil {
+		s.db.Where("user_id = ?", s.userID).Delete(&entity.SubscriptionEntity{})
+		s.db.Delete(&entity.PlanEntity{}, s.planID)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestActiveSubscriptionUniqueSuite(t *testing.T) {
+	suite.Run(t, new(ActiveSubscriptionUniqueTestSuite))
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) activeSubscription() model.SubscriptionModel {
+	endDate := time.Now().UTC().AddDate(0, 1, 0)
+	subscription, err := model.CreateSubscriptionModel(s.userID, s.planID, time.Now().UTC(), &endDate)
+	s.Require().NoError(err)
+	s.Require().NoError(subscription.Activate())
+	return subscription
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TestShouldLetOnlyOneConcurrentActiveInsertWin() {
+	// Arrange
+	const concurrentInserts = 10
+	subscriptions := make([]model.SubscriptionModel, concurrentInserts)
+	for i := range subscriptions {
+		subscriptions[i] = s.activeSubscription()
+	}
+	results := make([]error, concurrentInserts)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+
+	// Act
+	for i, subscription := range subscriptions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, results[i] = s.subscriptionRepository.Create(s.ctx, subscription)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	// Assert
+	inserted := 0
+	for _, err := range results {
+		if err == nil {
+			inserted++
+			continue
+		}
+		s.Require().ErrorIs(err, errs.ErrUserAlreadyHasActiveSubscription)
+	}
+	s.Equal(1, inserted)
+
+	active, err := s.subscriptionRepository.FindByUserID(s.ctx, s.userID)
+	s.Require().NoError(err)
+	s.Len(active, 1)
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TestShouldRejectActivatingASecondSubscription() {
+	// Arrange
+	_, err := s.subscriptionRepository.Create(s.ctx, s.activeSubscription())
+	s.Require().NoError(err)
+
+	endDate := time.Now().UTC().AddDate(0, 1, 0)
+	pending, err := model.CreateSubscriptionModel(s.userID, s.planID, time.Now().UTC(), &endDate)
+	s.Require().NoError(err)
+	pending, err = s.subscriptionRepository.Create(s.ctx, pending)
+	s.Require().NoError(err)
+	s.Require().NoError(pending.Activate())
+
+	// Act
+	err = s.subscriptionRepository.Update(s.ctx, pending)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrUserAlreadyHasActiveSubscription)
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TestShouldRejectASubscriptionWhileAnotherIsPaused() {
+	// Arrange
+	paused := s.activeSubscription()
+	s.Require().NoError(paused.Pause(time.Now().UTC()))
+	_, err := s.subscriptionRepository.Create(s.ctx, paused)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.subscriptionRepository.Create(s.ctx, s.activeSubscription())
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrUserAlreadyHasActiveSubscription)
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TestShouldRejectASubscriptionWhileATrialRuns() {
+	// Arrange
+	now := time.Now().UTC()
+	trial, err := model.CreateTrialSubscriptionModel(s.userID, s.planID, now, now.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	_, err = s.subscriptionRepository.Create(s.ctx, trial)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.subscriptionRepository.Create(s.ctx, s.activeSubscription())
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrUserAlreadyHasActiveSubscription)
+}
+
+func (s *ActiveSubscriptionUniqueTestSuite) TestShouldRejectASecondTrial() {
+	// Arrange
+	now := time.Now().UTC()
+	trial, err := model.CreateTrialSubscriptionModel(s.userID, s.planID, now, now.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	trial, err = s.subscriptionRepository.Create(s.ctx, trial)
+	s.Require().NoError(err)
+	s.Require().NoError(trial.Cancel(now))
+	s.Require().NoError(s.subscriptionRepository.Update(s.ctx, trial))
+
+	secondTrial, err := model.CreateTrialSubscriptionModel(s.userID, s.planID, now, now.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.subscriptionRepository.Create(s.ctx, secondTrial)
+
+	// Assert
+	s.Require().ErrorIs(err, errs.ErrTrialAlreadyUsed)
+}
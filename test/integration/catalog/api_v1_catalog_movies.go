@@ -0,0 +1,103 @@
+package catalog_test
+
+import (
+	"context"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type CatalogMoviesTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *CatalogMoviesTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *CatalogMoviesTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestCatalogMoviesSuite(t *testing.T) {
+	suite.Run(t, new(CatalogMoviesTestSuite))
+}
+
+func (s *CatalogMoviesTestSuite) TestShouldListMoviesRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/catalog/movies",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *CatalogMoviesTestSuite) TestShouldCreateMovieRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/catalog/movies",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *CatalogMoviesTestSuite) TestShouldFindTvShowRequireAuthenticationAndReturnStatus401() {
+	// Arrange
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9000/api/v1/catalog/tv-shows/1",
+		nil,
+	)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,31 @@
+package integration
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/config"
+	"github.com/cristiano-pacheco/goflix/internal/shared/modules/database"
+)
+
+// OpenDatabase connects to the database configured in the .env file of the project, for tests
+// that exercise the schema itself. The migrations must have been applied.
+func OpenDatabase() (*database.GoflixDB, error) {
+	workDir, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find project root: %w", err)
+	}
+
+	// The config is read from the working directory
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chdir(workDir); err != nil {
+		return nil, err
+	}
+	defer os.Chdir(currentDir)
+
+	config.Init()
+	return database.New(config.GetConfig()), nil
+}
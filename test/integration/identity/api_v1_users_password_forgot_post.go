@@ -0,0 +1,74 @@
+package identity_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cristiano-pacheco/goflix/test/integration"
+)
+
+type PostUsersPasswordForgotTestSuite struct {
+	suite.Suite
+	cmd    *exec.Cmd
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *http.Client
+}
+
+func (s *PostUsersPasswordForgotTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+
+	cmd, err := integration.Bootstrap(s.ctx)
+	s.Require().NoError(err)
+	s.cmd = cmd
+
+	s.client = &http.Client{Timeout: 10 * time.Second}
+}
+
+func (s *PostUsersPasswordForgotTestSuite) TearDownTest() {
+	if s.cmd != nil {
+		integration.Shutdown(s.cmd)
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func TestPostUsersPasswordForgotSuite(t *testing.T) {
+	suite.Run(t, new(PostUsersPasswordForgotTestSuite))
+}
+
+func (s *PostUsersPasswordForgotTestSuite) TestShouldNotRevealUnknownEmailAndReturnStatus204() {
+	// Arrange
+	requestBody := map[string]string{
+		"email": "unknown.user@example.com",
+	}
+
+	jsonBody, err := json.Marshal(requestBody)
+	s.Require().NoError(err)
+
+	// Act
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodPost,
+		"http://localhost:9000/api/v1/users/password/forgot",
+		bytes.NewBuffer(jsonBody),
+	)
+	s.Require().NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	// Assert
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+}